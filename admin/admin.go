@@ -6,6 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/internal/httplog"
+	"github.com/ctreminiom/go-atlassian/internal/instrument"
+	"github.com/ctreminiom/go-atlassian/internal/route"
+	"github.com/ctreminiom/go-atlassian/ratelimit"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -17,6 +21,10 @@ type Client struct {
 	HTTP *http.Client
 	Site *url.URL
 
+	logger          *httplog.Hook
+	instrumentation *instrument.Hook
+	limiter         *ratelimit.Limiter
+
 	Auth         *AuthenticationService
 	Organization *OrganizationService
 	User         *UserService
@@ -26,8 +34,11 @@ type Client struct {
 
 const ApiEndpoint = "https://api.atlassian.com/"
 
+// ClientOption configures the client created by New, e.g: WithInstrumentation
+type ClientOption func(*Client)
+
 //New
-func New(httpClient *http.Client) (client *Client, err error) {
+func New(httpClient *http.Client, options ...ClientOption) (client *Client, err error) {
 
 	if httpClient == nil {
 		httpClient = http.DefaultClient
@@ -64,10 +75,14 @@ func New(httpClient *http.Client) (client *Client, err error) {
 		Resource: &SCIMResourceService{client: client},
 	}
 
+	for _, option := range options {
+		option(client)
+	}
+
 	return
 }
 
-func (c *Client) newRequest(ctx context.Context, method, urlAsString string, payload interface{}) (request *http.Request, err error) {
+func (c *Client) newRequest(ctx context.Context, method, template, urlAsString string, payload interface{}) (request *http.Request, err error) {
 
 	if ctx == nil {
 		return nil, errors.New("the context param is nil, please provide a valid one")
@@ -79,6 +94,7 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 	}
 
 	relativePath.Path = strings.TrimLeft(relativePath.Path, "/")
+	relativePath.RawPath = strings.TrimLeft(relativePath.RawPath, "/")
 
 	endpointPath := c.Site.ResolveReference(relativePath)
 	var payloadBuffer io.ReadWriter
@@ -89,7 +105,7 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 		}
 	}
 
-	request, err = http.NewRequestWithContext(ctx, method, endpointPath.String(), payloadBuffer)
+	request, err = http.NewRequestWithContext(route.With(ctx, template), method, endpointPath.String(), payloadBuffer)
 	if err != nil {
 		return
 	}
@@ -107,7 +123,19 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 
 func (c *Client) Do(request *http.Request) (response *Response, err error) {
 
+	template := route.Of(request.Context())
+
+	if err = c.limiter.Wait(request.Context(), request.Method, template); err != nil {
+		return
+	}
+
+	request, call := c.instrumentation.Start(request, template)
+	exchange := c.logger.Start(request)
+
 	httpResponse, err := c.HTTP.Do(request)
+	exchange.Finish(httpResponse, err)
+	call.End(httpResponse, err)
+	c.limiter.Update(httpResponse)
 	if err != nil {
 		return
 	}
@@ -55,6 +55,7 @@ func TestClient_Do(t *testing.T) {
 	}
 
 	for _, testCase := range testCases {
+		testCase := testCase
 
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
@@ -81,7 +82,7 @@ func TestClient_Do(t *testing.T) {
 			}
 
 			//Init the mocked HTTP request
-			requestMocked, err := mockClient.newRequest(testCase.context, testCase.wantHTTPMethod, testCase.endpoint, nil)
+			requestMocked, err := mockClient.newRequest(testCase.context, testCase.wantHTTPMethod, "", testCase.endpoint, nil)
 			if testCase.wantErr {
 
 				if err != nil {
@@ -133,16 +134,16 @@ func startMockServer(opts *mockServerOptions) (*httptest.Server, error) {
 
 			if r.URL.Query().Encode() != "" {
 
-				var pathWithQueries = fmt.Sprintf("%v?%v", r.URL.Path, r.URL.Query().Encode())
+				var pathWithQueries = fmt.Sprintf("%v?%v", r.URL.EscapedPath(), r.URL.Query().Encode())
 
 				if pathWithQueries != opts.Endpoint {
-					http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.Path, opts.Endpoint), 400)
+					http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.EscapedPath(), opts.Endpoint), 400)
 					return
 				}
 
 			} else {
-				if r.URL.Path != opts.Endpoint {
-					http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.Path, opts.Endpoint), 400)
+				if r.URL.EscapedPath() != opts.Endpoint {
+					http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.EscapedPath(), opts.Endpoint), 400)
 					return
 				}
 			}
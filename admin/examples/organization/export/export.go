@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+)
+
+func main() {
+
+	//ATLASSIAN_ADMIN_TOKEN
+	var apiKey = os.Getenv("ATLASSIAN_ADMIN_TOKEN")
+
+	cloudAdmin, err := admin.New(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cloudAdmin.Auth.SetBearerToken(apiKey)
+	cloudAdmin.Auth.SetUserAgent("curl/7.54.0")
+
+	var organizationID = "9a1jj823-jac8-123d-jj01-63315k059cb2"
+
+	output, err := os.OpenFile("events.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer output.Close()
+
+	opts := &admin.OrganizationEventExportOptionsScheme{
+		Format:       admin.OrganizationEventSyslogFormat,
+		Writer:       output,
+		Checkpoint:   &admin.OrganizationEventFileCheckpoint{Path: "events.checkpoint.json"},
+		Since:        time.Now().Add(time.Duration(-24) * time.Hour),
+		Overlap:      10 * time.Minute,
+		PollInterval: 5 * time.Minute,
+		Hostname:     "go-atlassian-exporter",
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err = cloudAdmin.Organization.Tail(ctx, organizationID, opts, func(result *admin.OrganizationEventExportResultScheme) {
+		log.Println("Events exported", result.Exported, "duplicated", result.Duplicated, "checkpoint", result.Checkpoint.Time)
+	})
+
+	if err != nil && err != context.Canceled {
+		log.Fatal(err)
+	}
+}
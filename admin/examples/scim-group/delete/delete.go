@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"log"
+	"os"
+)
+
+func main() {
+
+	//ATLASSIAN_ADMIN_TOKEN
+	var scimApiKey = os.Getenv("ATLASSIAN_SCIM_API_KEY")
+
+	cloudAdmin, err := admin.New(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cloudAdmin.Auth.SetBearerToken(scimApiKey)
+	cloudAdmin.Auth.SetUserAgent("curl/7.54.0")
+
+	var (
+		directoryID = "bcdde508-ee40-4df2-89cc-d3f6292c5971"
+		groupID     = "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a"
+	)
+
+	response, err := cloudAdmin.SCIM.Group.Delete(context.Background(), directoryID, groupID)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"log"
+	"os"
+)
+
+func main() {
+
+	//ATLASSIAN_ADMIN_TOKEN
+	var scimApiKey = os.Getenv("ATLASSIAN_SCIM_API_KEY")
+
+	cloudAdmin, err := admin.New(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cloudAdmin.Auth.SetBearerToken(scimApiKey)
+	cloudAdmin.Auth.SetUserAgent("curl/7.54.0")
+
+	var (
+		directoryID = "bcdde508-ee40-4df2-89cc-d3f6292c5971"
+		groupID     = "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a"
+	)
+
+	payload := &admin.SCIMGroupPathScheme{}
+
+	if err = payload.AddMembers([]string{"ef5ff80e-9ca6-449c-8cca-5b621085c6c9"}); err != nil {
+		log.Fatal(err)
+	}
+
+	if err = payload.RemoveMembers([]string{"0f8b2c1e-6a4d-4f2b-b7c3-8e1d5a9c4b20"}); err != nil {
+		log.Fatal(err)
+	}
+
+	group, response, err := cloudAdmin.SCIM.Group.Update(context.Background(), directoryID, groupID, payload, nil, nil)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+	log.Println(group.DisplayName, len(group.Members))
+}
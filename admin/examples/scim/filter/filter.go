@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"log"
+	"os"
+)
+
+func main() {
+
+	//ATLASSIAN_ADMIN_TOKEN
+	var scimApiKey = os.Getenv("ATLASSIAN_SCIM_API_KEY")
+
+	cloudAdmin, err := admin.New(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cloudAdmin.Auth.SetBearerToken(scimApiKey)
+	cloudAdmin.Auth.SetUserAgent("curl/7.54.0")
+
+	var directoryID = "bcdde508-ee40-4df2-89cc-d3f6292c5971"
+
+	// Build the filter, the values are quoted and escaped
+	filter := admin.SCIMAnd(
+		admin.SCIMEq("active", true),
+		admin.SCIMValuePath("emails", admin.SCIMAnd(
+			admin.SCIMEq("type", "work"),
+			admin.SCIMEw("value", "@go-atlassian.io"),
+		)),
+	)
+
+	// Or validate a filter provided by the user
+	if _, err = admin.ParseSCIMFilter(filter.String()); err != nil {
+		log.Fatal(err)
+	}
+
+	options := &admin.SCIMUserGetsOptionsScheme{Filter: filter.String()}
+
+	users, response, err := cloudAdmin.SCIM.User.Gets(context.Background(), directoryID, options, 1, 50)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+
+	for _, user := range users.Resources {
+		log.Println(user.ID, user.UserName)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	//ATLASSIAN_ADMIN_TOKEN
+	var scimApiKey = os.Getenv("ATLASSIAN_SCIM_API_KEY")
+
+	cloudAdmin, err := admin.New(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cloudAdmin.Auth.SetBearerToken(scimApiKey)
+	cloudAdmin.Auth.SetUserAgent("curl/7.54.0")
+
+	var directoryID = "bcdde508-ee40-4df2-89cc-d3f6292c5971"
+
+	desired := &admin.SCIMReconcileDesiredScheme{
+		Users: []*admin.SCIMUserScheme{
+			{
+				ExternalID:  "HR-1001",
+				UserName:    "example@go-atlassian.io",
+				DisplayName: "Example Display Name",
+				Emails: []*admin.SCIMUserEmailScheme{
+					{Value: "example@go-atlassian.io", Type: "work", Primary: true},
+				},
+				Department: "Engineering",
+			},
+		},
+		Groups: []*admin.SCIMReconcileGroupScheme{
+			{DisplayName: "jira-users", Members: []string{"HR-1001"}},
+		},
+	}
+
+	options := &admin.SCIMReconcileOptionsScheme{
+		DryRun:            true,
+		DeactivateMissing: true,
+		Interval:          200 * time.Millisecond,
+		MaxRetries:        3,
+	}
+
+	report, err := cloudAdmin.SCIM.Reconcile(context.Background(), directoryID, desired, options)
+	if err != nil && report == nil {
+		log.Fatal(err)
+	}
+
+	reportAsJSON, _ := json.MarshalIndent(report, "", "  ")
+	log.Println(string(reportAsJSON))
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+func main() {
+
+	//ATLASSIAN_ADMIN_TOKEN
+	var apiKey = os.Getenv("ATLASSIAN_ADMIN_TOKEN")
+
+	cloudAdmin, err := admin.New(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cloudAdmin.Auth.SetBearerToken(apiKey)
+	cloudAdmin.Auth.SetUserAgent("curl/7.54.0")
+
+	var organizationID = "9a1jj823-jac8-123d-jj01-63315k059cb2"
+
+	opts := &admin.UserTokenReportOptionsScheme{
+		RotationDays:  365,
+		UnusedDays:    90,
+		NeverUsedDays: 14,
+		Interval:      200 * time.Millisecond,
+		MaxRetries:    3,
+	}
+
+	report, err := cloudAdmin.User.Token.Report(context.Background(), organizationID, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("Accounts", report.Accounts, "Tokens", report.Tokens, "Findings", len(report.Findings))
+
+	// Ask for the approval of every revocation
+	reader := bufio.NewReader(os.Stdin)
+	approve := func(finding *admin.UserTokenFindingScheme) bool {
+
+		fmt.Printf("Revoke the token %v (%v) of %v, reasons: %v? [y/N] ", finding.Label, finding.TokenID,
+			finding.Email, strings.Join(finding.Reasons, ", "))
+
+		answer, _ := reader.ReadString('\n')
+		return strings.EqualFold(strings.TrimSpace(answer), "y")
+	}
+
+	if err = cloudAdmin.User.Token.Revoke(context.Background(), report, approve, opts); err != nil {
+		log.Fatal(err)
+	}
+
+	auditTrail, err := os.Create("api-tokens-audit.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer auditTrail.Close()
+
+	if err = report.WriteCSV(auditTrail); err != nil {
+		log.Fatal(err)
+	}
+}
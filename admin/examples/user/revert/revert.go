@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	//ATLASSIAN_ADMIN_TOKEN
+	var apiKey = os.Getenv("ATLASSIAN_ADMIN_TOKEN")
+
+	cloudAdmin, err := admin.New(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cloudAdmin.Auth.SetBearerToken(apiKey)
+	cloudAdmin.Auth.SetUserAgent("curl/7.54.0")
+
+	undoLog, err := os.Open("disabled-accounts.jsonl")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer undoLog.Close()
+
+	result, err := cloudAdmin.User.Revert(context.Background(), undoLog, &admin.UserLifecycleOptionsScheme{
+		Interval:   500 * time.Millisecond,
+		MaxRetries: 3,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("Enabled accounts", result.Succeeded)
+
+	for _, failed := range result.Failed {
+		log.Println("Failed account", failed.AccountID, failed.Error)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	//ATLASSIAN_ADMIN_TOKEN
+	var apiKey = os.Getenv("ATLASSIAN_ADMIN_TOKEN")
+
+	cloudAdmin, err := admin.New(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cloudAdmin.Auth.SetBearerToken(apiKey)
+	cloudAdmin.Auth.SetUserAgent("curl/7.54.0")
+
+	var organizationID = "9a1jj823-jac8-123d-jj01-63315k059cb2"
+
+	plan, err := cloudAdmin.User.Stale(context.Background(), organizationID, &admin.UserStaleOptionsScheme{
+		InactiveDays:        90,
+		ProductInactiveDays: map[string]int{"jira-servicedesk": 30},
+		BillableOnly:        true,
+		ExcludedEmails:      []string{"automation@go-atlassian.io"},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, account := range plan.Accounts {
+		log.Println("Stale account", account.AccountID, account.Email, account.InactiveDays)
+	}
+
+	for _, account := range plan.Excluded {
+		log.Println("Excluded account", account.AccountID, account.Email, account.Reason)
+	}
+
+	// The undo log is used to re-enable the accounts disabled by this run with the Revert method
+	undoLog, err := os.Create("disabled-accounts.jsonl")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer undoLog.Close()
+
+	result, err := cloudAdmin.User.DisableBulk(context.Background(), plan, &admin.UserLifecycleOptionsScheme{
+		Message:    "Your account was disabled after 90 days of inactivity, contact the IT team to enable it.",
+		Interval:   500 * time.Millisecond,
+		MaxRetries: 3,
+		UndoLog:    undoLog,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("Disabled accounts", len(result.Succeeded))
+
+	for _, failed := range result.Failed {
+		log.Println("Failed account", failed.AccountID, failed.Error)
+	}
+}
@@ -0,0 +1,16 @@
+package admin
+
+import "github.com/ctreminiom/go-atlassian/internal/httplog"
+
+// Logger is implemented by *slog.Logger and the loggers with the same Debug and Error key/value methods
+type Logger = httplog.Logger
+
+// LoggerOptionsScheme configures the dump of the bodies and the redacted JSON paths,
+// e.g: &LoggerOptionsScheme{DumpBody: true, RedactPaths: []string{"data.*.email"}}
+type LoggerOptionsScheme = httplog.Options
+
+// SetLogger logs the requests of the client and the retries of the rate limited bulk operations,
+// the nil logger disables the logs
+func (c *Client) SetLogger(logger Logger, options *LoggerOptionsScheme) {
+	c.logger = httplog.New(logger, options)
+}
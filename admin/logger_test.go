@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/internal/httplog/httplogtest"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_SetLogger(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient.Auth.SetBearerToken("ADMIN_API_KEY")
+
+	logger := &httplogtest.Recorder{}
+	mockClient.SetLogger(logger, &LoggerOptionsScheme{DumpBody: true})
+
+	_, _, err = mockClient.Organization.Gets(context.Background(), "")
+	assert.NoError(t, err)
+
+	if entries := logger.Entries(); assert.Len(t, entries, 1) {
+		assert.Equal(t, mockServer.URL+"/admin/v1/orgs", entries[0].Args["endpoint"])
+		assert.NotContains(t, entries[0].Args["request.headers"], "ADMIN_API_KEY")
+	}
+
+	// The nil logger disables the logs
+	mockClient.SetLogger(nil, nil)
+
+	_, _, err = mockClient.Organization.Gets(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Len(t, logger.Entries(), 1)
+}
+
+func TestRequestThrottle_Logger(t *testing.T) {
+
+	mockClient, err := startMockClient("https://api.atlassian.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := &httplogtest.Recorder{}
+	mockClient.SetLogger(logger, nil)
+
+	var (
+		throttle = &requestThrottle{maxRetries: 2, logger: mockClient.logger}
+		calls    int
+	)
+
+	err = throttle.call(context.Background(), func(ctx context.Context) (*Response, error) {
+
+		calls++
+
+		if calls == 1 {
+			return &Response{
+				StatusCode: http.StatusTooManyRequests,
+				Method:     http.MethodPost,
+				Endpoint:   "https://api.atlassian.com/users/5b86be50b8e3cb5895860d6d/manage/lifecycle/disable",
+				Headers:    map[string][]string{"Retry-After": {"0"}},
+			}, assert.AnError
+		}
+
+		return &Response{StatusCode: http.StatusNoContent}, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	if assert.Equal(t, []string{"atlassian request retry"}, logger.Messages()) {
+		entry := logger.Entries()[0]
+		assert.Equal(t, "https://api.atlassian.com/users/5b86be50b8e3cb5895860d6d/manage/lifecycle/disable", entry.Args["endpoint"])
+		assert.Equal(t, http.StatusTooManyRequests, entry.Args["status"])
+		assert.Equal(t, 1, entry.Args["attempt"])
+	}
+}
@@ -29,7 +29,7 @@ func (o *OrganizationService) Gets(ctx context.Context, cursor string) (result *
 		endpoint = "/admin/v1/orgs"
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -62,7 +62,7 @@ func (o *OrganizationService) Get(ctx context.Context, organizationID string) (r
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -152,7 +152,7 @@ func (o *OrganizationService) Users(ctx context.Context, organizationID, cursor
 		endpoint = fmt.Sprintf("/admin/v1/orgs/%v/users", organizationID)
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}/users", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -221,7 +221,7 @@ func (o *OrganizationService) Domains(ctx context.Context, organizationID, curso
 		endpoint = fmt.Sprintf("/admin/v1/orgs/%v/domains", organizationID)
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}/domains", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -281,7 +281,7 @@ func (o *OrganizationService) Domain(ctx context.Context, organizationID, domain
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/domains/%v", organizationID, domainID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}/domains/{domainID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -371,7 +371,7 @@ func (o *OrganizationService) Events(ctx context.Context, organizationID string,
 		endpoint = fmt.Sprintf("/admin/v1/orgs/%v/events", organizationID)
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}/events", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -392,48 +392,7 @@ func (o *OrganizationService) Events(ctx context.Context, organizationID string,
 }
 
 type OrganizationEventPageScheme struct {
-	Data []struct {
-		ID         string `json:"id"`
-		Type       string `json:"type"`
-		Attributes struct {
-			Time   string `json:"time"`
-			Action string `json:"action"`
-			Actor  struct {
-				ID    string `json:"id"`
-				Name  string `json:"name"`
-				Links struct {
-					Self string `json:"self"`
-				} `json:"links"`
-			} `json:"actor"`
-			Context []struct {
-				ID         string `json:"id"`
-				Type       string `json:"type"`
-				Attributes struct {
-				} `json:"attributes"`
-				Links struct {
-					Self string `json:"self"`
-					Alt  string `json:"alt"`
-				} `json:"links"`
-			} `json:"context"`
-			Container []struct {
-				ID         string `json:"id"`
-				Type       string `json:"type"`
-				Attributes struct {
-				} `json:"attributes"`
-				Links struct {
-					Self string `json:"self"`
-					Alt  string `json:"alt"`
-				} `json:"links"`
-			} `json:"container"`
-			Location struct {
-				IP  string `json:"ip"`
-				Geo string `json:"geo"`
-			} `json:"location"`
-		} `json:"attributes"`
-		Links struct {
-			Self string `json:"self"`
-		} `json:"links"`
-	} `json:"data"`
+	Data []OrganizationEventDataScheme `json:"data"`
 	Meta struct {
 		Next     string `json:"next"`
 		PageSize int    `json:"page_size"`
@@ -461,7 +420,7 @@ func (o *OrganizationService) Event(ctx context.Context, organizationID, eventID
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/events/%v", organizationID, eventID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}/events/{eventID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -482,48 +441,52 @@ func (o *OrganizationService) Event(ctx context.Context, organizationID, eventID
 }
 
 type OrganizationEventScheme struct {
-	Data struct {
-		ID         string `json:"id"`
-		Type       string `json:"type"`
-		Attributes struct {
-			Time   string `json:"time"`
-			Action string `json:"action"`
-			Actor  struct {
-				ID    string `json:"id"`
-				Name  string `json:"name"`
-				Links struct {
-					Self string `json:"self"`
-				} `json:"links"`
-			} `json:"actor"`
-			Context []struct {
-				ID         string `json:"id"`
-				Type       string `json:"type"`
-				Attributes struct {
-				} `json:"attributes"`
-				Links struct {
-					Self string `json:"self"`
-					Alt  string `json:"alt"`
-				} `json:"links"`
-			} `json:"context"`
-			Container []struct {
-				ID         string `json:"id"`
-				Type       string `json:"type"`
-				Attributes struct {
-				} `json:"attributes"`
-				Links struct {
-					Self string `json:"self"`
-					Alt  string `json:"alt"`
-				} `json:"links"`
-			} `json:"container"`
-			Location struct {
-				IP  string `json:"ip"`
-				Geo string `json:"geo"`
-			} `json:"location"`
-		} `json:"attributes"`
-		Links struct {
-			Self string `json:"self"`
-		} `json:"links"`
-	} `json:"data"`
+	Data OrganizationEventDataScheme `json:"data"`
+}
+
+// OrganizationEventDataScheme is an alias of the event type of the OrganizationEventPageScheme and
+// OrganizationEventScheme data, so the event can be referenced by name, e.g: by the event export.
+type OrganizationEventDataScheme = struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Attributes struct {
+		Time   string `json:"time"`
+		Action string `json:"action"`
+		Actor  struct {
+			ID    string `json:"id"`
+			Name  string `json:"name"`
+			Links struct {
+				Self string `json:"self"`
+			} `json:"links"`
+		} `json:"actor"`
+		Context []struct {
+			ID         string `json:"id"`
+			Type       string `json:"type"`
+			Attributes struct {
+			} `json:"attributes"`
+			Links struct {
+				Self string `json:"self"`
+				Alt  string `json:"alt"`
+			} `json:"links"`
+		} `json:"context"`
+		Container []struct {
+			ID         string `json:"id"`
+			Type       string `json:"type"`
+			Attributes struct {
+			} `json:"attributes"`
+			Links struct {
+				Self string `json:"self"`
+				Alt  string `json:"alt"`
+			} `json:"links"`
+		} `json:"container"`
+		Location struct {
+			IP  string `json:"ip"`
+			Geo string `json:"geo"`
+		} `json:"location"`
+	} `json:"attributes"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
 }
 
 // Returns information localized event actions
@@ -539,7 +502,7 @@ func (o *OrganizationService) Actions(ctx context.Context, organizationID string
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/event-actions", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}/event-actions", endpoint, nil)
 	if err != nil {
 		return
 	}
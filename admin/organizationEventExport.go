@@ -0,0 +1,484 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/internal/retry"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	OrganizationEventJSONLinesFormat = "jsonl"
+	OrganizationEventCEFFormat       = "cef"
+	OrganizationEventSyslogFormat    = "syslog"
+)
+
+// OrganizationEventCheckpointScheme represents the position of the exporter in the organization audit log.
+// Time and LastEventID point to the last exported event, Events contains the events already exported
+// inside the overlap window, they're used to de-duplicate the events returned by the next export.
+type OrganizationEventCheckpointScheme struct {
+	Time        time.Time            `json:"time"`
+	LastEventID string               `json:"lastEventId,omitempty"`
+	Events      map[string]time.Time `json:"events,omitempty"`
+}
+
+// OrganizationEventCheckpointStore persists the exporter checkpoint, Load returns a nil checkpoint
+// when the exporter has not exported any event yet.
+type OrganizationEventCheckpointStore interface {
+	Load() (*OrganizationEventCheckpointScheme, error)
+	Save(checkpoint *OrganizationEventCheckpointScheme) error
+}
+
+// OrganizationEventFileCheckpoint stores the checkpoint as a JSON file,
+// the file is replaced atomically, so a crash never leaves a partial checkpoint.
+type OrganizationEventFileCheckpoint struct {
+	Path string
+}
+
+func (f *OrganizationEventFileCheckpoint) Load() (checkpoint *OrganizationEventCheckpointScheme, err error) {
+
+	content, err := ioutil.ReadFile(f.Path)
+	if err != nil {
+
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	checkpoint = new(OrganizationEventCheckpointScheme)
+	if err = json.Unmarshal(content, checkpoint); err != nil {
+		return nil, fmt.Errorf("error!, the checkpoint file %v is not valid: %v", f.Path, err)
+	}
+
+	return
+}
+
+func (f *OrganizationEventFileCheckpoint) Save(checkpoint *OrganizationEventCheckpointScheme) (err error) {
+
+	content, err := json.Marshal(checkpoint)
+	if err != nil {
+		return
+	}
+
+	temporary, err := ioutil.TempFile(filepath.Dir(f.Path), filepath.Base(f.Path)+".*.tmp")
+	if err != nil {
+		return
+	}
+
+	defer os.Remove(temporary.Name())
+
+	if _, err = temporary.Write(content); err != nil {
+		temporary.Close()
+		return
+	}
+
+	if err = temporary.Sync(); err != nil {
+		temporary.Close()
+		return
+	}
+
+	if err = temporary.Close(); err != nil {
+		return
+	}
+
+	return os.Rename(temporary.Name(), f.Path)
+}
+
+type OrganizationEventExportOptionsScheme struct {
+	Format     string                           // The output format: jsonl, cef or syslog (REQUIRED)
+	Writer     io.Writer                        // The destination of the exported events (REQUIRED)
+	Checkpoint OrganizationEventCheckpointStore // The checkpoint store, the export resumes from the saved checkpoint (REQUIRED)
+	Q          string                           // Single query term for searching events.
+	Action     string                           // Returns events of a specific action type.
+	Since      time.Time                        // The earliest date of the events, used when the store has not a checkpoint yet.
+
+	// Overlap is the window re-read before the checkpoint, it catches the events indexed
+	// late by Atlassian, the events already exported in the window are skipped.
+	Overlap time.Duration
+
+	// PollInterval is the time between exports when the events are tailed, the default value is 1 minute.
+	PollInterval time.Duration
+
+	Hostname string // The syslog HOSTNAME field, the default value is the nil value "-"
+	AppName  string // The syslog APP-NAME field, the default value is "go-atlassian"
+}
+
+type OrganizationEventExportResultScheme struct {
+	Exported   int                                // The number of events written
+	Duplicated int                                // The number of events skipped because they were already exported
+	Checkpoint *OrganizationEventCheckpointScheme // The checkpoint after the export
+}
+
+// Export writes the organization events created after the saved checkpoint, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. organizationID = ID of the organization (REQUIRED)
+// 3. opts = the export options, the format, writer and checkpoint store are required (REQUIRED)
+// The events are written in chronological order and the checkpoint is saved after every event,
+// so an interrupted export can be resumed without gaps or duplicates.
+// Library Docs: N/A
+func (o *OrganizationService) Export(ctx context.Context, organizationID string, opts *OrganizationEventExportOptionsScheme) (result *OrganizationEventExportResultScheme, err error) {
+
+	if len(organizationID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid organizationID value")
+	}
+
+	if err = validateOrganizationEventExportOptions(opts); err != nil {
+		return nil, err
+	}
+
+	checkpoint, err := opts.Checkpoint.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	if checkpoint == nil {
+		checkpoint = &OrganizationEventCheckpointScheme{Time: opts.Since}
+	}
+
+	if checkpoint.Events == nil {
+		checkpoint.Events = make(map[string]time.Time)
+	}
+
+	windowStart := checkpoint.Time.Add(-opts.Overlap)
+	eventOptions := &OrganizationEventOptScheme{Q: opts.Q, Action: opts.Action}
+	if !windowStart.IsZero() && !checkpoint.Time.IsZero() {
+		eventOptions.From = windowStart
+	}
+
+	events, err := o.exportEvents(ctx, organizationID, eventOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	result = &OrganizationEventExportResultScheme{Checkpoint: checkpoint}
+
+	for _, event := range events {
+
+		if _, exported := checkpoint.Events[event.data.ID]; exported || event.time.Before(windowStart) {
+			result.Duplicated++
+			continue
+		}
+
+		line, err := encodeOrganizationEvent(opts, event.data, event.time)
+		if err != nil {
+			return result, err
+		}
+
+		if _, err = opts.Writer.Write(line); err != nil {
+			return result, err
+		}
+
+		checkpoint.advance(event.data.ID, event.time, opts.Overlap)
+
+		if err = opts.Checkpoint.Save(checkpoint); err != nil {
+			return result, err
+		}
+
+		result.Exported++
+	}
+
+	return result, nil
+}
+
+// Tail exports the organization events every poll interval until the context is cancelled, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. organizationID = ID of the organization (REQUIRED)
+// 3. opts = the export options, the format, writer and checkpoint store are required (REQUIRED)
+// 4. callback = optional func called with the result of every export
+// Library Docs: N/A
+func (o *OrganizationService) Tail(ctx context.Context, organizationID string, opts *OrganizationEventExportOptionsScheme, callback func(result *OrganizationEventExportResultScheme)) (err error) {
+
+	if err = validateOrganizationEventExportOptions(opts); err != nil {
+		return err
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	for {
+
+		result, err := o.Export(ctx, organizationID, opts)
+		if err != nil {
+			return err
+		}
+
+		if callback != nil {
+			callback(result)
+		}
+
+		if err = retry.Sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+type organizationExportEvent struct {
+	data *OrganizationEventDataScheme
+	time time.Time
+}
+
+// exportEvents pages through the events window, the events created while the pages are fetched can
+// shift the cursor pages, so the repeated events are removed before they are sorted.
+func (o *OrganizationService) exportEvents(ctx context.Context, organizationID string, opts *OrganizationEventOptScheme) (events []*organizationExportEvent, err error) {
+
+	var (
+		cursor  string
+		fetched = make(map[string]bool)
+	)
+
+	for {
+
+		page, _, err := o.Events(ctx, organizationID, opts, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range page.Data {
+
+			data := &page.Data[index]
+			if fetched[data.ID] {
+				continue
+			}
+
+			eventTime, err := time.Parse(time.RFC3339Nano, data.Attributes.Time)
+			if err != nil {
+				return nil, fmt.Errorf("error!, the event %v has an invalid time %v", data.ID, data.Attributes.Time)
+			}
+
+			fetched[data.ID] = true
+			events = append(events, &organizationExportEvent{data: data, time: eventTime})
+		}
+
+		next := page.Meta.Next
+		if len(next) == 0 {
+
+			if next, err = nextCursor(page.Links.Next); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(next) == 0 || next == cursor || len(page.Data) == 0 {
+			break
+		}
+
+		cursor = next
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+
+		if !events[i].time.Equal(events[j].time) {
+			return events[i].time.Before(events[j].time)
+		}
+
+		return events[i].data.ID < events[j].data.ID
+	})
+
+	return events, nil
+}
+
+// advance moves the checkpoint to the exported event and forgets the events outside the overlap window.
+func (c *OrganizationEventCheckpointScheme) advance(eventID string, eventTime time.Time, overlap time.Duration) {
+
+	if eventTime.After(c.Time) {
+		c.Time = eventTime
+	}
+
+	c.LastEventID = eventID
+	c.Events[eventID] = eventTime
+
+	windowStart := c.Time.Add(-overlap)
+	for id, exportedAt := range c.Events {
+		if exportedAt.Before(windowStart) {
+			delete(c.Events, id)
+		}
+	}
+}
+
+func validateOrganizationEventExportOptions(opts *OrganizationEventExportOptionsScheme) error {
+
+	if opts == nil {
+		return fmt.Errorf("error!, please provide a valid OrganizationEventExportOptionsScheme pointer")
+	}
+
+	switch opts.Format {
+	case OrganizationEventJSONLinesFormat, OrganizationEventCEFFormat, OrganizationEventSyslogFormat:
+	default:
+		return fmt.Errorf("error!, the format %v is not supported, use %v, %v or %v", opts.Format,
+			OrganizationEventJSONLinesFormat, OrganizationEventCEFFormat, OrganizationEventSyslogFormat)
+	}
+
+	if opts.Writer == nil {
+		return fmt.Errorf("error!, please provide a valid io.Writer value")
+	}
+
+	if opts.Checkpoint == nil {
+		return fmt.Errorf("error!, please provide a valid OrganizationEventCheckpointStore value")
+	}
+
+	if opts.Overlap < 0 {
+		return fmt.Errorf("error!, the overlap window can't be negative")
+	}
+
+	return nil
+}
+
+func encodeOrganizationEvent(opts *OrganizationEventExportOptionsScheme, event *OrganizationEventDataScheme, eventTime time.Time) ([]byte, error) {
+
+	switch opts.Format {
+	case OrganizationEventCEFFormat:
+		return encodeOrganizationEventCEF(event, eventTime), nil
+	case OrganizationEventSyslogFormat:
+		return encodeOrganizationEventSyslog(opts, event, eventTime)
+	default:
+		return encodeOrganizationEventJSON(event)
+	}
+}
+
+func encodeOrganizationEventJSON(event *OrganizationEventDataScheme) ([]byte, error) {
+
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+
+	// The encoder appends the new line required by the JSON Lines format
+	if err := encoder.Encode(event); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// encodeOrganizationEventCEF renders the event as an ArcSight Common Event Format line:
+// CEF:Version|Device Vendor|Device Product|Device Version|Signature ID|Name|Severity|Extension
+func encodeOrganizationEventCEF(event *OrganizationEventDataScheme, eventTime time.Time) []byte {
+
+	var (
+		attributes = event.Attributes
+		header     = []string{"CEF:0", "Atlassian", "Organization Admin", "1.0", attributes.Action, attributes.Action, "3"}
+		extension  []string
+	)
+
+	for index := 1; index < len(header); index++ {
+		header[index] = cefHeaderReplacer.Replace(header[index])
+	}
+
+	addExtension := func(key, value string) {
+		if len(value) != 0 {
+			extension = append(extension, key+"="+cefExtensionReplacer.Replace(value))
+		}
+	}
+
+	addExtension("rt", strconv.FormatInt(eventTime.UnixNano()/int64(time.Millisecond), 10))
+	addExtension("externalId", event.ID)
+	addExtension("act", attributes.Action)
+	addExtension("suid", attributes.Actor.ID)
+	addExtension("suser", attributes.Actor.Name)
+	addExtension("src", attributes.Location.IP)
+
+	if len(attributes.Location.Geo) != 0 {
+		addExtension("cs1Label", "geo")
+		addExtension("cs1", attributes.Location.Geo)
+	}
+
+	var containers, contexts []string
+	for _, container := range attributes.Container {
+		containers = append(containers, container.Type+"/"+container.ID)
+	}
+
+	for _, eventContext := range attributes.Context {
+		contexts = append(contexts, eventContext.Type+"/"+eventContext.ID)
+	}
+
+	if len(containers) != 0 {
+		addExtension("cs2Label", "container")
+		addExtension("cs2", strings.Join(containers, ","))
+	}
+
+	if len(contexts) != 0 {
+		addExtension("cs3Label", "context")
+		addExtension("cs3", strings.Join(contexts, ","))
+	}
+
+	return []byte(strings.Join(header, "|") + "|" + strings.Join(extension, " ") + "\n")
+}
+
+var (
+	cefHeaderReplacer    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionReplacer = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+// encodeOrganizationEventSyslog renders the event as a RFC 5424 message with the facility log audit (13)
+// and the severity informational (6), the MSG part contains the event encoded as JSON.
+func encodeOrganizationEventSyslog(opts *OrganizationEventExportOptionsScheme, event *OrganizationEventDataScheme, eventTime time.Time) ([]byte, error) {
+
+	message, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		hostname  = syslogHeaderField(opts.Hostname, 255)
+		appName   = syslogHeaderField(opts.AppName, 48)
+		messageID = syslogHeaderField(event.Attributes.Action, 32)
+	)
+
+	if len(opts.AppName) == 0 {
+		appName = "go-atlassian"
+	}
+
+	line := fmt.Sprintf("<%d>1 %v %v %v - %v - %s\n", 13*8+6, eventTime.UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		hostname, appName, messageID, message)
+
+	return []byte(line), nil
+}
+
+// syslogHeaderField returns the value with the printable US-ASCII characters allowed in the syslog header,
+// the empty values are replaced with the nil value "-".
+func syslogHeaderField(value string, length int) string {
+
+	field := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+
+	if len(field) > length {
+		field = field[:length]
+	}
+
+	if len(field) == 0 {
+		return "-"
+	}
+
+	return field
+}
+
+// nextCursor returns the cursor query parameter of the next page link, the empty link returns an empty cursor
+func nextCursor(next string) (cursor string, err error) {
+
+	if len(next) == 0 {
+		return "", nil
+	}
+
+	nextAsURL, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+
+	return nextAsURL.Query().Get("cursor"), nil
+}
@@ -0,0 +1,393 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeOrganizationAuditLog serves the events endpoint with the newest events first,
+// the cursor is the offset of the next page and the page size is 2 events.
+type fakeOrganizationAuditLog struct {
+	mu     sync.Mutex
+	events []*OrganizationEventDataScheme
+}
+
+func (f *fakeOrganizationAuditLog) add(id, action string, at time.Time) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	event := &OrganizationEventDataScheme{ID: id, Type: "events"}
+	event.Attributes.Time = at.UTC().Format(time.RFC3339Nano)
+	event.Attributes.Action = action
+	event.Attributes.Actor.ID = "6066553090e3950069df8558"
+	event.Attributes.Actor.Name = "go-atlassian-demo-api"
+	event.Attributes.Location.IP = "201.202.14.42"
+
+	f.events = append(f.events, event)
+	sort.SliceStable(f.events, func(i, j int) bool { return f.events[i].Attributes.Time > f.events[j].Attributes.Time })
+}
+
+func (f *fakeOrganizationAuditLog) handler(t *testing.T) http.Handler {
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if r.URL.Path != "/admin/v1/orgs/organization-id/events" {
+			t.Errorf("unexpected endpoint %v", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var matched []*OrganizationEventDataScheme
+		for _, event := range f.events {
+
+			if from := r.URL.Query().Get("from"); len(from) != 0 {
+
+				seconds, _ := strconv.ParseInt(from, 10, 64)
+				eventTime, _ := time.Parse(time.RFC3339Nano, event.Attributes.Time)
+
+				if eventTime.Before(time.Unix(seconds, 0)) {
+					continue
+				}
+			}
+
+			matched = append(matched, event)
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		page := &OrganizationEventPageScheme{}
+
+		for index := offset; index < len(matched) && index < offset+2; index++ {
+			page.Data = append(page.Data, *matched[index])
+		}
+
+		if offset+2 < len(matched) {
+			page.Meta.Next = strconv.Itoa(offset + 2)
+		}
+
+		_ = json.NewEncoder(w).Encode(page)
+	})
+}
+
+type memoryOrganizationEventCheckpoint struct {
+	content []byte
+}
+
+func (m *memoryOrganizationEventCheckpoint) Load() (*OrganizationEventCheckpointScheme, error) {
+
+	if m.content == nil {
+		return nil, nil
+	}
+
+	checkpoint := new(OrganizationEventCheckpointScheme)
+	return checkpoint, json.Unmarshal(m.content, checkpoint)
+}
+
+func (m *memoryOrganizationEventCheckpoint) Save(checkpoint *OrganizationEventCheckpointScheme) (err error) {
+	m.content, err = json.Marshal(checkpoint)
+	return
+}
+
+type failingOrganizationEventWriter struct {
+	writes int
+	buffer bytes.Buffer
+}
+
+func (f *failingOrganizationEventWriter) Write(p []byte) (int, error) {
+
+	if f.writes == 0 {
+		return 0, errors.New("the SIEM connection was closed")
+	}
+
+	f.writes--
+	return f.buffer.Write(p)
+}
+
+func exportedOrganizationEventIDs(t *testing.T, output string) (ids []string) {
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+
+		if len(line) == 0 {
+			continue
+		}
+
+		event := new(OrganizationEventDataScheme)
+		if err := json.Unmarshal([]byte(line), event); err != nil {
+			t.Fatal(err)
+		}
+
+		ids = append(ids, event.ID)
+	}
+
+	return
+}
+
+func TestOrganizationService_Export(t *testing.T) {
+
+	var base = time.Date(2021, 4, 3, 6, 12, 11, 117451000, time.UTC)
+
+	t.Run("ExportWhenTheEventsAreExportedIncrementally", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog := &fakeOrganizationAuditLog{}
+		auditLog.add("event-1", "policy_created", base)
+		auditLog.add("event-2", "policy_updated", base.Add(time.Minute))
+		auditLog.add("event-3", "policy_updated", base.Add(time.Minute))
+
+		mockServer := httptest.NewServer(auditLog.handler(t))
+		defer mockServer.Close()
+
+		mockClient, err := startMockClient(mockServer.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var (
+			output = new(bytes.Buffer)
+			store  = &memoryOrganizationEventCheckpoint{}
+			opts   = &OrganizationEventExportOptionsScheme{
+				Format:     OrganizationEventJSONLinesFormat,
+				Writer:     output,
+				Checkpoint: store,
+				Overlap:    10 * time.Minute,
+			}
+		)
+
+		result, err := mockClient.Organization.Export(context.Background(), "organization-id", opts)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.Exported)
+		assert.Equal(t, []string{"event-1", "event-2", "event-3"}, exportedOrganizationEventIDs(t, output.String()))
+
+		// The late event is inside the overlap window, the exported events must be skipped
+		auditLog.add("event-4", "user_added", base.Add(2*time.Minute))
+		auditLog.add("event-0", "user_removed", base.Add(30*time.Second))
+
+		output.Reset()
+		result, err = mockClient.Organization.Export(context.Background(), "organization-id", opts)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Exported)
+		assert.Equal(t, 3, result.Duplicated)
+		assert.Equal(t, []string{"event-0", "event-4"}, exportedOrganizationEventIDs(t, output.String()))
+
+		checkpoint, err := store.Load()
+		assert.NoError(t, err)
+		assert.True(t, checkpoint.Time.Equal(base.Add(2*time.Minute)))
+		assert.Equal(t, "event-4", checkpoint.LastEventID)
+
+		// Nothing new, the export must be idempotent
+		output.Reset()
+		result, err = mockClient.Organization.Export(context.Background(), "organization-id", opts)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Exported)
+		assert.Empty(t, output.String())
+	})
+
+	t.Run("ExportWhenTheExportIsResumedAfterAFailure", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog := &fakeOrganizationAuditLog{}
+		for index := 1; index <= 5; index++ {
+			auditLog.add(fmt.Sprintf("event-%v", index), "user_added", base.Add(time.Duration(index)*time.Second))
+		}
+
+		mockServer := httptest.NewServer(auditLog.handler(t))
+		defer mockServer.Close()
+
+		mockClient, err := startMockClient(mockServer.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		writer := &failingOrganizationEventWriter{writes: 2}
+		opts := &OrganizationEventExportOptionsScheme{
+			Format:     OrganizationEventJSONLinesFormat,
+			Writer:     writer,
+			Checkpoint: &OrganizationEventFileCheckpoint{Path: filepath.Join(t.TempDir(), "checkpoint.json")},
+		}
+
+		result, err := mockClient.Organization.Export(context.Background(), "organization-id", opts)
+		assert.Error(t, err)
+		assert.Equal(t, 2, result.Exported)
+
+		writer.writes = 10
+		result, err = mockClient.Organization.Export(context.Background(), "organization-id", opts)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.Exported)
+
+		assert.Equal(t, []string{"event-1", "event-2", "event-3", "event-4", "event-5"},
+			exportedOrganizationEventIDs(t, writer.buffer.String()))
+	})
+
+	testCases := []struct {
+		name           string
+		organizationID string
+		opts           *OrganizationEventExportOptionsScheme
+	}{
+		{
+			name:           "ExportWhenTheOrganizationIDIsNotProvided",
+			organizationID: "",
+			opts: &OrganizationEventExportOptionsScheme{
+				Format:     OrganizationEventCEFFormat,
+				Writer:     new(bytes.Buffer),
+				Checkpoint: &memoryOrganizationEventCheckpoint{},
+			},
+		},
+		{
+			name:           "ExportWhenTheOptionsAreNotProvided",
+			organizationID: "organization-id",
+			opts:           nil,
+		},
+		{
+			name:           "ExportWhenTheFormatIsNotSupported",
+			organizationID: "organization-id",
+			opts: &OrganizationEventExportOptionsScheme{
+				Format:     "leef",
+				Writer:     new(bytes.Buffer),
+				Checkpoint: &memoryOrganizationEventCheckpoint{},
+			},
+		},
+		{
+			name:           "ExportWhenTheWriterIsNotProvided",
+			organizationID: "organization-id",
+			opts: &OrganizationEventExportOptionsScheme{
+				Format:     OrganizationEventSyslogFormat,
+				Checkpoint: &memoryOrganizationEventCheckpoint{},
+			},
+		},
+		{
+			name:           "ExportWhenTheCheckpointStoreIsNotProvided",
+			organizationID: "organization-id",
+			opts: &OrganizationEventExportOptionsScheme{
+				Format: OrganizationEventSyslogFormat,
+				Writer: new(bytes.Buffer),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient, err := startMockClient("https://api.atlassian.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = mockClient.Organization.Export(context.Background(), testCase.organizationID, testCase.opts)
+
+			if err != nil {
+				t.Logf("error returned: %v", err.Error())
+			}
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestOrganizationService_Tail(t *testing.T) {
+
+	auditLog := &fakeOrganizationAuditLog{}
+	auditLog.add("event-1", "policy_created", time.Date(2021, 4, 3, 6, 12, 11, 0, time.UTC))
+
+	mockServer := httptest.NewServer(auditLog.handler(t))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		output  = new(bytes.Buffer)
+		exports int
+	)
+
+	opts := &OrganizationEventExportOptionsScheme{
+		Format:       OrganizationEventJSONLinesFormat,
+		Writer:       output,
+		Checkpoint:   &memoryOrganizationEventCheckpoint{},
+		PollInterval: time.Millisecond,
+	}
+
+	err = mockClient.Organization.Tail(ctx, "organization-id", opts, func(result *OrganizationEventExportResultScheme) {
+
+		exports++
+
+		if exports == 1 {
+			auditLog.add("event-2", "policy_updated", time.Date(2021, 4, 3, 6, 13, 11, 0, time.UTC))
+			return
+		}
+
+		cancel()
+	})
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, []string{"event-1", "event-2"}, exportedOrganizationEventIDs(t, output.String()))
+}
+
+func TestEncodeOrganizationEvent(t *testing.T) {
+
+	event := &OrganizationEventDataScheme{ID: "25e4c3e7-7f0d-4b84-8028-330fed144a51", Type: "events"}
+	event.Attributes.Time = "2021-04-03T06:12:11.117451Z"
+	event.Attributes.Action = "policy_deleted"
+	event.Attributes.Actor.ID = "6066553090e3950069df8558"
+	event.Attributes.Actor.Name = "go-atlassian=demo|api"
+	event.Attributes.Location.IP = "201.202.14.42"
+
+	// The context items are anonymous structs of the event scheme
+	err := json.Unmarshal([]byte(`{"attributes": {"context": [{"id": "eaffa6f0-eb42-4b09-b2fb-0c7932187783", "type": "org-policy"}]}}`), event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eventTime, _ := time.Parse(time.RFC3339Nano, event.Attributes.Time)
+
+	t.Run("EncodeWhenTheFormatIsCEF", func(t *testing.T) {
+
+		got, err := encodeOrganizationEvent(&OrganizationEventExportOptionsScheme{Format: OrganizationEventCEFFormat}, event, eventTime)
+		assert.NoError(t, err)
+		assert.Equal(t, "CEF:0|Atlassian|Organization Admin|1.0|policy_deleted|policy_deleted|3|"+
+			"rt=1617430331117 externalId=25e4c3e7-7f0d-4b84-8028-330fed144a51 act=policy_deleted "+
+			`suid=6066553090e3950069df8558 suser=go-atlassian\=demo|api src=201.202.14.42 `+
+			"cs3Label=context cs3=org-policy/eaffa6f0-eb42-4b09-b2fb-0c7932187783\n", string(got))
+	})
+
+	t.Run("EncodeWhenTheFormatIsSyslog", func(t *testing.T) {
+
+		opts := &OrganizationEventExportOptionsScheme{Format: OrganizationEventSyslogFormat, Hostname: "siem collector"}
+
+		got, err := encodeOrganizationEvent(opts, event, eventTime)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(got),
+			"<110>1 2021-04-03T06:12:11.117451Z siem_collector go-atlassian - policy_deleted - {\"id\":\"25e4c3e7"))
+		assert.True(t, strings.HasSuffix(string(got), "}\n"))
+	})
+
+	t.Run("EncodeWhenTheFormatIsJSONLines", func(t *testing.T) {
+
+		got, err := encodeOrganizationEvent(&OrganizationEventExportOptionsScheme{Format: OrganizationEventJSONLinesFormat}, event, eventTime)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, strings.Count(string(got), "\n"))
+		assert.Equal(t, []string{event.ID}, exportedOrganizationEventIDs(t, string(got)))
+	})
+}
@@ -43,7 +43,7 @@ func (o *OrganizationPolicyService) Gets(ctx context.Context, organizationID, po
 		endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies", organizationID)
 	}
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}/policies", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -94,7 +94,7 @@ func (o *OrganizationPolicyService) Get(ctx context.Context, organizationID, pol
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v", organizationID, policyID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "admin/v1/orgs/{organizationID}/policies/{policyID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -153,7 +153,7 @@ func (o *OrganizationPolicyService) Create(ctx context.Context, organizationID s
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := o.client.newRequest(ctx, http.MethodPost, "admin/v1/orgs/{organizationID}/policies", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -196,7 +196,7 @@ func (o *OrganizationPolicyService) Update(ctx context.Context, organizationID,
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v", organizationID, policyID)
 
-	request, err := o.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := o.client.newRequest(ctx, http.MethodPut, "admin/v1/orgs/{organizationID}/policies/{policyID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -234,7 +234,7 @@ func (o *OrganizationPolicyService) Delete(ctx context.Context, organizationID,
 
 	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v", organizationID, policyID)
 
-	request, err := o.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodDelete, "admin/v1/orgs/{organizationID}/policies/{policyID}", endpoint, nil)
 	if err != nil {
 		return
 	}
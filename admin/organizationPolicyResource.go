@@ -1,3 +1,226 @@
 package admin
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
 type OrganizationPolicyResourceService struct{ client *Client }
+
+// OrganizationPolicyResourceProducts contains the products that can be added to each policy type,
+// the products are matched with the resource ARI, e.g: ari:cloud:jira::site/{siteId}
+// The policy types not included in the map are applied to the whole organization and don't support resources.
+var OrganizationPolicyResourceProducts = map[string][]string{
+	"data-residency": {"jira", "confluence"},
+	"ip-allowlist":   {"jira", "confluence"},
+}
+
+// Add a resource to a policy, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. organizationID = ID of the organization (REQUIRED)
+// 3. policyID = ID of the policy (REQUIRED)
+// 4. payload = The resource to add, the ID is the ARI of the resource (REQUIRED)
+// The policy is fetched before adding the resource, the products the policy type doesn't support are rejected
+// without calling the API, see OrganizationPolicyResourceProducts.
+// Official Docs: https://developer.atlassian.com/cloud/admin/organization/rest/api-group-orgs/#api-orgs-orgid-policies-policyid-resources-post
+// Library Example: N/A
+func (o *OrganizationPolicyResourceService) Add(ctx context.Context, organizationID, policyID string, payload *OrganizationPolicyResource) (result *OrganizationPolicyScheme, response *Response, err error) {
+
+	if len(organizationID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid organizationID value")
+	}
+
+	if len(policyID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid policyID value")
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error!, please provide a valid OrganizationPolicyResource pointer")
+	}
+
+	if _, err = organizationPolicyResourceProduct(payload.ID); err != nil {
+		return nil, nil, err
+	}
+
+	if response, err = o.validate(ctx, organizationID, policyID, payload); err != nil {
+		return nil, response, err
+	}
+
+	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v/resources", organizationID, policyID)
+
+	request, err := o.client.newRequest(ctx, http.MethodPost, "admin/v1/orgs/{organizationID}/policies/{policyID}/resources", endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = o.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(OrganizationPolicyScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Update a policy resource, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. organizationID = ID of the organization (REQUIRED)
+// 3. policyID = ID of the policy (REQUIRED)
+// 4. resourceID = The ARI of the resource to update (REQUIRED)
+// 5. payload = The resource information (REQUIRED)
+// The policy is fetched before updating the resource, the products the policy type doesn't support are rejected
+// without calling the API, see OrganizationPolicyResourceProducts.
+// Official Docs: https://developer.atlassian.com/cloud/admin/organization/rest/api-group-orgs/#api-orgs-orgid-policies-policyid-resources-resourceid-put
+// Library Example: N/A
+func (o *OrganizationPolicyResourceService) Update(ctx context.Context, organizationID, policyID, resourceID string, payload *OrganizationPolicyResource) (result *OrganizationPolicyScheme, response *Response, err error) {
+
+	if len(organizationID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid organizationID value")
+	}
+
+	if len(policyID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid policyID value")
+	}
+
+	if _, err = organizationPolicyResourceProduct(resourceID); err != nil {
+		return nil, nil, err
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error!, please provide a valid OrganizationPolicyResource pointer")
+	}
+
+	if response, err = o.validate(ctx, organizationID, policyID, &OrganizationPolicyResource{ID: resourceID}); err != nil {
+		return nil, response, err
+	}
+
+	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v/resources/%v", organizationID, policyID, url.PathEscape(resourceID))
+
+	request, err := o.client.newRequest(ctx, http.MethodPut, "admin/v1/orgs/{organizationID}/policies/{policyID}/resources/{resourceID}", endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = o.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(OrganizationPolicyScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Remove a resource from a policy, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. organizationID = ID of the organization (REQUIRED)
+// 3. policyID = ID of the policy (REQUIRED)
+// 4. resourceID = The ARI of the resource to remove (REQUIRED)
+// Official Docs: https://developer.atlassian.com/cloud/admin/organization/rest/api-group-orgs/#api-orgs-orgid-policies-policyid-resources-resourceid-delete
+// Library Example: N/A
+func (o *OrganizationPolicyResourceService) Remove(ctx context.Context, organizationID, policyID, resourceID string) (response *Response, err error) {
+
+	if len(organizationID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid organizationID value")
+	}
+
+	if len(policyID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid policyID value")
+	}
+
+	if len(resourceID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid resourceID value")
+	}
+
+	var endpoint = fmt.Sprintf("/admin/v1/orgs/%v/policies/%v/resources/%v", organizationID, policyID, url.PathEscape(resourceID))
+
+	request, err := o.client.newRequest(ctx, http.MethodDelete, "admin/v1/orgs/{organizationID}/policies/{policyID}/resources/{resourceID}", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = o.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// validate fetches the policy and checks it supports the product of the resource, the response is
+// returned when the policy can't be fetched.
+func (o *OrganizationPolicyResourceService) validate(ctx context.Context, organizationID, policyID string, resource *OrganizationPolicyResource) (*Response, error) {
+
+	policy, response, err := o.client.Organization.Policy.Get(ctx, organizationID, policyID)
+	if err != nil {
+		return response, err
+	}
+
+	return nil, ValidateOrganizationPolicyResource(&policy.Data, resource)
+}
+
+// ValidateOrganizationPolicyResource checks the policy supports the product of the resource, it's called by
+// the Add and Update methods with the policy returned by the OrganizationPolicyService.Get method.
+func ValidateOrganizationPolicyResource(policy *OrganizationPolicyData, resource *OrganizationPolicyResource) error {
+
+	if policy == nil || policy.Attributes == nil || len(policy.Attributes.Type) == 0 {
+		return fmt.Errorf("error!, please provide a valid OrganizationPolicyData pointer with the policy type")
+	}
+
+	if resource == nil {
+		return fmt.Errorf("error!, please provide a valid OrganizationPolicyResource pointer")
+	}
+
+	product, err := organizationPolicyResourceProduct(resource.ID)
+	if err != nil {
+		return err
+	}
+
+	products, ok := OrganizationPolicyResourceProducts[policy.Attributes.Type]
+	if !ok {
+		return fmt.Errorf("error!, the %v policies don't support resources", policy.Attributes.Type)
+	}
+
+	for _, supported := range products {
+		if supported == product {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("error!, the %v policies don't support %v resources, the supported products are: %v",
+		policy.Attributes.Type, product, strings.Join(products, ", "))
+}
+
+// organizationPolicyResourceProduct extracts the product from a resource ARI, e.g: ari:cloud:jira::site/{siteId}
+func organizationPolicyResourceProduct(resourceID string) (product string, err error) {
+
+	if len(resourceID) == 0 {
+		return "", fmt.Errorf("error!, please provide a valid resourceID value")
+	}
+
+	segments := strings.SplitN(resourceID, ":", 5)
+	if len(segments) != 5 || segments[0] != "ari" || segments[1] != "cloud" || len(segments[2]) == 0 || len(segments[4]) == 0 {
+		return "", fmt.Errorf("error!, the resourceID %v is not a valid resource ARI, e.g: ari:cloud:jira::site/{siteId}", resourceID)
+	}
+
+	return segments[2], nil
+}
@@ -0,0 +1,625 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestOrganizationPolicyResourceService_Add(t *testing.T) {
+
+	testCases := []struct {
+		name                     string
+		organizationID, policyID string
+		payload                  *OrganizationPolicyResource
+		policyMockFile, mockFile string
+		wantHTTPMethod           string
+		endpoint                 string
+		context                  context.Context
+		wantHTTPCodeReturn       int
+		wantWrites               int32
+		wantErr                  bool
+	}{
+		{
+			name:               "AddOrganizationPolicyResourceWhenTheParametersAreCorrect",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+			wantWrites:         1,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenTheResourceIDIsNotAnARI",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            &OrganizationPolicyResource{ID: "2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenThePayloadIsNil",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            nil,
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenThePolicyIDIsNotSet",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenTheOrganizationIDIsNotSet",
+			organizationID:     "",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenTheStatusCodeIsIncorrect",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+			wantWrites:         1,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenTheResponseBodyIsEmpty",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/empty.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+			wantWrites:         1,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenThePolicyDoesNotSupportResources",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenThePolicyDoesNotSupportTheProduct",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:bitbucket::workspace/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddOrganizationPolicyResourceWhenThePolicyIsNotFound",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			var writes int32
+			mockServer := startOrganizationPolicyResourceServer(testCase.policyMockFile, &mockOptions, &writes)
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &OrganizationPolicyResourceService{client: mockClient}
+			gotResult, gotResponse, err := service.Add(testCase.context, testCase.organizationID, testCase.policyID, testCase.payload)
+
+			// The requests rejected by the validation aren't sent to the API
+			assert.Equal(t, testCase.wantWrites, atomic.LoadInt32(&writes))
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				assert.Equal(t, "60f0f660-be3e-4d70-bd34-9c2858ec040f", gotResult.Data.ID)
+			}
+
+		})
+	}
+
+}
+
+func TestOrganizationPolicyResourceService_Update(t *testing.T) {
+
+	testCases := []struct {
+		name                                 string
+		organizationID, policyID, resourceID string
+		payload                              *OrganizationPolicyResource
+		policyMockFile, mockFile             string
+		wantHTTPMethod                       string
+		endpoint                             string
+		context                              context.Context
+		wantHTTPCodeReturn                   int
+		wantWrites                           int32
+		wantErr                              bool
+	}{
+		{
+			name:               "UpdateOrganizationPolicyResourceWhenTheParametersAreCorrect",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "ari:cloud:confluence::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:confluence::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/ari:cloud:confluence::site%2F2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+			wantWrites:         1,
+		},
+
+		{
+			name:               "UpdateOrganizationPolicyResourceWhenTheResourceIDIsNotSet",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "",
+			payload:            &OrganizationPolicyResource{},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateOrganizationPolicyResourceWhenThePayloadIsNil",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "ari:cloud:confluence::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			payload:            nil,
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/ari:cloud:confluence::site%2F2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateOrganizationPolicyResourceWhenTheRequestMethodIsIncorrect",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "ari:cloud:confluence::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:confluence::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/ari:cloud:confluence::site%2F2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+			wantWrites:         1,
+		},
+
+		{
+			name:               "UpdateOrganizationPolicyResourceWhenThePolicyDoesNotSupportTheProduct",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "ari:cloud:bitbucket::workspace/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:bitbucket::workspace/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy-ip-allowlist.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/ari:cloud:bitbucket::workspace%2F2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateOrganizationPolicyResourceWhenThePolicyDoesNotSupportResources",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "ari:cloud:confluence::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			payload:            &OrganizationPolicyResource{ID: "ari:cloud:confluence::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			policyMockFile:     "./mocks/get-organization-policy.json",
+			mockFile:           "./mocks/get-organization-policy.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/ari:cloud:confluence::site%2F2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			var writes int32
+			mockServer := startOrganizationPolicyResourceServer(testCase.policyMockFile, &mockOptions, &writes)
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &OrganizationPolicyResourceService{client: mockClient}
+			gotResult, gotResponse, err := service.Update(testCase.context, testCase.organizationID, testCase.policyID,
+				testCase.resourceID, testCase.payload)
+
+			// The requests rejected by the validation aren't sent to the API
+			assert.Equal(t, testCase.wantWrites, atomic.LoadInt32(&writes))
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+
+		})
+	}
+
+}
+
+func TestOrganizationPolicyResourceService_Remove(t *testing.T) {
+
+	testCases := []struct {
+		name                                 string
+		organizationID, policyID, resourceID string
+		wantHTTPMethod                       string
+		endpoint                             string
+		context                              context.Context
+		wantHTTPCodeReturn                   int
+		wantErr                              bool
+	}{
+		{
+			name:               "RemoveOrganizationPolicyResourceWhenTheParametersAreCorrect",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/ari:cloud:jira::site%2F2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "RemoveOrganizationPolicyResourceWhenTheResourceIDIsNotSet",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RemoveOrganizationPolicyResourceWhenThePolicyIDIsNotSet",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "",
+			resourceID:         "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/ari:cloud:jira::site%2F2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RemoveOrganizationPolicyResourceWhenTheContextIsNil",
+			organizationID:     "d094d850-d57e-483a-bd03-ca8855919267",
+			policyID:           "60f0f660-be3e-4d70-bd34-9c2858ec040f",
+			resourceID:         "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/admin/v1/orgs/d094d850-d57e-483a-bd03-ca8855919267/policies/60f0f660-be3e-4d70-bd34-9c2858ec040f/resources/ari:cloud:jira::site%2F2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &OrganizationPolicyResourceService{client: mockClient}
+			gotResponse, err := service.Remove(testCase.context, testCase.organizationID, testCase.policyID, testCase.resourceID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+
+		})
+	}
+
+}
+
+func TestValidateOrganizationPolicyResource(t *testing.T) {
+
+	newPolicy := func(policyType string) *OrganizationPolicyData {
+		return &OrganizationPolicyData{Type: "policy", Attributes: &OrganizationPolicyAttributes{Type: policyType}}
+	}
+
+	testCases := []struct {
+		name     string
+		policy   *OrganizationPolicyData
+		resource *OrganizationPolicyResource
+		wantErr  bool
+	}{
+		{
+			name:     "ValidateWhenTheDataResidencyPolicyHasAJiraSite",
+			policy:   newPolicy("data-residency"),
+			resource: &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+		},
+		{
+			name:     "ValidateWhenTheIPAllowlistPolicyHasAConfluenceSite",
+			policy:   newPolicy("ip-allowlist"),
+			resource: &OrganizationPolicyResource{ID: "ari:cloud:confluence::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+		},
+		{
+			name:     "ValidateWhenTheProductIsNotSupported",
+			policy:   newPolicy("data-residency"),
+			resource: &OrganizationPolicyResource{ID: "ari:cloud:bitbucket::workspace/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			wantErr:  true,
+		},
+		{
+			name:     "ValidateWhenThePolicyDoesNotSupportResources",
+			policy:   newPolicy("admin-notification-settings"),
+			resource: &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			wantErr:  true,
+		},
+		{
+			name:     "ValidateWhenTheResourceIDIsNotAnARI",
+			policy:   newPolicy("ip-allowlist"),
+			resource: &OrganizationPolicyResource{ID: "jira"},
+			wantErr:  true,
+		},
+		{
+			name:     "ValidateWhenThePolicyTypeIsNotSet",
+			policy:   &OrganizationPolicyData{},
+			resource: &OrganizationPolicyResource{ID: "ari:cloud:jira::site/2b6d5b8a-0fbc-4b2b-8d1a-2c3f5d0c9f11"},
+			wantErr:  true,
+		},
+		{
+			name:     "ValidateWhenTheResourceIsNil",
+			policy:   newPolicy("ip-allowlist"),
+			resource: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateOrganizationPolicyResource(testCase.policy, testCase.resource)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// startOrganizationPolicyResourceServer serves the policy fetched by the validation of the resources, the empty
+// policy mock file returns the 404 status code. The resource requests are counted and checked with the mock options.
+func startOrganizationPolicyResourceServer(policyMockFile string, opts *mockServerOptions, writes *int32) *httptest.Server {
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method == http.MethodGet && !strings.Contains(r.URL.Path, "/resources") {
+
+			if len(policyMockFile) == 0 {
+				http.Error(w, "the policy doesn't exist", http.StatusNotFound)
+				return
+			}
+
+			mockResponse, err := ioutil.ReadFile(policyMockFile)
+			if err != nil {
+				http.Error(w, err.Error(), 500)
+				return
+			}
+
+			_, _ = w.Write(mockResponse)
+			return
+		}
+
+		atomic.AddInt32(writes, 1)
+
+		if r.Method != opts.MethodAccepted {
+			http.Error(w, fmt.Sprintf("Request method: %v, want %v", r.Method, opts.MethodAccepted), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if r.URL.EscapedPath() != opts.Endpoint {
+			http.Error(w, fmt.Sprintf("Request URL: %v, want %v", r.URL.EscapedPath(), opts.Endpoint), 400)
+			return
+		}
+
+		w.WriteHeader(opts.ResponseCodeWanted)
+
+		mockResponse, err := ioutil.ReadFile(opts.MockFilePath)
+		if err != nil {
+			return
+		}
+
+		_, _ = w.Write(mockResponse)
+	}))
+}
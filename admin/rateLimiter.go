@@ -0,0 +1,11 @@
+package admin
+
+import "github.com/ctreminiom/go-atlassian/ratelimit"
+
+// WithRateLimiter waits for the tokens of the limiter before sending the requests of the client, the weights
+// of the limiter are matched with the endpoint templates of the service methods, e.g: GET admin/v1/orgs/{organizationID}/users
+func WithRateLimiter(limiter *ratelimit.Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
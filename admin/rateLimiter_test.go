@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestWithRateLimiter(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer mockServer.Close()
+
+	limiter, err := ratelimit.New(&ratelimit.OptionsScheme{Rate: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient, err := New(nil, WithRateLimiter(limiter))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mockClient.Site, err = url.Parse(mockServer.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = mockClient.Organization.Get(context.Background(), "a4e6f5a1-5b2c-4f3f-9c7a-1d6b2b0e1c2d")
+	assert.Error(t, err)
+
+	// The 429 status code pauses the requests of the clients sharing the limiter
+	stats := limiter.Stats()
+	assert.Equal(t, int64(1), stats.Requests)
+	assert.Equal(t, int64(1), stats.Throttled)
+	assert.Equal(t, 5.0, stats.Rate)
+	assert.False(t, stats.PausedUntil.IsZero())
+}
@@ -0,0 +1,506 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// SCIMFilterExpression is a node of a SCIM filter (RFC 7644 section 3.4.2.2),
+// use the String method to get the value expected by the filter query parameters.
+type SCIMFilterExpression interface {
+	String() string
+	isSCIMFilter()
+}
+
+const (
+	SCIMFilterEqualOperator          = "eq"
+	SCIMFilterNotEqualOperator       = "ne"
+	SCIMFilterContainsOperator       = "co"
+	SCIMFilterStartsWithOperator     = "sw"
+	SCIMFilterEndsWithOperator       = "ew"
+	SCIMFilterPresentOperator        = "pr"
+	SCIMFilterGreaterThanOperator    = "gt"
+	SCIMFilterGreaterOrEqualOperator = "ge"
+	SCIMFilterLessThanOperator       = "lt"
+	SCIMFilterLessOrEqualOperator    = "le"
+
+	SCIMFilterAndOperator = "and"
+	SCIMFilterOrOperator  = "or"
+)
+
+// SCIMAttributeExpression compares an attribute with a value, e.g: userName eq "example@go-atlassian.io"
+// The Value can be a string, a bool, a number, a time.Time or nil (null), it's ignored by the "pr" operator.
+type SCIMAttributeExpression struct {
+	Attribute string
+	Operator  string
+	Value     interface{}
+}
+
+// SCIMLogicalExpression joins the expressions with the "and" or "or" operators.
+type SCIMLogicalExpression struct {
+	Operator    string
+	Expressions []SCIMFilterExpression
+}
+
+// SCIMNotExpression negates the expression, e.g: not (title pr)
+type SCIMNotExpression struct {
+	Expression SCIMFilterExpression
+}
+
+// SCIMValuePathExpression filters a multi-valued attribute, e.g: emails[type eq "work"]
+type SCIMValuePathExpression struct {
+	Attribute string
+	Filter    SCIMFilterExpression
+}
+
+func (*SCIMAttributeExpression) isSCIMFilter() {}
+func (*SCIMLogicalExpression) isSCIMFilter()   {}
+func (*SCIMNotExpression) isSCIMFilter()       {}
+func (*SCIMValuePathExpression) isSCIMFilter() {}
+
+func SCIMEq(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterEqualOperator, Value: value}
+}
+
+func SCIMNe(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterNotEqualOperator, Value: value}
+}
+
+func SCIMCo(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterContainsOperator, Value: value}
+}
+
+func SCIMSw(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterStartsWithOperator, Value: value}
+}
+
+func SCIMEw(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterEndsWithOperator, Value: value}
+}
+
+func SCIMPr(attribute string) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterPresentOperator}
+}
+
+func SCIMGt(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterGreaterThanOperator, Value: value}
+}
+
+func SCIMGe(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterGreaterOrEqualOperator, Value: value}
+}
+
+func SCIMLt(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterLessThanOperator, Value: value}
+}
+
+func SCIMLe(attribute string, value interface{}) *SCIMAttributeExpression {
+	return &SCIMAttributeExpression{Attribute: attribute, Operator: SCIMFilterLessOrEqualOperator, Value: value}
+}
+
+// SCIMAnd joins the expressions with the "and" operator, the nested "and" expressions are flattened.
+func SCIMAnd(expressions ...SCIMFilterExpression) *SCIMLogicalExpression {
+	return newSCIMLogicalExpression(SCIMFilterAndOperator, expressions)
+}
+
+// SCIMOr joins the expressions with the "or" operator, the nested "or" expressions are flattened.
+func SCIMOr(expressions ...SCIMFilterExpression) *SCIMLogicalExpression {
+	return newSCIMLogicalExpression(SCIMFilterOrOperator, expressions)
+}
+
+func SCIMNot(expression SCIMFilterExpression) *SCIMNotExpression {
+	return &SCIMNotExpression{Expression: expression}
+}
+
+func SCIMValuePath(attribute string, filter SCIMFilterExpression) *SCIMValuePathExpression {
+	return &SCIMValuePathExpression{Attribute: attribute, Filter: filter}
+}
+
+func newSCIMLogicalExpression(operator string, expressions []SCIMFilterExpression) *SCIMLogicalExpression {
+
+	logical := &SCIMLogicalExpression{Operator: operator}
+
+	for _, expression := range expressions {
+
+		if nested, ok := expression.(*SCIMLogicalExpression); ok && nested.Operator == operator {
+			logical.Expressions = append(logical.Expressions, nested.Expressions...)
+			continue
+		}
+
+		logical.Expressions = append(logical.Expressions, expression)
+	}
+
+	return logical
+}
+
+func (e *SCIMAttributeExpression) String() string {
+
+	if e.Operator == SCIMFilterPresentOperator {
+		return e.Attribute + " " + e.Operator
+	}
+
+	return e.Attribute + " " + e.Operator + " " + scimFilterValue(e.Value)
+}
+
+func (e *SCIMLogicalExpression) String() string {
+
+	var nodes []string
+	for _, expression := range e.Expressions {
+
+		// The "and" operator has a higher precedence, the nested "or" expressions need parentheses
+		if nested, ok := expression.(*SCIMLogicalExpression); ok && nested.Operator != e.Operator && len(nested.Expressions) > 1 {
+			nodes = append(nodes, "("+nested.String()+")")
+			continue
+		}
+
+		nodes = append(nodes, expression.String())
+	}
+
+	return strings.Join(nodes, " "+e.Operator+" ")
+}
+
+func (e *SCIMNotExpression) String() string {
+	return "not (" + e.Expression.String() + ")"
+}
+
+func (e *SCIMValuePathExpression) String() string {
+	return e.Attribute + "[" + e.Filter.String() + "]"
+}
+
+// scimFilterValue encodes the comparison values as JSON values, as defined in RFC 7644.
+func scimFilterValue(value interface{}) string {
+
+	if date, ok := value.(time.Time); ok {
+		value = date.UTC().Format(time.RFC3339Nano)
+	}
+
+	buffer := new(bytes.Buffer)
+
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprintf("%q", fmt.Sprint(value))
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// ParseSCIMFilter parses a SCIM filter (RFC 7644 section 3.4.2.2) into an expression tree.
+// The operators are case-insensitive and the numbers are returned as json.Number values.
+func ParseSCIMFilter(filter string) (expression SCIMFilterExpression, err error) {
+
+	tokens, err := tokenizeSCIMFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid filter value")
+	}
+
+	parser := &scimFilterParser{tokens: tokens}
+
+	expression, err = parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !parser.done() {
+		return nil, fmt.Errorf("error!, unexpected token %v at position %v", parser.peek().value, parser.peek().position)
+	}
+
+	return
+}
+
+type scimFilterTokenKind int
+
+const (
+	scimFilterWordToken scimFilterTokenKind = iota
+	scimFilterStringToken
+	scimFilterPunctuationToken
+)
+
+type scimFilterToken struct {
+	kind     scimFilterTokenKind
+	value    string
+	position int
+}
+
+func tokenizeSCIMFilter(filter string) (tokens []*scimFilterToken, err error) {
+
+	runes := []rune(filter)
+
+	for index := 0; index < len(runes); {
+
+		character := runes[index]
+
+		switch {
+
+		case unicode.IsSpace(character):
+			index++
+
+		case strings.ContainsRune("()[]", character):
+			tokens = append(tokens, &scimFilterToken{kind: scimFilterPunctuationToken, value: string(character), position: index})
+			index++
+
+		case character == '"':
+
+			end := index + 1
+			for ; end < len(runes) && runes[end] != '"'; end++ {
+				if runes[end] == '\\' {
+					end++
+				}
+			}
+
+			if end >= len(runes) {
+				return nil, fmt.Errorf("error!, the string value at position %v is not closed", index)
+			}
+
+			var value string
+			if err = json.Unmarshal([]byte(string(runes[index:end+1])), &value); err != nil {
+				return nil, fmt.Errorf("error!, the string value at position %v is not valid: %v", index, err)
+			}
+
+			tokens = append(tokens, &scimFilterToken{kind: scimFilterStringToken, value: value, position: index})
+			index = end + 1
+
+		default:
+
+			end := index
+			for ; end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune("()[]\"", runes[end]); end++ {
+			}
+
+			tokens = append(tokens, &scimFilterToken{kind: scimFilterWordToken, value: string(runes[index:end]), position: index})
+			index = end
+		}
+	}
+
+	return
+}
+
+type scimFilterParser struct {
+	tokens   []*scimFilterToken
+	position int
+}
+
+func (p *scimFilterParser) done() bool { return p.position >= len(p.tokens) }
+
+func (p *scimFilterParser) peek() *scimFilterToken {
+
+	if p.done() {
+		return &scimFilterToken{value: "end of the filter", position: -1}
+	}
+
+	return p.tokens[p.position]
+}
+
+func (p *scimFilterParser) next() *scimFilterToken {
+	token := p.peek()
+	p.position++
+	return token
+}
+
+func (p *scimFilterParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == scimFilterWordToken && strings.EqualFold(token.value, keyword)
+}
+
+func (p *scimFilterParser) isPunctuation(value string) bool {
+	token := p.peek()
+	return token.kind == scimFilterPunctuationToken && token.value == value
+}
+
+func (p *scimFilterParser) expect(value string) error {
+
+	if !p.isPunctuation(value) {
+		return fmt.Errorf("error!, expected %v, got %v at position %v", value, p.peek().value, p.peek().position)
+	}
+
+	p.position++
+	return nil
+}
+
+func (p *scimFilterParser) parseOr() (SCIMFilterExpression, error) {
+
+	expressions, err := p.parseSequence(SCIMFilterOrOperator, p.parseAnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expressions) == 1 {
+		return expressions[0], nil
+	}
+
+	return SCIMOr(expressions...), nil
+}
+
+func (p *scimFilterParser) parseAnd() (SCIMFilterExpression, error) {
+
+	expressions, err := p.parseSequence(SCIMFilterAndOperator, p.parseUnary)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(expressions) == 1 {
+		return expressions[0], nil
+	}
+
+	return SCIMAnd(expressions...), nil
+}
+
+func (p *scimFilterParser) parseSequence(operator string, parse func() (SCIMFilterExpression, error)) (expressions []SCIMFilterExpression, err error) {
+
+	for {
+
+		expression, err := parse()
+		if err != nil {
+			return nil, err
+		}
+
+		expressions = append(expressions, expression)
+
+		if !p.isKeyword(operator) {
+			return expressions, nil
+		}
+
+		p.position++
+	}
+}
+
+func (p *scimFilterParser) parseUnary() (SCIMFilterExpression, error) {
+
+	if p.isKeyword("not") && p.position+1 < len(p.tokens) &&
+		p.tokens[p.position+1].kind == scimFilterPunctuationToken && p.tokens[p.position+1].value == "(" {
+
+		p.position++
+
+		expression, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+
+		return SCIMNot(expression), nil
+	}
+
+	if p.isPunctuation("(") {
+		return p.parseGroup()
+	}
+
+	attribute := p.next()
+	if attribute.kind != scimFilterWordToken || !isSCIMAttributePath(attribute.value) {
+		return nil, fmt.Errorf("error!, expected an attribute, got %v at position %v", attribute.value, attribute.position)
+	}
+
+	if p.isPunctuation("[") {
+
+		p.position++
+
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = p.expect("]"); err != nil {
+			return nil, err
+		}
+
+		return SCIMValuePath(attribute.value, filter), nil
+	}
+
+	operator := p.next()
+	if operator.kind != scimFilterWordToken {
+		return nil, fmt.Errorf("error!, expected an operator, got %v at position %v", operator.value, operator.position)
+	}
+
+	expression := &SCIMAttributeExpression{Attribute: attribute.value, Operator: strings.ToLower(operator.value)}
+
+	switch expression.Operator {
+
+	case SCIMFilterPresentOperator:
+		return expression, nil
+
+	case SCIMFilterEqualOperator, SCIMFilterNotEqualOperator, SCIMFilterContainsOperator, SCIMFilterStartsWithOperator,
+		SCIMFilterEndsWithOperator, SCIMFilterGreaterThanOperator, SCIMFilterGreaterOrEqualOperator,
+		SCIMFilterLessThanOperator, SCIMFilterLessOrEqualOperator:
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		expression.Value = value
+		return expression, nil
+
+	default:
+		return nil, fmt.Errorf("error!, the operator %v at position %v is not valid", operator.value, operator.position)
+	}
+}
+
+func (p *scimFilterParser) parseGroup() (SCIMFilterExpression, error) {
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+
+	expression, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.expect(")"); err != nil {
+		return nil, err
+	}
+
+	return expression, nil
+}
+
+func (p *scimFilterParser) parseValue() (interface{}, error) {
+
+	token := p.next()
+
+	if token.kind == scimFilterStringToken {
+		return token.value, nil
+	}
+
+	if token.kind != scimFilterWordToken {
+		return nil, fmt.Errorf("error!, expected a value, got %v at position %v", token.value, token.position)
+	}
+
+	switch strings.ToLower(token.value) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal([]byte(token.value), &number); err != nil {
+		return nil, fmt.Errorf("error!, the value %v at position %v is not valid", token.value, token.position)
+	}
+
+	return number, nil
+}
+
+// isSCIMAttributePath validates the attribute paths, including the URN prefixed ones,
+// e.g: urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber
+func isSCIMAttributePath(path string) bool {
+
+	if len(path) == 0 || !unicode.IsLetter([]rune(path)[0]) {
+		return false
+	}
+
+	for _, character := range path {
+		if !unicode.IsLetter(character) && !unicode.IsDigit(character) && !strings.ContainsRune("_-$:.", character) {
+			return false
+		}
+	}
+
+	return true
+}
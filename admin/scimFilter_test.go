@@ -0,0 +1,184 @@
+package admin
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSCIMFilterExpression_String(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		expression SCIMFilterExpression
+		want       string
+	}{
+		{
+			name:       "BuildWhenTheValueIsAString",
+			expression: SCIMEq("userName", "example@go-atlassian.io"),
+			want:       `userName eq "example@go-atlassian.io"`,
+		},
+		{
+			name:       "BuildWhenTheValueNeedsEscaping",
+			expression: SCIMCo("displayName", `Carlos "Charlie" <Treminio>\`),
+			want:       `displayName co "Carlos \"Charlie\" <Treminio>\\"`,
+		},
+		{
+			name:       "BuildWhenTheValueIsABool",
+			expression: SCIMEq("active", false),
+			want:       `active eq false`,
+		},
+		{
+			name:       "BuildWhenTheValueIsNull",
+			expression: SCIMNe("title", nil),
+			want:       `title ne null`,
+		},
+		{
+			name:       "BuildWhenTheValueIsADate",
+			expression: SCIMGt("meta.lastModified", time.Date(2021, 7, 2, 21, 34, 58, 0, time.UTC)),
+			want:       `meta.lastModified gt "2021-07-02T21:34:58Z"`,
+		},
+		{
+			name:       "BuildWhenTheOperatorIsPresent",
+			expression: SCIMPr("title"),
+			want:       `title pr`,
+		},
+		{
+			name: "BuildWhenTheExpressionsAreGrouped",
+			expression: SCIMAnd(
+				SCIMSw("userName", "a"),
+				SCIMOr(SCIMEq("department", "Sales"), SCIMEq("department", "Support")),
+				SCIMAnd(SCIMEq("active", true)),
+			),
+			want: `userName sw "a" and (department eq "Sales" or department eq "Support") and active eq true`,
+		},
+		{
+			name:       "BuildWhenTheExpressionIsNegated",
+			expression: SCIMNot(SCIMOr(SCIMPr("title"), SCIMEw("userName", ".io"))),
+			want:       `not (title pr or userName ew ".io")`,
+		},
+		{
+			name:       "BuildWhenTheExpressionIsAValuePath",
+			expression: SCIMValuePath("emails", SCIMAnd(SCIMEq("type", "work"), SCIMCo("value", "@go-atlassian.io"))),
+			want:       `emails[type eq "work" and value co "@go-atlassian.io"]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.expression.String()
+			assert.Equal(t, testCase.want, got)
+
+			// The rendered filter must be parsed back into the same filter
+			parsed, err := ParseSCIMFilter(got)
+			if assert.NoError(t, err) {
+				assert.Equal(t, got, parsed.String())
+			}
+		})
+	}
+}
+
+func TestParseSCIMFilter(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		filter  string
+		want    SCIMFilterExpression
+		wantErr bool
+	}{
+		{
+			name:   "ParseWhenTheFilterIsAComparison",
+			filter: `userName Eq "example@go-atlassian.io"`,
+			want:   SCIMEq("userName", "example@go-atlassian.io"),
+		},
+		{
+			name:   "ParseWhenTheValueIsEscaped",
+			filter: `displayName eq "Carlos \"Charlie\" é"`,
+			want:   SCIMEq("displayName", `Carlos "Charlie" é`),
+		},
+		{
+			name:   "ParseWhenTheValueIsANumber",
+			filter: `urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber ge 1024`,
+			want:   SCIMGe("urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:employeeNumber", json.Number("1024")),
+		},
+		{
+			name:   "ParseWhenTheAndOperatorHasPrecedence",
+			filter: `title pr or userName sw "J" and active eq true`,
+			want:   SCIMOr(SCIMPr("title"), SCIMAnd(SCIMSw("userName", "J"), SCIMEq("active", true))),
+		},
+		{
+			name:   "ParseWhenTheExpressionsAreGrouped",
+			filter: `(title pr or userName sw "J") AND not (active eq false)`,
+			want:   SCIMAnd(SCIMOr(SCIMPr("title"), SCIMSw("userName", "J")), SCIMNot(SCIMEq("active", false))),
+		},
+		{
+			name:   "ParseWhenTheFilterHasAValuePath",
+			filter: `emails[type eq "work" and value co "@go-atlassian.io"] or userType eq null`,
+			want: SCIMOr(
+				SCIMValuePath("emails", SCIMAnd(SCIMEq("type", "work"), SCIMCo("value", "@go-atlassian.io"))),
+				SCIMEq("userType", nil),
+			),
+		},
+		{
+			name:    "ParseWhenTheStringIsNotClosed",
+			filter:  `userName eq "example`,
+			wantErr: true,
+		},
+		{
+			name:    "ParseWhenTheOperatorIsNotValid",
+			filter:  `userName like "example"`,
+			wantErr: true,
+		},
+		{
+			name:    "ParseWhenTheValueIsNotQuoted",
+			filter:  `userName eq example`,
+			wantErr: true,
+		},
+		{
+			name:    "ParseWhenTheParenthesisIsNotClosed",
+			filter:  `(userName pr`,
+			wantErr: true,
+		},
+		{
+			name:    "ParseWhenTheFilterHasTrailingTokens",
+			filter:  `userName pr title pr`,
+			wantErr: true,
+		},
+		{
+			name:    "ParseWhenTheFilterIsEmpty",
+			filter:  "  ",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseSCIMFilter(testCase.filter)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+
+			// The parsed filter must be rendered and parsed into the same tree
+			reparsed, err := ParseSCIMFilter(got.String())
+			assert.NoError(t, err)
+			assert.Equal(t, got, reparsed)
+		})
+	}
+}
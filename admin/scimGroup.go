@@ -1,3 +1,439 @@
 package admin
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
 type SCIMGroupService struct{ client *Client }
+
+type SCIMGroupScheme struct {
+	Schemas     []string                 `json:"schemas,omitempty"`
+	ID          string                   `json:"id,omitempty"`
+	ExternalID  string                   `json:"externalId,omitempty"`
+	DisplayName string                   `json:"displayName,omitempty"`
+	Members     []*SCIMGroupMemberScheme `json:"members,omitempty"`
+	Meta        *SCIMUserMetaScheme      `json:"meta,omitempty"`
+}
+
+type SCIMGroupMemberScheme struct {
+	Type    string `json:"type,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type SCIMGroupPageScheme struct {
+	Schemas      []string           `json:"schemas,omitempty"`
+	TotalResults int                `json:"totalResults,omitempty"`
+	StartIndex   int                `json:"startIndex,omitempty"`
+	ItemsPerPage int                `json:"itemsPerPage,omitempty"`
+	Resources    []*SCIMGroupScheme `json:"Resources,omitempty"`
+}
+
+type SCIMGroupGetsOptionsScheme struct {
+	Attributes         []string
+	ExcludedAttributes []string
+	Filter             string
+}
+
+const (
+	SCIMGroupSchema          = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMPatchOperationSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// Create a group in a directory.
+// An attempt to create a group with an existing name fails with a 409 (Conflict) error.
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value
+// 2. directoryId = Directory Id (REQUIRED)
+// 3. payload = The information of the new group to create, the displayName is required (REQUIRED)
+// 4. attributes = Resource attributes to be included in response
+// 5. excludedAttributes = Resource attributes to be excluded from response
+// Atlassian Docs: https://developer.atlassian.com/cloud/admin/user-provisioning/rest/api-group-groups/#api-scim-directory-directoryid-groups-post
+// Library Docs: N/A
+func (s *SCIMGroupService) Create(ctx context.Context, directoryID string, payload *SCIMGroupScheme, attributes, excludedAttributes []string) (result *SCIMGroupScheme, response *Response, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error!, please provide a valid SCIMGroupScheme pointer value")
+	}
+
+	if len(payload.DisplayName) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid displayName value")
+	}
+
+	if len(payload.Schemas) == 0 {
+		payload.Schemas = []string{SCIMGroupSchema}
+	}
+
+	params := url.Values{}
+	addSCIMAttributesParams(params, attributes, excludedAttributes)
+
+	var endpoint string
+	if len(params.Encode()) != 0 {
+		endpoint = fmt.Sprintf("/scim/directory/%v/Groups?%v", directoryID, params.Encode())
+	} else {
+		endpoint = fmt.Sprintf("/scim/directory/%v/Groups", directoryID)
+	}
+
+	request, err := s.client.newRequest(ctx, http.MethodPost, "scim/directory/{directoryID}/Groups", endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/scim+json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SCIMGroupScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Get groups from a directory, the groups can be filtered by the displayName, e.g: displayName eq "jira-users"
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value
+// 2. directoryId = Directory Id (REQUIRED)
+// 3. opts = More Filtering queries
+// 4. startIndex = A 1-based index of the first query result.
+// 5. count = Desired maximum number of query results in the list response page.
+// Atlassian Docs: https://developer.atlassian.com/cloud/admin/user-provisioning/rest/api-group-groups/#api-scim-directory-directoryid-groups-get
+// Library Docs: N/A
+func (s *SCIMGroupService) Gets(ctx context.Context, directoryID string, opts *SCIMGroupGetsOptionsScheme, startIndex, count int) (result *SCIMGroupPageScheme, response *Response, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	params := url.Values{}
+	params.Add("startIndex", strconv.Itoa(startIndex))
+	params.Add("count", strconv.Itoa(count))
+
+	if opts != nil {
+
+		addSCIMAttributesParams(params, opts.Attributes, opts.ExcludedAttributes)
+
+		if len(opts.Filter) != 0 {
+			params.Add("filter", opts.Filter)
+		}
+	}
+
+	var endpoint = fmt.Sprintf("/scim/directory/%v/Groups?%v", directoryID, params.Encode())
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/Groups", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SCIMGroupPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Get a group from a directory by groupId.
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value
+// 2. directoryId = Directory Id (REQUIRED)
+// 3. groupId = The group ID (REQUIRED)
+// 4. attributes = Resource attributes to be included in response
+// 5. excludedAttributes = Resource attributes to be excluded from response
+// Atlassian Docs: https://developer.atlassian.com/cloud/admin/user-provisioning/rest/api-group-groups/#api-scim-directory-directoryid-groups-id-get
+// Library Docs: N/A
+func (s *SCIMGroupService) Get(ctx context.Context, directoryID, groupID string, attributes, excludedAttributes []string) (result *SCIMGroupScheme, response *Response, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	if len(groupID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid groupID value")
+	}
+
+	params := url.Values{}
+	addSCIMAttributesParams(params, attributes, excludedAttributes)
+
+	var endpoint string
+	if len(params.Encode()) != 0 {
+		endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v?%v", directoryID, groupID, params.Encode())
+	} else {
+		endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v", directoryID, groupID)
+	}
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/Groups/{groupID}", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SCIMGroupScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Updates a group in a directory by groupId, the group information is replaced, including the members.
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value
+// 2. directoryId = Directory Id (REQUIRED)
+// 3. groupId = The group ID (REQUIRED)
+// 4. payload = The group information, the displayName is required (REQUIRED)
+// 5. attributes = Resource attributes to be included in response
+// 6. excludedAttributes = Resource attributes to be excluded from response
+// Atlassian Docs: https://developer.atlassian.com/cloud/admin/user-provisioning/rest/api-group-groups/#api-scim-directory-directoryid-groups-id-put
+// Library Docs: N/A
+func (s *SCIMGroupService) Overwrite(ctx context.Context, directoryID, groupID string, payload *SCIMGroupScheme, attributes, excludedAttributes []string) (result *SCIMGroupScheme, response *Response, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	if len(groupID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid groupID value")
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error!, please provide a valid SCIMGroupScheme pointer value")
+	}
+
+	if len(payload.DisplayName) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid displayName value")
+	}
+
+	if len(payload.Schemas) == 0 {
+		payload.Schemas = []string{SCIMGroupSchema}
+	}
+
+	params := url.Values{}
+	addSCIMAttributesParams(params, attributes, excludedAttributes)
+
+	var endpoint string
+	if len(params.Encode()) != 0 {
+		endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v?%v", directoryID, groupID, params.Encode())
+	} else {
+		endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v", directoryID, groupID)
+	}
+
+	request, err := s.client.newRequest(ctx, http.MethodPut, "scim/directory/{directoryID}/Groups/{groupID}", endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/scim+json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SCIMGroupScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Updates a group in a directory by groupId via PATCH, use it to add or remove members or rename the group.
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value
+// 2. directoryId = Directory Id (REQUIRED)
+// 3. groupId = The group ID (REQUIRED)
+// 4. payload = The patch operations (REQUIRED)
+// 5. attributes = Resource attributes to be included in response
+// 6. excludedAttributes = Resource attributes to be excluded from response
+// Atlassian Docs: https://developer.atlassian.com/cloud/admin/user-provisioning/rest/api-group-groups/#api-scim-directory-directoryid-groups-id-patch
+// Library Docs: N/A
+func (s *SCIMGroupService) Update(ctx context.Context, directoryID, groupID string, payload *SCIMGroupPathScheme, attributes, excludedAttributes []string) (result *SCIMGroupScheme, response *Response, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	if len(groupID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid groupID value")
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error!, please provide a valid SCIMGroupPathScheme pointer value")
+	}
+
+	if len(payload.Operations) == 0 {
+		return nil, nil, fmt.Errorf("error!, the payload must contain at least one operation")
+	}
+
+	if len(payload.Schemas) == 0 {
+		payload.Schemas = []string{SCIMPatchOperationSchema}
+	}
+
+	params := url.Values{}
+	addSCIMAttributesParams(params, attributes, excludedAttributes)
+
+	var endpoint string
+	if len(params.Encode()) != 0 {
+		endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v?%v", directoryID, groupID, params.Encode())
+	} else {
+		endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v", directoryID, groupID)
+	}
+
+	request, err := s.client.newRequest(ctx, http.MethodPatch, "scim/directory/{directoryID}/Groups/{groupID}", endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/scim+json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SCIMGroupScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes a group from a directory by groupId.
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value
+// 2. directoryId = Directory Id (REQUIRED)
+// 3. groupId = The group ID (REQUIRED)
+// Atlassian Docs: https://developer.atlassian.com/cloud/admin/user-provisioning/rest/api-group-groups/#api-scim-directory-directoryid-groups-id-delete
+// Library Docs: N/A
+func (s *SCIMGroupService) Delete(ctx context.Context, directoryID, groupID string) (response *Response, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	if len(groupID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid groupID value")
+	}
+
+	var endpoint = fmt.Sprintf("/scim/directory/%v/Groups/%v", directoryID, groupID)
+
+	request, err := s.client.newRequest(ctx, http.MethodDelete, "scim/directory/{directoryID}/Groups/{groupID}", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+type SCIMGroupPathScheme struct {
+	Schemas    []string                    `json:"schemas,omitempty"`
+	Operations []*SCIMGroupOperationScheme `json:"Operations,omitempty"`
+}
+
+type SCIMGroupOperationScheme struct {
+	Op    string      `json:"op,omitempty"`
+	Path  string      `json:"path,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+type SCIMGroupOperationValueScheme struct {
+	Value   string `json:"value,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+func (s *SCIMGroupPathScheme) AddMembers(userIDs []string) (err error) {
+	return s.addMembersOperation("add", userIDs)
+}
+
+func (s *SCIMGroupPathScheme) RemoveMembers(userIDs []string) (err error) {
+	return s.addMembersOperation("remove", userIDs)
+}
+
+func (s *SCIMGroupPathScheme) ReplaceDisplayName(displayName string) (err error) {
+
+	if len(displayName) == 0 {
+		return fmt.Errorf("error!, please provide a valid displayName value")
+	}
+
+	s.Operations = append(s.Operations, &SCIMGroupOperationScheme{
+		Op:    "replace",
+		Path:  "displayName",
+		Value: displayName,
+	})
+
+	return
+}
+
+func (s *SCIMGroupPathScheme) addMembersOperation(operation string, userIDs []string) (err error) {
+
+	if len(userIDs) == 0 {
+		return fmt.Errorf("error!, please provide a valid userIDs value")
+	}
+
+	var values []*SCIMGroupOperationValueScheme
+	for _, userID := range userIDs {
+
+		if len(userID) == 0 {
+			return fmt.Errorf("error!, the userIDs value contains an empty user ID")
+		}
+
+		values = append(values, &SCIMGroupOperationValueScheme{Value: userID})
+	}
+
+	s.Operations = append(s.Operations, &SCIMGroupOperationScheme{
+		Op:    operation,
+		Path:  "members",
+		Value: values,
+	})
+
+	return
+}
+
+func addSCIMAttributesParams(params url.Values, attributes, excludedAttributes []string) {
+
+	if len(attributes) != 0 {
+		params.Add("attributes", strings.Join(attributes, ","))
+	}
+
+	if len(excludedAttributes) != 0 {
+		params.Add("excludedAttributes", strings.Join(excludedAttributes, ","))
+	}
+}
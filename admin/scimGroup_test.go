@@ -0,0 +1,1030 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestSCIMGroupService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name                           string
+		directoryID                    string
+		payload                        *SCIMGroupScheme
+		attributes, excludedAttributes []string
+		mockFile                       string
+		wantHTTPMethod                 string
+		endpoint                       string
+		context                        context.Context
+		wantHTTPCodeReturn             int
+		wantErr                        bool
+	}{
+		{
+			name:               "CreateSCIMGroupWhenTheParametersAreCorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			attributes:         []string{"displayName"},
+			excludedAttributes: []string{"members"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups?attributes=displayName&excludedAttributes=members",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateSCIMGroupWhenTheAttributesAndExcludedAttributesAreNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateSCIMGroupWhenTheDisplayNameIsNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			payload:            &SCIMGroupScheme{},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateSCIMGroupWhenThePayloadIsNil",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			payload:            nil,
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateSCIMGroupWhenTheDirectoryIDIsNotSet",
+			directoryID:        "",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateSCIMGroupWhenTheStatusCodeIsIncorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusConflict,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateSCIMGroupWhenTheContextIsNil",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateSCIMGroupWhenTheResponseBodyIsEmpty",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			mockFile:           "./mocks/empty.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SCIMGroupService{client: mockClient}
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.directoryID, testCase.payload,
+				testCase.attributes, testCase.excludedAttributes)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				assert.Equal(t, []string{SCIMGroupSchema}, testCase.payload.Schemas)
+				assert.Equal(t, "jira-users", gotResult.DisplayName)
+				assert.Len(t, gotResult.Members, 1)
+			}
+
+		})
+	}
+
+}
+
+func TestSCIMGroupService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		directoryID        string
+		opts               *SCIMGroupGetsOptionsScheme
+		startIndex         int
+		count              int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:        "GetsSCIMGroupsWhenTheParametersAreCorrect",
+			directoryID: "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			opts: &SCIMGroupGetsOptionsScheme{
+				Attributes:         []string{"displayName"},
+				ExcludedAttributes: []string{"members"},
+				Filter:             `displayName eq "jira-users"`,
+			},
+			startIndex:         1,
+			count:              50,
+			mockFile:           "./mocks/scim-get-groups.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups?attributes=displayName&count=50&excludedAttributes=members&filter=displayName+eq+%22jira-users%22&startIndex=1",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsSCIMGroupsWhenTheOptionsAreNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			opts:               nil,
+			startIndex:         1,
+			count:              50,
+			mockFile:           "./mocks/scim-get-groups.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups?count=50&startIndex=1",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsSCIMGroupsWhenTheDirectoryIDIsNotSet",
+			directoryID:        "",
+			startIndex:         1,
+			count:              50,
+			mockFile:           "./mocks/scim-get-groups.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups?count=50&startIndex=1",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsSCIMGroupsWhenTheRequestMethodIsIncorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			startIndex:         1,
+			count:              50,
+			mockFile:           "./mocks/scim-get-groups.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups?count=50&startIndex=1",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsSCIMGroupsWhenTheContextIsNil",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			startIndex:         1,
+			count:              50,
+			mockFile:           "./mocks/scim-get-groups.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups?count=50&startIndex=1",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsSCIMGroupsWhenTheResponseBodyIsEmpty",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			startIndex:         1,
+			count:              50,
+			mockFile:           "./mocks/empty.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups?count=50&startIndex=1",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SCIMGroupService{client: mockClient}
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.directoryID, testCase.opts,
+				testCase.startIndex, testCase.count)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				assert.Equal(t, 2, gotResult.TotalResults)
+
+				for _, group := range gotResult.Resources {
+					t.Log(group.ID, group.DisplayName, len(group.Members))
+				}
+			}
+
+		})
+	}
+
+}
+
+func TestSCIMGroupService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                           string
+		directoryID, groupID           string
+		attributes, excludedAttributes []string
+		mockFile                       string
+		wantHTTPMethod                 string
+		endpoint                       string
+		context                        context.Context
+		wantHTTPCodeReturn             int
+		wantErr                        bool
+	}{
+		{
+			name:               "GetSCIMGroupWhenTheParametersAreCorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			attributes:         []string{"displayName", "members"},
+			excludedAttributes: []string{"meta"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a?attributes=displayName%2Cmembers&excludedAttributes=meta",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetSCIMGroupWhenTheAttributesAndExcludedAttributesAreNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetSCIMGroupWhenTheGroupIDIsNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "",
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetSCIMGroupWhenTheDirectoryIDIsNotSet",
+			directoryID:        "",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetSCIMGroupWhenTheStatusCodeIsIncorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNotFound,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetSCIMGroupWhenTheResponseBodyIsEmpty",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			mockFile:           "./mocks/empty.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SCIMGroupService{client: mockClient}
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.directoryID, testCase.groupID,
+				testCase.attributes, testCase.excludedAttributes)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				if assert.Len(t, gotResult.Members, 1) {
+					assert.Equal(t, "ef5ff80e-9ca6-449c-8cca-5b621085c6c9", gotResult.Members[0].Value)
+				}
+			}
+
+		})
+	}
+
+}
+
+func TestSCIMGroupService_Overwrite(t *testing.T) {
+
+	testCases := []struct {
+		name                           string
+		directoryID, groupID           string
+		payload                        *SCIMGroupScheme
+		attributes, excludedAttributes []string
+		mockFile                       string
+		wantHTTPMethod                 string
+		endpoint                       string
+		context                        context.Context
+		wantHTTPCodeReturn             int
+		wantErr                        bool
+	}{
+		{
+			name:        "OverwriteSCIMGroupWhenTheParametersAreCorrect",
+			directoryID: "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:     "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload: &SCIMGroupScheme{
+				DisplayName: "jira-users",
+				Members:     []*SCIMGroupMemberScheme{{Value: "ef5ff80e-9ca6-449c-8cca-5b621085c6c9"}},
+			},
+			attributes:         []string{"displayName"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a?attributes=displayName",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "OverwriteSCIMGroupWhenThePayloadIsNil",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            nil,
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OverwriteSCIMGroupWhenTheDisplayNameIsNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            &SCIMGroupScheme{},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OverwriteSCIMGroupWhenTheGroupIDIsNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OverwriteSCIMGroupWhenTheDirectoryIDIsNotSet",
+			directoryID:        "",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OverwriteSCIMGroupWhenTheRequestMethodIsIncorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            &SCIMGroupScheme{DisplayName: "jira-users"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPatch,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SCIMGroupService{client: mockClient}
+			gotResult, gotResponse, err := service.Overwrite(testCase.context, testCase.directoryID, testCase.groupID,
+				testCase.payload, testCase.attributes, testCase.excludedAttributes)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+
+		})
+	}
+
+}
+
+func TestSCIMGroupService_Update(t *testing.T) {
+
+	validPayload := &SCIMGroupPathScheme{}
+	if err := validPayload.AddMembers([]string{"ef5ff80e-9ca6-449c-8cca-5b621085c6c9"}); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name                           string
+		directoryID, groupID           string
+		payload                        *SCIMGroupPathScheme
+		attributes, excludedAttributes []string
+		mockFile                       string
+		wantHTTPMethod                 string
+		endpoint                       string
+		context                        context.Context
+		wantHTTPCodeReturn             int
+		wantErr                        bool
+	}{
+		{
+			name:               "UpdateSCIMGroupWhenTheParametersAreCorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            validPayload,
+			excludedAttributes: []string{"meta"},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPatch,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a?excludedAttributes=meta",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "UpdateSCIMGroupWhenThePayloadHasNoOperations",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            &SCIMGroupPathScheme{},
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPatch,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateSCIMGroupWhenThePayloadIsNil",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            nil,
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPatch,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateSCIMGroupWhenTheGroupIDIsNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "",
+			payload:            validPayload,
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPatch,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateSCIMGroupWhenTheDirectoryIDIsNotSet",
+			directoryID:        "",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            validPayload,
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPatch,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateSCIMGroupWhenTheStatusCodeIsIncorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			payload:            validPayload,
+			mockFile:           "./mocks/scim-get-group.json",
+			wantHTTPMethod:     http.MethodPatch,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SCIMGroupService{client: mockClient}
+			gotResult, gotResponse, err := service.Update(testCase.context, testCase.directoryID, testCase.groupID,
+				testCase.payload, testCase.attributes, testCase.excludedAttributes)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+
+		})
+	}
+
+}
+
+func TestSCIMGroupService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name                 string
+		directoryID, groupID string
+		mockFile             string
+		wantHTTPMethod       string
+		endpoint             string
+		context              context.Context
+		wantHTTPCodeReturn   int
+		wantErr              bool
+	}{
+		{
+			name:               "DeleteSCIMGroupWhenTheParametersAreCorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteSCIMGroupWhenTheGroupIDIsNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteSCIMGroupWhenTheDirectoryIDIsNotSet",
+			directoryID:        "",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteSCIMGroupWhenTheRequestMethodIsIncorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteSCIMGroupWhenTheContextIsNil",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			groupID:            "6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/Groups/6b5d6d3e-1a2f-4c5b-9a8e-2d0b4f3f1c7a",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SCIMGroupService{client: mockClient}
+			gotResponse, err := service.Delete(testCase.context, testCase.directoryID, testCase.groupID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+
+		})
+	}
+
+}
+
+func TestSCIMGroupPathScheme_Operations(t *testing.T) {
+
+	payload := &SCIMGroupPathScheme{}
+
+	assert.NoError(t, payload.AddMembers([]string{"user-1", "user-2"}))
+	assert.NoError(t, payload.RemoveMembers([]string{"user-3"}))
+	assert.NoError(t, payload.ReplaceDisplayName("jira-software-users"))
+
+	assert.Error(t, payload.AddMembers(nil))
+	assert.Error(t, payload.RemoveMembers([]string{""}))
+	assert.Error(t, payload.ReplaceDisplayName(""))
+
+	want := []*SCIMGroupOperationScheme{
+		{
+			Op:    "add",
+			Path:  "members",
+			Value: []*SCIMGroupOperationValueScheme{{Value: "user-1"}, {Value: "user-2"}},
+		},
+		{
+			Op:    "remove",
+			Path:  "members",
+			Value: []*SCIMGroupOperationValueScheme{{Value: "user-3"}},
+		},
+		{
+			Op:    "replace",
+			Path:  "displayName",
+			Value: "jira-software-users",
+		},
+	}
+
+	assert.Equal(t, want, payload.Operations)
+}
@@ -0,0 +1,765 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type SCIMReconcileDesiredScheme struct {
+	Users  []*SCIMUserScheme           `json:"users,omitempty"`
+	Groups []*SCIMReconcileGroupScheme `json:"groups,omitempty"`
+}
+
+// SCIMReconcileGroupScheme represents the desired state of a directory group,
+// the members are referenced by the userName or the externalId of the users.
+type SCIMReconcileGroupScheme struct {
+	DisplayName string   `json:"displayName,omitempty"`
+	Members     []string `json:"members,omitempty"`
+}
+
+type SCIMReconcileOptionsScheme struct {
+
+	// DryRun plans the changes without applying them.
+	DryRun bool
+
+	// DeactivateMissing deactivates the active directory users not included in the desired state.
+	DeactivateMissing bool
+
+	// Interval is the minimum time between two requests, use it to stay below the API rate limits.
+	Interval time.Duration
+
+	// MaxRetries is the number of times a request is retried when the API returns a 429 (Too Many Requests) status.
+	MaxRetries int
+
+	// PageSize is the number of users and groups requested per page, the default value is 100.
+	PageSize int
+}
+
+type SCIMReconcileReportScheme struct {
+	DirectoryID string                       `json:"directoryId"`
+	DryRun      bool                         `json:"dryRun"`
+	Actions     []*SCIMReconcileActionScheme `json:"actions"`
+	Summary     *SCIMReconcileSummaryScheme  `json:"summary"`
+}
+
+type SCIMReconcileActionScheme struct {
+	Type    string   `json:"type"`
+	Target  string   `json:"target"`
+	ID      string   `json:"id,omitempty"`
+	Changes []string `json:"changes,omitempty"`
+	Members []string `json:"members,omitempty"`
+	Status  string   `json:"status"`
+	Error   string   `json:"error,omitempty"`
+
+	user    *SCIMUserScheme
+	payload *SCIMUserToPathScheme
+	refs    []string
+}
+
+type SCIMReconcileSummaryScheme struct {
+	Planned int `json:"planned"`
+	Applied int `json:"applied"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+const (
+	SCIMReconcileCreateUserAction     = "create_user"
+	SCIMReconcileUpdateUserAction     = "update_user"
+	SCIMReconcileDeactivateUserAction = "deactivate_user"
+	SCIMReconcileCreateGroupAction    = "create_group"
+	SCIMReconcileAddMembersAction     = "add_members"
+	SCIMReconcileRemoveMembersAction  = "remove_members"
+
+	SCIMReconcilePlannedStatus = "planned"
+	SCIMReconcileAppliedStatus = "applied"
+	SCIMReconcileSkippedStatus = "skipped"
+	SCIMReconcileFailedStatus  = "failed"
+)
+
+// Reconcile syncs the desired users and group memberships into a SCIM directory.
+// The users are matched by externalId and then by userName, the groups are matched by displayName.
+// The plan is computed against the current state of the directory, so running it twice applies the changes once.
+// Only the attributes set in the desired users are compared, the empty ones are not cleared.
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value
+// 2. directoryId = Directory Id (REQUIRED)
+// 3. desired = The desired users and groups (REQUIRED)
+// 4. opts = The reconciliation options, e.g: dry-run mode, rate limiting.
+// Atlassian Docs: N/A
+// Library Docs: N/A
+func (s *SCIMService) Reconcile(ctx context.Context, directoryID string, desired *SCIMReconcileDesiredScheme, opts *SCIMReconcileOptionsScheme) (report *SCIMReconcileReportScheme, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	if desired == nil {
+		return nil, fmt.Errorf("error!, please provide a valid SCIMReconcileDesiredScheme pointer value")
+	}
+
+	if opts == nil {
+		opts = &SCIMReconcileOptionsScheme{}
+	}
+
+	reconciler := &scimReconciler{
+		service:     s,
+		directoryID: directoryID,
+		opts:        opts,
+		throttle:    &requestThrottle{interval: opts.Interval, maxRetries: opts.MaxRetries, logger: s.client.logger},
+	}
+
+	current, err := reconciler.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = reconciler.fetchMembers(ctx, current, desired); err != nil {
+		return nil, err
+	}
+
+	actions, err := planSCIMReconcile(current, desired, opts.DeactivateMissing)
+	if err != nil {
+		return nil, err
+	}
+
+	report = &SCIMReconcileReportScheme{
+		DirectoryID: directoryID,
+		DryRun:      opts.DryRun,
+		Actions:     actions,
+		Summary:     &SCIMReconcileSummaryScheme{},
+	}
+
+	if !opts.DryRun {
+		reconciler.apply(ctx, current, actions)
+	}
+
+	for _, action := range actions {
+
+		switch action.Status {
+		case SCIMReconcilePlannedStatus:
+			report.Summary.Planned++
+		case SCIMReconcileAppliedStatus:
+			report.Summary.Applied++
+		case SCIMReconcileSkippedStatus:
+			report.Summary.Skipped++
+		case SCIMReconcileFailedStatus:
+			report.Summary.Failed++
+		}
+	}
+
+	if report.Summary.Failed != 0 {
+		return report, fmt.Errorf("error!, %v of the %v reconcile actions failed", report.Summary.Failed, len(actions))
+	}
+
+	return
+}
+
+type scimDirectoryState struct {
+	users  []*SCIMUserScheme
+	groups []*SCIMGroupScheme
+}
+
+type scimReconciler struct {
+	service     *SCIMService
+	directoryID string
+	opts        *SCIMReconcileOptionsScheme
+	throttle    *requestThrottle
+}
+
+func (r *scimReconciler) pageSize() int {
+
+	if r.opts.PageSize <= 0 {
+		return 100
+	}
+
+	return r.opts.PageSize
+}
+
+func (r *scimReconciler) fetch(ctx context.Context) (state *scimDirectoryState, err error) {
+
+	state = &scimDirectoryState{}
+
+	for startIndex := 1; ; {
+
+		var page *SCIMUserPageScheme
+		err = r.call(ctx, func(ctx context.Context) (response *Response, err error) {
+			page, response, err = r.service.User.Gets(ctx, r.directoryID, nil, startIndex, r.pageSize())
+			return
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		state.users = append(state.users, page.Resources...)
+		startIndex += len(page.Resources)
+
+		if len(page.Resources) == 0 || startIndex > page.TotalResults {
+			break
+		}
+	}
+
+	for startIndex := 1; ; {
+
+		var page *SCIMGroupPageScheme
+		err = r.call(ctx, func(ctx context.Context) (response *Response, err error) {
+			page, response, err = r.service.Group.Gets(ctx, r.directoryID, nil, startIndex, r.pageSize())
+			return
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		state.groups = append(state.groups, page.Resources...)
+		startIndex += len(page.Resources)
+
+		if len(page.Resources) == 0 || startIndex > page.TotalResults {
+			break
+		}
+	}
+
+	return
+}
+
+// fetchMembers gets the members of the desired groups, the group pages may not include them.
+func (r *scimReconciler) fetchMembers(ctx context.Context, state *scimDirectoryState, desired *SCIMReconcileDesiredScheme) (err error) {
+
+	desiredNames := make(map[string]bool)
+	for _, group := range desired.Groups {
+		if group != nil {
+			desiredNames[strings.ToLower(group.DisplayName)] = true
+		}
+	}
+
+	for index, group := range state.groups {
+
+		if !desiredNames[strings.ToLower(group.DisplayName)] {
+			continue
+		}
+
+		var result *SCIMGroupScheme
+		err = r.call(ctx, func(ctx context.Context) (response *Response, err error) {
+			result, response, err = r.service.Group.Get(ctx, r.directoryID, group.ID, nil, nil)
+			return
+		})
+
+		if err != nil {
+			return
+		}
+
+		state.groups[index] = result
+	}
+
+	return
+}
+
+// call waits for the configured interval and retries the request when the API is rate limiting the client.
+func (r *scimReconciler) call(ctx context.Context, request func(ctx context.Context) (*Response, error)) (err error) {
+	return r.throttle.call(ctx, request)
+}
+
+func (r *scimReconciler) apply(ctx context.Context, current *scimDirectoryState, actions []*SCIMReconcileActionScheme) {
+
+	// The member actions reference the new users by userName, the IDs are stored once they're created
+	createdIDs := make(map[string]string)
+
+	groupIDs := make(map[string]string)
+	for _, group := range current.groups {
+		groupIDs[strings.ToLower(group.DisplayName)] = group.ID
+	}
+
+	for _, action := range actions {
+
+		var err error
+
+		switch action.Type {
+
+		case SCIMReconcileCreateUserAction:
+
+			var created *SCIMUserScheme
+			err = r.call(ctx, func(ctx context.Context) (response *Response, err error) {
+				created, response, err = r.service.User.Create(ctx, r.directoryID, action.user, nil, nil)
+
+				// The user was created by a previous run, the request is not repeated
+				if response != nil && response.StatusCode == http.StatusConflict {
+					action.Status = SCIMReconcileSkippedStatus
+					return nil, nil
+				}
+
+				return
+			})
+
+			if err == nil && action.Status == SCIMReconcileSkippedStatus {
+
+				var page *SCIMUserPageScheme
+				err = r.call(ctx, func(ctx context.Context) (response *Response, err error) {
+					opts := &SCIMUserGetsOptionsScheme{Filter: SCIMEq("userName", action.Target).String()}
+					page, response, err = r.service.User.Gets(ctx, r.directoryID, opts, 1, 1)
+					return
+				})
+
+				if err == nil && len(page.Resources) != 0 {
+					created = page.Resources[0]
+				}
+			}
+
+			if err == nil && created != nil {
+				action.ID = created.ID
+				createdIDs[newSCIMUserRef(action.Target)] = created.ID
+			}
+
+		case SCIMReconcileUpdateUserAction:
+
+			err = r.call(ctx, func(ctx context.Context) (response *Response, err error) {
+				_, response, err = r.service.User.Update(ctx, r.directoryID, action.ID, action.payload, nil, nil)
+				return
+			})
+
+		case SCIMReconcileDeactivateUserAction:
+
+			err = r.call(ctx, func(ctx context.Context) (*Response, error) {
+				return r.service.User.Deactivate(ctx, r.directoryID, action.ID)
+			})
+
+		case SCIMReconcileCreateGroupAction:
+
+			var created *SCIMGroupScheme
+			err = r.call(ctx, func(ctx context.Context) (response *Response, err error) {
+				created, response, err = r.service.Group.Create(ctx, r.directoryID, &SCIMGroupScheme{DisplayName: action.Target}, nil, nil)
+				return
+			})
+
+			if err == nil {
+				action.ID = created.ID
+				groupIDs[strings.ToLower(action.Target)] = created.ID
+			}
+
+		case SCIMReconcileAddMembersAction, SCIMReconcileRemoveMembersAction:
+
+			action.ID = groupIDs[strings.ToLower(action.Target)]
+			if len(action.ID) == 0 {
+				err = fmt.Errorf("the group %v was not created", action.Target)
+				break
+			}
+
+			var ids []string
+			for index, ref := range action.refs {
+
+				id := ref
+				if strings.HasPrefix(ref, newSCIMUserRefPrefix) {
+					id = createdIDs[ref]
+				}
+
+				if len(id) == 0 {
+					err = fmt.Errorf("the user %v was not created", action.Members[index])
+					break
+				}
+
+				ids = append(ids, id)
+			}
+
+			if err != nil {
+				break
+			}
+
+			payload := &SCIMGroupPathScheme{}
+			if action.Type == SCIMReconcileAddMembersAction {
+				err = payload.AddMembers(ids)
+			} else {
+				err = payload.RemoveMembers(ids)
+			}
+
+			if err != nil {
+				break
+			}
+
+			err = r.call(ctx, func(ctx context.Context) (response *Response, err error) {
+				_, response, err = r.service.Group.Update(ctx, r.directoryID, action.ID, payload, nil, nil)
+				return
+			})
+		}
+
+		if err != nil {
+			action.Status = SCIMReconcileFailedStatus
+			action.Error = err.Error()
+			continue
+		}
+
+		if action.Status == SCIMReconcilePlannedStatus {
+			action.Status = SCIMReconcileAppliedStatus
+		}
+	}
+}
+
+func planSCIMReconcile(current *scimDirectoryState, desired *SCIMReconcileDesiredScheme, deactivateMissing bool) (actions []*SCIMReconcileActionScheme, err error) {
+
+	currentUsers := make(map[string]*SCIMUserScheme)
+	for _, user := range current.users {
+		for _, key := range scimUserKeys(user) {
+			currentUsers[key] = user
+		}
+	}
+
+	var (
+		desiredKeys    = make(map[string]bool)
+		desiredMatches = make(map[*SCIMUserScheme]*SCIMUserScheme)
+		matchedUsers   = make(map[string]bool)
+		updates        []*SCIMReconcileActionScheme
+	)
+
+	for _, user := range desired.Users {
+
+		if user == nil || len(user.UserName) == 0 {
+			return nil, fmt.Errorf("error!, the desired users must contain a userName value")
+		}
+
+		keys := scimUserKeys(user)
+		for _, key := range keys {
+
+			if desiredKeys[key] {
+				return nil, fmt.Errorf("error!, the desired user %v is duplicated", key)
+			}
+
+			desiredKeys[key] = true
+		}
+
+		var match *SCIMUserScheme
+		for _, key := range keys {
+			if match = currentUsers[key]; match != nil {
+				break
+			}
+		}
+
+		if match == nil {
+
+			payload := *user
+			payload.ID = ""
+			payload.Active = true
+
+			actions = append(actions, &SCIMReconcileActionScheme{
+				Type:   SCIMReconcileCreateUserAction,
+				Target: user.UserName,
+				Status: SCIMReconcilePlannedStatus,
+				user:   &payload,
+			})
+
+			continue
+		}
+
+		matchedUsers[match.ID] = true
+		desiredMatches[user] = match
+
+		changes, payload, err := diffSCIMUser(match, user)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(changes) != 0 {
+			updates = append(updates, &SCIMReconcileActionScheme{
+				Type:    SCIMReconcileUpdateUserAction,
+				Target:  user.UserName,
+				ID:      match.ID,
+				Changes: changes,
+				Status:  SCIMReconcilePlannedStatus,
+				payload: payload,
+			})
+		}
+	}
+
+	actions = append(actions, updates...)
+
+	currentGroups := make(map[string]*SCIMGroupScheme)
+	for _, group := range current.groups {
+		currentGroups[strings.ToLower(group.DisplayName)] = group
+	}
+
+	// The users are identified by their ID, or by a reference to the userName when they're not created yet
+	var (
+		refs  = make(map[string]string)
+		names = make(map[string]string)
+	)
+
+	for _, user := range desired.Users {
+
+		ref := newSCIMUserRef(user.UserName)
+		if match := desiredMatches[user]; match != nil {
+			ref = match.ID
+		}
+
+		for _, key := range scimUserKeys(user) {
+			refs[key] = ref
+		}
+
+		names[ref] = user.UserName
+	}
+
+	for _, user := range current.users {
+
+		for _, key := range scimUserKeys(user) {
+			if _, ok := refs[key]; !ok {
+				refs[key] = user.ID
+			}
+		}
+
+		if _, ok := names[user.ID]; !ok {
+			names[user.ID] = user.UserName
+		}
+	}
+
+	var (
+		groupCreates []*SCIMReconcileActionScheme
+		groupChanges []*SCIMReconcileActionScheme
+		desiredNames = make(map[string]bool)
+	)
+
+	for _, group := range desired.Groups {
+
+		if group == nil || len(group.DisplayName) == 0 {
+			return nil, fmt.Errorf("error!, the desired groups must contain a displayName value")
+		}
+
+		name := strings.ToLower(group.DisplayName)
+		if desiredNames[name] {
+			return nil, fmt.Errorf("error!, the desired group %v is duplicated", group.DisplayName)
+		}
+		desiredNames[name] = true
+
+		var (
+			wanted     = make(map[string]bool)
+			wantedRefs []string
+		)
+
+		for _, member := range group.Members {
+
+			ref, ok := refs[strings.ToLower(member)]
+			if !ok {
+				ref, ok = refs["externalid:"+strings.ToLower(member)]
+			}
+
+			if !ok {
+				return nil, fmt.Errorf("error!, the member %v of the group %v does not match any user", member, group.DisplayName)
+			}
+
+			if !wanted[ref] {
+				wanted[ref] = true
+				wantedRefs = append(wantedRefs, ref)
+			}
+		}
+
+		var (
+			currentGroup = currentGroups[name]
+			present      = make(map[string]bool)
+			toAdd        []string
+			toRemove     []string
+		)
+
+		if currentGroup == nil {
+
+			groupCreates = append(groupCreates, &SCIMReconcileActionScheme{
+				Type:   SCIMReconcileCreateGroupAction,
+				Target: group.DisplayName,
+				Status: SCIMReconcilePlannedStatus,
+			})
+
+		} else {
+
+			for _, member := range currentGroup.Members {
+
+				present[member.Value] = true
+
+				if !wanted[member.Value] {
+					toRemove = append(toRemove, member.Value)
+				}
+			}
+		}
+
+		for _, ref := range wantedRefs {
+			if !present[ref] {
+				toAdd = append(toAdd, ref)
+			}
+		}
+
+		if len(toAdd) != 0 {
+			groupChanges = append(groupChanges, newSCIMMembersAction(SCIMReconcileAddMembersAction, group.DisplayName, toAdd, names))
+		}
+
+		if len(toRemove) != 0 {
+			groupChanges = append(groupChanges, newSCIMMembersAction(SCIMReconcileRemoveMembersAction, group.DisplayName, toRemove, names))
+		}
+	}
+
+	actions = append(actions, groupCreates...)
+	actions = append(actions, groupChanges...)
+
+	if deactivateMissing {
+
+		for _, user := range current.users {
+
+			if !user.Active || matchedUsers[user.ID] {
+				continue
+			}
+
+			actions = append(actions, &SCIMReconcileActionScheme{
+				Type:   SCIMReconcileDeactivateUserAction,
+				Target: user.UserName,
+				ID:     user.ID,
+				Status: SCIMReconcilePlannedStatus,
+			})
+		}
+	}
+
+	return
+}
+
+const newSCIMUserRefPrefix = "new:"
+
+func newSCIMUserRef(userName string) string {
+	return newSCIMUserRefPrefix + strings.ToLower(userName)
+}
+
+func newSCIMMembersAction(actionType, group string, refs []string, names map[string]string) *SCIMReconcileActionScheme {
+
+	action := &SCIMReconcileActionScheme{
+		Type:   actionType,
+		Target: group,
+		Status: SCIMReconcilePlannedStatus,
+		refs:   refs,
+	}
+
+	for _, ref := range refs {
+
+		if name, ok := names[ref]; ok {
+			action.Members = append(action.Members, name)
+		} else {
+			action.Members = append(action.Members, ref)
+		}
+	}
+
+	return action
+}
+
+// diffSCIMUser compares the attributes set in the desired user and returns the PATCH operations needed.
+func diffSCIMUser(current, desired *SCIMUserScheme) (changes []string, payload *SCIMUserToPathScheme, err error) {
+
+	payload = &SCIMUserToPathScheme{Schemas: []string{SCIMPatchOperationSchema}}
+
+	attributes := []struct {
+		path             string
+		current, desired string
+	}{
+		{"externalId", current.ExternalID, desired.ExternalID},
+		{"userName", current.UserName, desired.UserName},
+		{"displayName", current.DisplayName, desired.DisplayName},
+		{"nickName", current.NickName, desired.NickName},
+		{"title", current.Title, desired.Title},
+		{"preferredLanguage", current.PreferredLanguage, desired.PreferredLanguage},
+		{"department", current.Department, desired.Department},
+		{"organization", current.Organization, desired.Organization},
+		{"timezone", current.Timezone, desired.Timezone},
+	}
+
+	if desired.Name != nil {
+
+		currentName := current.Name
+		if currentName == nil {
+			currentName = &SCIMUserNameScheme{}
+		}
+
+		attributes = append(attributes, []struct {
+			path             string
+			current, desired string
+		}{
+			{"name.formatted", currentName.Formatted, desired.Name.Formatted},
+			{"name.givenName", currentName.GivenName, desired.Name.GivenName},
+			{"name.familyName", currentName.FamilyName, desired.Name.FamilyName},
+			{"name.middleName", currentName.MiddleName, desired.Name.MiddleName},
+		}...)
+	}
+
+	for _, attribute := range attributes {
+
+		if len(attribute.desired) == 0 || attribute.desired == attribute.current {
+			continue
+		}
+
+		if err = payload.AddStringOperation("replace", attribute.path, attribute.desired); err != nil {
+			return nil, nil, err
+		}
+
+		changes = append(changes, attribute.path)
+	}
+
+	if desiredEmail := primarySCIMEmail(desired.Emails); desiredEmail != nil &&
+		!strings.EqualFold(desiredEmail.Value, primarySCIMEmailValue(current.Emails)) {
+
+		email := &SCIMUserComplexOperationScheme{
+			Value:     desiredEmail.Value,
+			ValueType: desiredEmail.Type,
+			Primary:   true,
+		}
+
+		if len(email.ValueType) == 0 {
+			email.ValueType = "work"
+		}
+
+		if err = payload.AddComplexOperation("replace", "emails", []*SCIMUserComplexOperationScheme{email}); err != nil {
+			return nil, nil, err
+		}
+
+		changes = append(changes, "emails")
+	}
+
+	if !current.Active {
+
+		if err = payload.AddBoolOperation("replace", "active", true); err != nil {
+			return nil, nil, err
+		}
+
+		changes = append(changes, "active")
+	}
+
+	return
+}
+
+func primarySCIMEmail(emails []*SCIMUserEmailScheme) *SCIMUserEmailScheme {
+
+	for _, email := range emails {
+		if email.Primary {
+			return email
+		}
+	}
+
+	if len(emails) != 0 {
+		return emails[0]
+	}
+
+	return nil
+}
+
+func primarySCIMEmailValue(emails []*SCIMUserEmailScheme) string {
+
+	if email := primarySCIMEmail(emails); email != nil {
+		return email.Value
+	}
+
+	return ""
+}
+
+// scimUserKeys returns the lowercase keys used to match the users, the externalId goes first.
+func scimUserKeys(user *SCIMUserScheme) (keys []string) {
+
+	if len(user.ExternalID) != 0 {
+		keys = append(keys, "externalid:"+strings.ToLower(user.ExternalID))
+	}
+
+	if len(user.UserName) != 0 {
+		keys = append(keys, strings.ToLower(user.UserName))
+	}
+
+	return
+}
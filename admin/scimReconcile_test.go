@@ -0,0 +1,453 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeSCIMDirectory is an in-memory SCIM directory used to verify the reconciliation results.
+type fakeSCIMDirectory struct {
+	mu          sync.Mutex
+	users       []*SCIMUserScheme
+	groups      []*SCIMGroupScheme
+	writes      []string
+	rateLimited int
+}
+
+func newFakeSCIMDirectory() *fakeSCIMDirectory {
+
+	return &fakeSCIMDirectory{
+		users: []*SCIMUserScheme{
+			{ID: "u1", ExternalID: "E1", UserName: "alice@go-atlassian.io", DisplayName: "Alice", Active: true},
+			{ID: "u2", UserName: "bob@go-atlassian.io", DisplayName: "Bob", Title: "Developer", Active: true},
+			{ID: "u3", UserName: "carol@go-atlassian.io", DisplayName: "Carol", Active: true},
+			{ID: "u4", UserName: "dave@go-atlassian.io", DisplayName: "Dave", Active: false},
+		},
+		groups: []*SCIMGroupScheme{
+			{ID: "g1", DisplayName: "jira-users", Members: []*SCIMGroupMemberScheme{{Value: "u1"}, {Value: "u3"}}},
+		},
+	}
+}
+
+func (f *fakeSCIMDirectory) handler() http.Handler {
+
+	const prefix = "/scim/directory/d1/"
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if f.rateLimited > 0 {
+			f.rateLimited--
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, prefix), "/")
+
+		if r.Method != http.MethodGet {
+			f.writes = append(f.writes, r.Method+" "+strings.Join(parts, "/"))
+		}
+
+		switch {
+
+		case parts[0] == "Users" && len(parts) == 1 && r.Method == http.MethodGet:
+
+			var resources []interface{}
+			for _, user := range f.users {
+				resources = append(resources, user)
+			}
+
+			f.page(w, r, resources)
+
+		case parts[0] == "Users" && len(parts) == 1 && r.Method == http.MethodPost:
+
+			user := new(SCIMUserScheme)
+			_ = json.NewDecoder(r.Body).Decode(user)
+
+			user.ID = "u" + strconv.Itoa(len(f.users)+1)
+			f.users = append(f.users, user)
+
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(user)
+
+		case parts[0] == "Users" && len(parts) == 2:
+
+			user := f.user(parts[1])
+			if user == nil {
+				http.Error(w, "user not found", http.StatusNotFound)
+				return
+			}
+
+			if r.Method == http.MethodDelete {
+				user.Active = false
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			payload := new(SCIMUserToPathScheme)
+			_ = json.NewDecoder(r.Body).Decode(payload)
+
+			for _, operation := range payload.Operations {
+
+				switch operation.Path {
+				case "displayName":
+					user.DisplayName = operation.Value.(string)
+				case "title":
+					user.Title = operation.Value.(string)
+				case "active":
+					user.Active = operation.Value.(bool)
+				}
+			}
+
+			_ = json.NewEncoder(w).Encode(user)
+
+		case parts[0] == "Groups" && len(parts) == 1 && r.Method == http.MethodGet:
+
+			// The group pages don't include the members
+			var resources []interface{}
+			for _, group := range f.groups {
+				resources = append(resources, &SCIMGroupScheme{ID: group.ID, DisplayName: group.DisplayName})
+			}
+
+			f.page(w, r, resources)
+
+		case parts[0] == "Groups" && len(parts) == 1 && r.Method == http.MethodPost:
+
+			group := new(SCIMGroupScheme)
+			_ = json.NewDecoder(r.Body).Decode(group)
+
+			group.ID = "g" + strconv.Itoa(len(f.groups)+1)
+			f.groups = append(f.groups, group)
+
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(group)
+
+		case parts[0] == "Groups" && len(parts) == 2:
+
+			var group *SCIMGroupScheme
+			for _, candidate := range f.groups {
+				if candidate.ID == parts[1] {
+					group = candidate
+				}
+			}
+
+			if group == nil {
+				http.Error(w, "group not found", http.StatusNotFound)
+				return
+			}
+
+			if r.Method == http.MethodPatch {
+
+				payload := struct {
+					Operations []struct {
+						Op    string                           `json:"op"`
+						Value []*SCIMGroupOperationValueScheme `json:"value"`
+					} `json:"Operations"`
+				}{}
+
+				_ = json.NewDecoder(r.Body).Decode(&payload)
+
+				for _, operation := range payload.Operations {
+					for _, value := range operation.Value {
+
+						if operation.Op == "add" {
+							group.Members = append(group.Members, &SCIMGroupMemberScheme{Value: value.Value})
+							continue
+						}
+
+						var members []*SCIMGroupMemberScheme
+						for _, member := range group.Members {
+							if member.Value != value.Value {
+								members = append(members, member)
+							}
+						}
+						group.Members = members
+					}
+				}
+			}
+
+			_ = json.NewEncoder(w).Encode(group)
+
+		default:
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+		}
+	})
+}
+
+func (f *fakeSCIMDirectory) page(w http.ResponseWriter, r *http.Request, resources []interface{}) {
+
+	startIndex, _ := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+
+	from, to := startIndex-1, startIndex-1+count
+	if to > len(resources) {
+		to = len(resources)
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"totalResults": len(resources),
+		"startIndex":   startIndex,
+		"itemsPerPage": count,
+		"Resources":    resources[from:to],
+	})
+}
+
+func (f *fakeSCIMDirectory) user(id string) *SCIMUserScheme {
+
+	for _, user := range f.users {
+		if user.ID == id {
+			return user
+		}
+	}
+
+	return nil
+}
+
+func TestSCIMService_Reconcile(t *testing.T) {
+
+	desired := &SCIMReconcileDesiredScheme{
+		Users: []*SCIMUserScheme{
+			{ExternalID: "E1", UserName: "alice@go-atlassian.io", DisplayName: "Alice Smith"},
+			{UserName: "bob@go-atlassian.io", Title: "Developer"},
+			{UserName: "erin@go-atlassian.io", DisplayName: "Erin"},
+		},
+		Groups: []*SCIMReconcileGroupScheme{
+			{DisplayName: "jira-users", Members: []string{"E1", "erin@go-atlassian.io", "bob@go-atlassian.io"}},
+			{DisplayName: "confluence-users", Members: []string{"alice@go-atlassian.io"}},
+		},
+	}
+
+	wantActions := []string{
+		"create_user erin@go-atlassian.io",
+		"update_user alice@go-atlassian.io [displayName]",
+		"create_group confluence-users",
+		"add_members jira-users [erin@go-atlassian.io bob@go-atlassian.io]",
+		"remove_members jira-users [carol@go-atlassian.io]",
+		"add_members confluence-users [alice@go-atlassian.io]",
+		"deactivate_user carol@go-atlassian.io",
+	}
+
+	testCases := []struct {
+		name        string
+		dryRun      bool
+		rateLimited int
+		wantStatus  string
+		wantWrites  int
+	}{
+		{
+			name:       "ReconcileWhenTheDryRunIsEnabled",
+			dryRun:     true,
+			wantStatus: SCIMReconcilePlannedStatus,
+			wantWrites: 0,
+		},
+		{
+			name:       "ReconcileWhenTheDryRunIsDisabled",
+			dryRun:     false,
+			wantStatus: SCIMReconcileAppliedStatus,
+			wantWrites: 7,
+		},
+		{
+			name:        "ReconcileWhenTheAPIIsRateLimiting",
+			dryRun:      false,
+			rateLimited: 2,
+			wantStatus:  SCIMReconcileAppliedStatus,
+			wantWrites:  7,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			directory := newFakeSCIMDirectory()
+			directory.rateLimited = testCase.rateLimited
+
+			mockServer := httptest.NewServer(directory.handler())
+			defer mockServer.Close()
+
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			opts := &SCIMReconcileOptionsScheme{
+				DryRun:            testCase.dryRun,
+				DeactivateMissing: true,
+				MaxRetries:        3,
+				PageSize:          2,
+			}
+
+			report, err := mockClient.SCIM.Reconcile(context.Background(), "d1", desired, opts)
+			assert.NoError(t, err)
+
+			var gotActions []string
+			for _, action := range report.Actions {
+
+				description := action.Type + " " + action.Target
+				if len(action.Changes) != 0 {
+					description += fmt.Sprint(" ", action.Changes)
+				}
+
+				if len(action.Members) != 0 {
+					description += fmt.Sprint(" ", action.Members)
+				}
+
+				gotActions = append(gotActions, description)
+				assert.Equal(t, testCase.wantStatus, action.Status, action.Error)
+			}
+
+			assert.Equal(t, wantActions, gotActions)
+			assert.Len(t, directory.writes, testCase.wantWrites)
+			assert.Equal(t, testCase.dryRun, report.DryRun)
+
+			if testCase.dryRun {
+				assert.Equal(t, len(wantActions), report.Summary.Planned)
+				return
+			}
+
+			assert.Equal(t, len(wantActions), report.Summary.Applied)
+
+			// The second run must not find any difference
+			rerun, err := mockClient.SCIM.Reconcile(context.Background(), "d1", desired, opts)
+			assert.NoError(t, err)
+			assert.Empty(t, rerun.Actions)
+			assert.Len(t, directory.writes, testCase.wantWrites)
+
+			var members []string
+			for _, member := range directory.groups[0].Members {
+				members = append(members, member.Value)
+			}
+
+			assert.Equal(t, []string{"u1", "u5", "u2"}, members)
+			assert.False(t, directory.user("u3").Active)
+		})
+	}
+}
+
+func TestSCIMService_ReconcileWhenTheActionsFail(t *testing.T) {
+
+	directory := newFakeSCIMDirectory()
+
+	mux := http.NewServeMux()
+	mux.Handle("/", directory.handler())
+	mux.HandleFunc("/scim/directory/d1/Users/u3", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "forbidden", http.StatusForbidden)
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	desired := &SCIMReconcileDesiredScheme{
+		Users: []*SCIMUserScheme{
+			{ExternalID: "E1", UserName: "alice@go-atlassian.io"},
+			{UserName: "bob@go-atlassian.io"},
+		},
+	}
+
+	report, err := mockClient.SCIM.Reconcile(context.Background(), "d1", desired, &SCIMReconcileOptionsScheme{DeactivateMissing: true})
+	assert.Error(t, err)
+
+	if assert.Len(t, report.Actions, 1) {
+		assert.Equal(t, SCIMReconcileFailedStatus, report.Actions[0].Status)
+		assert.NotEmpty(t, report.Actions[0].Error)
+	}
+
+	assert.Equal(t, 1, report.Summary.Failed)
+}
+
+func Test_planSCIMReconcile(t *testing.T) {
+
+	current := &scimDirectoryState{
+		users: []*SCIMUserScheme{
+			{ID: "u1", ExternalID: "E1", UserName: "alice@go-atlassian.io", Active: false,
+				Emails: []*SCIMUserEmailScheme{{Value: "alice@go-atlassian.io", Primary: true}}},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		desired     *SCIMReconcileDesiredScheme
+		wantChanges []string
+		wantErr     bool
+	}{
+		{
+			name: "PlanWhenTheUserIsMatchedByTheExternalID",
+			desired: &SCIMReconcileDesiredScheme{Users: []*SCIMUserScheme{
+				{ExternalID: "E1", UserName: "alice.smith@go-atlassian.io",
+					Emails: []*SCIMUserEmailScheme{{Value: "alice.smith@go-atlassian.io"}}},
+			}},
+			wantChanges: []string{"userName", "emails", "active"},
+		},
+		{
+			name: "PlanWhenTheUserNameIsDuplicated",
+			desired: &SCIMReconcileDesiredScheme{Users: []*SCIMUserScheme{
+				{UserName: "alice@go-atlassian.io"},
+				{UserName: "Alice@go-atlassian.io"},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "PlanWhenTheUserNameIsNotSet",
+			desired: &SCIMReconcileDesiredScheme{Users: []*SCIMUserScheme{{DisplayName: "Alice"}}},
+			wantErr: true,
+		},
+		{
+			name: "PlanWhenTheGroupMemberDoesNotExist",
+			desired: &SCIMReconcileDesiredScheme{Groups: []*SCIMReconcileGroupScheme{
+				{DisplayName: "jira-users", Members: []string{"erin@go-atlassian.io"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "PlanWhenTheGroupIsDuplicated",
+			desired: &SCIMReconcileDesiredScheme{Groups: []*SCIMReconcileGroupScheme{
+				{DisplayName: "jira-users"},
+				{DisplayName: "JIRA-USERS"},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actions, err := planSCIMReconcile(current, testCase.desired, false)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			if assert.Len(t, actions, 1) {
+				assert.Equal(t, SCIMReconcileUpdateUserAction, actions[0].Type)
+				assert.Equal(t, testCase.wantChanges, actions[0].Changes)
+			}
+		})
+	}
+}
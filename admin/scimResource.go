@@ -1,3 +1,107 @@
 package admin
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
 type SCIMResourceService struct{ client *Client }
+
+type SCIMResourceTypePageScheme struct {
+	Schemas      []string                  `json:"schemas,omitempty"`
+	TotalResults int                       `json:"totalResults,omitempty"`
+	StartIndex   int                       `json:"startIndex,omitempty"`
+	ItemsPerPage int                       `json:"itemsPerPage,omitempty"`
+	Resources    []*SCIMResourceTypeScheme `json:"Resources,omitempty"`
+}
+
+type SCIMResourceTypeScheme struct {
+	Schemas          []string                           `json:"schemas,omitempty"`
+	ID               string                             `json:"id,omitempty"`
+	Name             string                             `json:"name,omitempty"`
+	Endpoint         string                             `json:"endpoint,omitempty"`
+	Description      string                             `json:"description,omitempty"`
+	Schema           string                             `json:"schema,omitempty"`
+	SchemaExtensions []*SCIMResourceTypeExtensionScheme `json:"schemaExtensions,omitempty"`
+	Meta             *SCIMUserMetaScheme                `json:"meta,omitempty"`
+}
+
+type SCIMResourceTypeExtensionScheme struct {
+	Schema   string `json:"schema,omitempty"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// Get metadata about the supported SCIM types (User, Group).
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value (REQUIRED)
+// 2. directoryId = Directory Id (REQUIRED)
+// Atlassian Docs: https://developer.atlassian.com/cloud/admin/user-provisioning/rest/api-group-resource-types/#api-scim-directory-directoryid-resourcetypes-get
+// Library Docs: N/A
+func (s *SCIMResourceService) Gets(ctx context.Context, directoryID string) (result *SCIMResourceTypePageScheme, response *Response, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	var endpoint = fmt.Sprintf("/scim/directory/%v/ResourceTypes", directoryID)
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/ResourceTypes", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SCIMResourceTypePageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Get metadata about a SCIM type by its ID (User, Group).
+// --- This func needs the following parameters: ---
+// 1. ctx = it's the context.context value (REQUIRED)
+// 2. directoryId = Directory Id (REQUIRED)
+// 3. resourceTypeId = The resource type ID, e.g: User or Group (REQUIRED)
+// Atlassian Docs: https://developer.atlassian.com/cloud/admin/user-provisioning/rest/api-group-resource-types/#api-scim-directory-directoryid-resourcetypes-id-get
+// Library Docs: N/A
+func (s *SCIMResourceService) Get(ctx context.Context, directoryID, resourceTypeID string) (result *SCIMResourceTypeScheme, response *Response, err error) {
+
+	if len(directoryID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid directoryID value")
+	}
+
+	if len(resourceTypeID) == 0 {
+		return nil, nil, fmt.Errorf("error!, please provide a valid resourceTypeID value")
+	}
+
+	var endpoint = fmt.Sprintf("/scim/directory/%v/ResourceTypes/%v", directoryID, resourceTypeID)
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/ResourceTypes/{resourceTypeID}", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SCIMResourceTypeScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,251 @@
+package admin
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestSCIMResourceService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		directoryID        string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetSCIMResourceTypesWhenTheParametersAreCorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			mockFile:           "./mocks/scim-get-resource-types.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetSCIMResourceTypesWhenTheDirectoryIDIsNotSet",
+			directoryID:        "",
+			mockFile:           "./mocks/scim-get-resource-types.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetSCIMResourceTypesWhenTheRequestMethodIsIncorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			mockFile:           "./mocks/scim-get-resource-types.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetSCIMResourceTypesWhenTheContextIsNil",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			mockFile:           "./mocks/scim-get-resource-types.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetSCIMResourceTypesWhenTheResponseBodyIsEmpty",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			mockFile:           "./mocks/empty.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SCIMResourceService{client: mockClient}
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.directoryID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				if assert.Len(t, gotResult.Resources, 2) {
+					assert.Equal(t, "/Groups", gotResult.Resources[1].Endpoint)
+				}
+			}
+
+		})
+	}
+
+}
+
+func TestSCIMResourceService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                        string
+		directoryID, resourceTypeID string
+		mockFile                    string
+		wantHTTPMethod              string
+		endpoint                    string
+		context                     context.Context
+		wantHTTPCodeReturn          int
+		wantErr                     bool
+	}{
+		{
+			name:               "GetSCIMResourceTypeWhenTheParametersAreCorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			resourceTypeID:     "User",
+			mockFile:           "./mocks/scim-get-resource-type.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes/User",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetSCIMResourceTypeWhenTheResourceTypeIDIsNotSet",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			resourceTypeID:     "",
+			mockFile:           "./mocks/scim-get-resource-type.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes/User",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetSCIMResourceTypeWhenTheDirectoryIDIsNotSet",
+			directoryID:        "",
+			resourceTypeID:     "User",
+			mockFile:           "./mocks/scim-get-resource-type.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes/User",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetSCIMResourceTypeWhenTheStatusCodeIsIncorrect",
+			directoryID:        "bcdde508-ee40-4df2-89cc-d3f6292c5971",
+			resourceTypeID:     "User",
+			mockFile:           "./mocks/scim-get-resource-type.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/scim/directory/bcdde508-ee40-4df2-89cc-d3f6292c5971/ResourceTypes/User",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNotFound,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SCIMResourceService{client: mockClient}
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.directoryID, testCase.resourceTypeID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				assert.Equal(t, "urn:ietf:params:scim:schemas:core:2.0:User", gotResult.Schema)
+			}
+
+		})
+	}
+
+}
@@ -23,7 +23,7 @@ func (s *SCIMSchemeService) Gets(ctx context.Context, directoryID string) (resul
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Schemas", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/Schemas", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -94,7 +94,7 @@ func (s *SCIMSchemeService) Group(ctx context.Context, directoryID string) (resu
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Schemas/urn:ietf:params:scim:schemas:core:2.0:Group", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/Schemas/urn:ietf:params:scim:schemas:core:2.0:Group", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -127,7 +127,7 @@ func (s *SCIMSchemeService) User(ctx context.Context, directoryID string) (resul
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Schemas/urn:ietf:params:scim:schemas:core:2.0:User", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/Schemas/urn:ietf:params:scim:schemas:core:2.0:User", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -160,7 +160,7 @@ func (s *SCIMSchemeService) Enterprise(ctx context.Context, directoryID string)
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Schemas/urn:ietf:params:scim:schemas:extension:enterprise:2.0:User", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/Schemas/urn:ietf:params:scim:schemas:extension:enterprise:2.0:User", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -226,7 +226,7 @@ func (s *SCIMSchemeService) Feature(ctx context.Context, directoryID string) (re
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/ServiceProviderConfig", directoryID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/ServiceProviderConfig", endpoint, nil)
 	if err != nil {
 		return
 	}
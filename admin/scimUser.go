@@ -73,7 +73,7 @@ func (s *SCIMUserService) Create(ctx context.Context, directoryID string, payloa
 		endpoint = fmt.Sprintf("/scim/directory/%v/Users", directoryID)
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := s.client.newRequest(ctx, http.MethodPost, "scim/directory/{directoryID}/Users", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -217,7 +217,7 @@ func (s *SCIMUserService) Gets(ctx context.Context, directoryID string, opts *SC
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Users?%v", directoryID, params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/Users", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -303,7 +303,7 @@ func (s *SCIMUserService) Get(ctx context.Context, directoryID, userID string, a
 		endpoint = fmt.Sprintf("/scim/directory/%v/Users/%v", directoryID, userID)
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "scim/directory/{directoryID}/Users/{userID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -344,7 +344,7 @@ func (s *SCIMUserService) Deactivate(ctx context.Context, directoryID, userID st
 
 	var endpoint = fmt.Sprintf("/scim/directory/%v/Users/%v", directoryID, userID)
 
-	request, err := s.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodDelete, "scim/directory/{directoryID}/Users/{userID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -421,7 +421,7 @@ func (s *SCIMUserService) Update(ctx context.Context, directoryID, userID string
 		endpoint = fmt.Sprintf("/scim/directory/%v/Users/%v", directoryID, userID)
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodPatch, endpoint, payload)
+	request, err := s.client.newRequest(ctx, http.MethodPatch, "scim/directory/{directoryID}/Users/{userID}", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -617,7 +617,7 @@ func (s *SCIMUserService) Overwrite(ctx context.Context, directoryID, userID str
 		endpoint = fmt.Sprintf("/scim/directory/%v/Users/%v", directoryID, userID)
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodPut, endpoint, payload)
+	request, err := s.client.newRequest(ctx, http.MethodPut, "scim/directory/{directoryID}/Users/{userID}", endpoint, payload)
 	if err != nil {
 		return
 	}
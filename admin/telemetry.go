@@ -0,0 +1,15 @@
+package admin
+
+import (
+	"github.com/ctreminiom/go-atlassian/internal/instrument"
+	"github.com/ctreminiom/go-atlassian/telemetry"
+)
+
+// WithInstrumentation calls the instrumentation for every request of the client, e.g: to create the OpenTelemetry
+// spans and metrics. The requests are described with the endpoint templates of the service methods,
+// e.g: admin/v1/orgs/{organizationID}/users
+func WithInstrumentation(instrumentation telemetry.Instrumentation) ClientOption {
+	return func(c *Client) {
+		c.instrumentation = instrument.New(instrumentation, telemetry.ProductAdmin)
+	}
+}
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/telemetry"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type instrumentationContextKey struct{}
+
+type recordingInstrumentation struct {
+	requests []*telemetry.RequestScheme
+	results  []*telemetry.ResultScheme
+}
+
+func (r *recordingInstrumentation) Start(ctx context.Context, request *telemetry.RequestScheme) (context.Context, telemetry.Span) {
+	r.requests = append(r.requests, request)
+	return context.WithValue(ctx, instrumentationContextKey{}, len(r.requests)), r
+}
+
+func (r *recordingInstrumentation) End(result *telemetry.ResultScheme) {
+	r.results = append(r.results, result)
+}
+
+// contextTransport records the instrumentation values of the request contexts
+type contextTransport struct {
+	values []interface{}
+}
+
+func (c *contextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	c.values = append(c.values, request.Context().Value(instrumentationContextKey{}))
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func TestWithInstrumentation(t *testing.T) {
+
+	var calls int
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		calls++
+
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer mockServer.Close()
+
+	instrumentation := &recordingInstrumentation{}
+
+	mockClient, err := New(nil, WithInstrumentation(instrumentation))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mockClient.Site, err = url.Parse(mockServer.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	// The retries of the throttle are reported to the instrumentation
+	throttle := &requestThrottle{maxRetries: 1}
+
+	err = throttle.call(context.Background(), func(ctx context.Context) (*Response, error) {
+		response, err := mockClient.User.Disable(ctx, "5b86be50b8e3cb5895860d6d", "Inactive for 90 days")
+		return response, err
+	})
+
+	assert.NoError(t, err)
+
+	if assert.Len(t, instrumentation.requests, 2) && assert.Len(t, instrumentation.results, 2) {
+
+		assert.Equal(t, telemetry.ProductAdmin, instrumentation.requests[0].Product)
+		assert.Equal(t, "users/{accountID}/manage/lifecycle/disable", instrumentation.requests[0].Route)
+		assert.Equal(t, 0, instrumentation.requests[0].Retries)
+		assert.Equal(t, http.StatusTooManyRequests, instrumentation.results[0].StatusCode)
+
+		assert.Equal(t, 1, instrumentation.requests[1].Retries)
+		assert.Equal(t, http.StatusNoContent, instrumentation.results[1].StatusCode)
+	}
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/internal/httplog"
+	"github.com/ctreminiom/go-atlassian/internal/retry"
+	"github.com/ctreminiom/go-atlassian/telemetry"
+	"net/http"
+	"time"
+)
+
+// requestThrottle spaces the requests by the interval and retries the requests rejected with
+// the 429 status code, it waits the time returned on the Retry-After header or a exponential backoff.
+type requestThrottle struct {
+	interval   time.Duration
+	maxRetries int
+	last       time.Time
+	logger     *httplog.Hook
+}
+
+func (t *requestThrottle) call(ctx context.Context, request func(ctx context.Context) (*Response, error)) (err error) {
+
+	for attempt := 0; ; attempt++ {
+
+		if wait := t.interval - time.Since(t.last); t.interval > 0 && wait > 0 {
+			if err = retry.Sleep(ctx, wait); err != nil {
+				return
+			}
+		}
+
+		var response *Response
+		response, err = request(telemetry.WithRetries(ctx, attempt))
+		t.last = time.Now()
+
+		if err == nil || response == nil || response.StatusCode != http.StatusTooManyRequests || attempt >= t.maxRetries {
+			return
+		}
+
+		wait := retry.After(http.Header(response.Headers), attempt)
+		t.logger.Retry(response.Method, response.Endpoint, attempt+1, wait, response.StatusCode)
+
+		if err = retry.Sleep(ctx, wait); err != nil {
+			return
+		}
+	}
+}
@@ -49,7 +49,7 @@ func (u *UserService) Permissions(ctx context.Context, accountID string, privile
 		endpoint = fmt.Sprintf("/users/%v/manage", accountID)
 	}
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "users/{accountID}/manage", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -82,7 +82,7 @@ func (u *UserService) Get(ctx context.Context, accountID string) (result *UserSc
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/profile", accountID)
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "users/{accountID}/manage/profile", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -266,7 +266,7 @@ func (u *UserService) Update(ctx context.Context, accountID string, payload map[
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/profile", accountID)
 
-	request, err := u.client.newRequest(ctx, http.MethodPatch, endpoint, payload)
+	request, err := u.client.newRequest(ctx, http.MethodPatch, "users/{accountID}/manage/profile", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -314,7 +314,7 @@ func (u *UserService) Disable(ctx context.Context, accountID, message string) (r
 			Message string `json:"message"`
 		}{Message: message}
 
-		request, err = u.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+		request, err = u.client.newRequest(ctx, http.MethodPost, "users/{accountID}/manage/lifecycle/disable", endpoint, payload)
 		if err != nil {
 			return
 		}
@@ -323,7 +323,7 @@ func (u *UserService) Disable(ctx context.Context, accountID, message string) (r
 		request.Header.Set("Content-Type", "application/json")
 
 	} else {
-		request, err = u.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+		request, err = u.client.newRequest(ctx, http.MethodPost, "users/{accountID}/manage/lifecycle/disable", endpoint, nil)
 		if err != nil {
 			return
 		}
@@ -354,7 +354,7 @@ func (u *UserService) Enable(ctx context.Context, accountID string) (response *R
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/lifecycle/enable", accountID)
 
-	request, err := u.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodPost, "users/{accountID}/manage/lifecycle/enable", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,408 @@
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+type UserStaleOptionsScheme struct {
+	InactiveDays        int            // The days without activity to consider an account stale (REQUIRED)
+	ProductInactiveDays map[string]int // Overrides the inactive days per product key, e.g: jira-software: 30
+	IncludeNeverActive  bool           // Includes the accounts without activity dates, e.g: invited accounts
+	BillableOnly        bool           // Includes only the accounts that consume a seat
+
+	ExcludedAccountIDs []string // The allow-listed accounts
+	ExcludedEmails     []string // The allow-listed emails
+	ExcludedGroups     []string // The allow-listed groups display names, the members are read from the SCIM directory
+	DirectoryID        string   // The SCIM directory of the excluded groups
+
+	Now time.Time // The reference time to calculate the inactivity, the default value is the current time
+}
+
+// UserLifecyclePlanScheme contains the managed accounts to disable, the stale accounts excluded by the allow-lists
+// and the accounts excluded because their last activity dates can't be parsed
+type UserLifecyclePlanScheme struct {
+	OrganizationID string                          `json:"organizationId"`
+	GeneratedAt    time.Time                       `json:"generatedAt"`
+	Accounts       []*UserLifecycleCandidateScheme `json:"accounts,omitempty"`
+	Excluded       []*UserLifecycleCandidateScheme `json:"excluded,omitempty"`
+}
+
+type UserLifecycleCandidateScheme struct {
+	AccountID    string                        `json:"accountId"`
+	Name         string                        `json:"name,omitempty"`
+	Email        string                        `json:"email,omitempty"`
+	LastActive   string                        `json:"lastActive,omitempty"`
+	InactiveDays int                           `json:"inactiveDays"` // -1 when the account was never active
+	Products     []*UserLifecycleProductScheme `json:"products,omitempty"`
+	Reason       string                        `json:"reason,omitempty"` // The reason of the exclusion
+}
+
+type UserLifecycleProductScheme struct {
+	Key          string `json:"key"`
+	LastActive   string `json:"lastActive,omitempty"`
+	InactiveDays int    `json:"inactiveDays"` // -1 when the product was never used
+	Threshold    int    `json:"threshold"`
+}
+
+type UserLifecycleOptionsScheme struct {
+	Message    string        // The message shown to the disabled users on attempted authentication
+	Interval   time.Duration // The minimum time between requests
+	MaxRetries int           // The retries of the requests rejected by the rate limit
+	UndoLog    io.Writer     // Records every disabled account as a JSON line, use it with the Revert func
+}
+
+type UserLifecycleResultScheme struct {
+	Succeeded []string                            `json:"succeeded,omitempty"`
+	Failed    []*UserLifecycleFailedAccountScheme `json:"failed,omitempty"`
+}
+
+type UserLifecycleFailedAccountScheme struct {
+	AccountID string `json:"accountId"`
+	Error     string `json:"error"`
+}
+
+// UserLifecycleLogEntryScheme is an entry of the undo log
+type UserLifecycleLogEntryScheme struct {
+	Action    string    `json:"action"`
+	AccountID string    `json:"accountId"`
+	Time      time.Time `json:"time"`
+}
+
+const UserLifecycleDisableAction = "disable"
+
+// Stale returns the plan of the managed accounts inactive for more days than the thresholds, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. organizationID = ID of the organization (REQUIRED)
+// 3. opts = the inactivity thresholds and allow-lists (REQUIRED)
+// An account is stale when every product it can access is inactive for more days than the product threshold,
+// the accounts already disabled are ignored.
+// Library Docs: N/A
+func (u *UserService) Stale(ctx context.Context, organizationID string, opts *UserStaleOptionsScheme) (plan *UserLifecyclePlanScheme, err error) {
+
+	if len(organizationID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid organizationID value")
+	}
+
+	if opts == nil || opts.InactiveDays <= 0 {
+		return nil, fmt.Errorf("error!, please provide a valid UserStaleOptionsScheme pointer with the InactiveDays value")
+	}
+
+	if len(opts.ExcludedGroups) != 0 && len(opts.DirectoryID) == 0 {
+		return nil, fmt.Errorf("error!, please provide the DirectoryID value to read the members of the excluded groups")
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	excludedAccounts, excludedEmails := lowerSet(opts.ExcludedAccountIDs), lowerSet(opts.ExcludedEmails)
+
+	groupMembers, err := u.groupMembersEmails(ctx, opts.DirectoryID, opts.ExcludedGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	plan = &UserLifecyclePlanScheme{OrganizationID: organizationID, GeneratedAt: now}
+
+	for cursor := ""; ; {
+
+		page, _, err := u.client.Organization.Users(ctx, organizationID, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range page.Data {
+
+			if user.AccountStatus != "active" || (opts.BillableOnly && !user.AccessBillable) {
+				continue
+			}
+
+			// The dates with an unexpected format are reported, the inactivity of the account can't be calculated
+			days, dateErr := inactiveDays(user.LastActive, now)
+
+			candidate := &UserLifecycleCandidateScheme{
+				AccountID:    user.AccountID,
+				Name:         user.Name,
+				Email:        user.Email,
+				LastActive:   user.LastActive,
+				InactiveDays: days,
+			}
+
+			stale := isStale(candidate.InactiveDays, opts.InactiveDays, opts.IncludeNeverActive)
+
+			// The accounts with product access are stale when every product is stale
+			if len(user.ProductAccess) != 0 {
+				stale = true
+			}
+
+			for _, product := range user.ProductAccess {
+
+				threshold, ok := opts.ProductInactiveDays[product.Key]
+				if !ok {
+					threshold = opts.InactiveDays
+				}
+
+				days, err := inactiveDays(product.LastActive, now)
+				if err != nil && dateErr == nil {
+					dateErr = err
+				}
+
+				access := &UserLifecycleProductScheme{
+					Key:          product.Key,
+					LastActive:   product.LastActive,
+					InactiveDays: days,
+					Threshold:    threshold,
+				}
+
+				candidate.Products = append(candidate.Products, access)
+				stale = stale && isStale(access.InactiveDays, threshold, opts.IncludeNeverActive)
+			}
+
+			if dateErr != nil {
+				candidate.Reason = dateErr.Error()
+				plan.Excluded = append(plan.Excluded, candidate)
+				continue
+			}
+
+			if !stale {
+				continue
+			}
+
+			switch {
+			case excludedAccounts[strings.ToLower(user.AccountID)]:
+				candidate.Reason = "allow-listed account"
+			case len(user.Email) != 0 && excludedEmails[strings.ToLower(user.Email)]:
+				candidate.Reason = "allow-listed email"
+			case len(user.Email) != 0 && len(groupMembers[strings.ToLower(user.Email)]) != 0:
+				candidate.Reason = "member of the allow-listed group " + groupMembers[strings.ToLower(user.Email)]
+			}
+
+			if len(candidate.Reason) != 0 {
+				plan.Excluded = append(plan.Excluded, candidate)
+				continue
+			}
+
+			plan.Accounts = append(plan.Accounts, candidate)
+		}
+
+		cursor, err = nextCursor(page.Links.Next)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cursor) == 0 || len(page.Data) == 0 {
+			break
+		}
+	}
+
+	sort.SliceStable(plan.Accounts, func(i, j int) bool { return plan.Accounts[i].InactiveDays > plan.Accounts[j].InactiveDays })
+
+	return plan, nil
+}
+
+// DisableBulk disables the accounts of the plan, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. plan = the plan returned by the Stale func (REQUIRED)
+// 3. opts = the message, the rate limit options and the undo log
+// The accounts are disabled one by one, the failed accounts don't stop the run and they're returned on the result.
+// Library Docs: N/A
+func (u *UserService) DisableBulk(ctx context.Context, plan *UserLifecyclePlanScheme, opts *UserLifecycleOptionsScheme) (result *UserLifecycleResultScheme, err error) {
+
+	if plan == nil {
+		return nil, fmt.Errorf("error!, please provide a valid UserLifecyclePlanScheme pointer")
+	}
+
+	if opts == nil {
+		opts = &UserLifecycleOptionsScheme{}
+	}
+
+	var (
+		throttle = &requestThrottle{interval: opts.Interval, maxRetries: opts.MaxRetries, logger: u.client.logger}
+		encoder  *json.Encoder
+	)
+
+	if opts.UndoLog != nil {
+		encoder = json.NewEncoder(opts.UndoLog)
+	}
+
+	result = new(UserLifecycleResultScheme)
+
+	for _, account := range plan.Accounts {
+
+		err = throttle.call(ctx, func(ctx context.Context) (*Response, error) {
+			return u.Disable(ctx, account.AccountID, opts.Message)
+		})
+
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		if err != nil {
+			result.Failed = append(result.Failed, &UserLifecycleFailedAccountScheme{AccountID: account.AccountID, Error: err.Error()})
+			continue
+		}
+
+		if encoder != nil {
+
+			entry := &UserLifecycleLogEntryScheme{Action: UserLifecycleDisableAction, AccountID: account.AccountID, Time: time.Now().UTC()}
+			if err = encoder.Encode(entry); err != nil {
+				return result, fmt.Errorf("error!, the account %v was disabled but the undo log can't be written: %v", account.AccountID, err)
+			}
+		}
+
+		result.Succeeded = append(result.Succeeded, account.AccountID)
+	}
+
+	return result, nil
+}
+
+// Revert enables the accounts disabled by a DisableBulk run, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. undoLog = the undo log written by the DisableBulk func (REQUIRED)
+// 3. opts = the rate limit options, the message and undo log are ignored
+// Library Docs: N/A
+func (u *UserService) Revert(ctx context.Context, undoLog io.Reader, opts *UserLifecycleOptionsScheme) (result *UserLifecycleResultScheme, err error) {
+
+	if undoLog == nil {
+		return nil, fmt.Errorf("error!, please provide a valid undo log reader")
+	}
+
+	if opts == nil {
+		opts = &UserLifecycleOptionsScheme{}
+	}
+
+	var (
+		accounts []string
+		seen     = make(map[string]bool)
+		scanner  = bufio.NewScanner(undoLog)
+	)
+
+	for line := 1; scanner.Scan(); line++ {
+
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+
+		entry := new(UserLifecycleLogEntryScheme)
+		if err = json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return nil, fmt.Errorf("error!, the undo log line %v is not valid: %v", line, err)
+		}
+
+		if entry.Action != UserLifecycleDisableAction || len(entry.AccountID) == 0 || seen[entry.AccountID] {
+			continue
+		}
+
+		seen[entry.AccountID] = true
+		accounts = append(accounts, entry.AccountID)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	throttle := &requestThrottle{interval: opts.Interval, maxRetries: opts.MaxRetries, logger: u.client.logger}
+	result = new(UserLifecycleResultScheme)
+
+	for _, accountID := range accounts {
+
+		err = throttle.call(ctx, func(ctx context.Context) (*Response, error) {
+			return u.Enable(ctx, accountID)
+		})
+
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		if err != nil {
+			result.Failed = append(result.Failed, &UserLifecycleFailedAccountScheme{AccountID: accountID, Error: err.Error()})
+			continue
+		}
+
+		result.Succeeded = append(result.Succeeded, accountID)
+	}
+
+	return result, nil
+}
+
+// groupMembersEmails returns the emails of the groups members mapped to the group display name
+func (u *UserService) groupMembersEmails(ctx context.Context, directoryID string, groups []string) (emails map[string]string, err error) {
+
+	emails = make(map[string]string)
+
+	for _, displayName := range groups {
+
+		page, _, err := u.client.SCIM.Group.Gets(ctx, directoryID, &SCIMGroupGetsOptionsScheme{Filter: SCIMEq("displayName", displayName).String()}, 1, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Resources) == 0 {
+			return nil, fmt.Errorf("error!, the group %v doesn't exist on the directory %v", displayName, directoryID)
+		}
+
+		group, _, err := u.client.SCIM.Group.Get(ctx, directoryID, page.Resources[0].ID, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range group.Members {
+
+			user, _, err := u.client.SCIM.User.Get(ctx, directoryID, member.Value, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			emails[strings.ToLower(user.UserName)] = displayName
+			for _, email := range user.Emails {
+				emails[strings.ToLower(email.Value)] = displayName
+			}
+		}
+	}
+
+	return emails, nil
+}
+
+func isStale(inactive, threshold int, includeNeverActive bool) bool {
+
+	if inactive < 0 {
+		return includeNeverActive
+	}
+
+	return inactive >= threshold
+}
+
+// inactiveDays returns the days since the last activity or -1 when the value is empty, the error is returned
+// when the value isn't a RFC 3339 timestamp or a date.
+func inactiveDays(lastActive string, now time.Time) (int, error) {
+
+	if len(lastActive) == 0 {
+		return -1, nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+
+		if lastActiveAsTime, err := time.Parse(layout, lastActive); err == nil {
+			return int(now.Sub(lastActiveAsTime).Hours() / 24), nil
+		}
+	}
+
+	return 0, fmt.Errorf("error!, the last activity date %v can't be parsed", lastActive)
+}
+
+func lowerSet(values []string) map[string]bool {
+
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[strings.ToLower(value)] = true
+	}
+
+	return set
+}
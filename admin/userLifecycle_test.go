@@ -0,0 +1,242 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeUserLifecycleServer serves the organization users in two pages, the SCIM group of the
+// allow-listed accounts and the lifecycle endpoints, the first disable request is rate limited.
+type fakeUserLifecycleServer struct {
+	mu          sync.Mutex
+	calls       []string
+	rateLimited bool
+}
+
+func (f *fakeUserLifecycleServer) handler(t *testing.T) http.Handler {
+
+	var (
+		mux   = http.NewServeMux()
+		pages = map[string]string{
+			"": `{"data": [
+				{"account_id": "account-stale", "account_status": "active", "email": "stale@go-atlassian.io", "access_billable": true,
+				 "last_active": "2021-01-01", "product_access": [{"key": "jira-software", "last_active": "2021-01-01"}]},
+				{"account_id": "account-recent", "account_status": "active", "email": "recent@go-atlassian.io", "access_billable": true,
+				 "last_active": "2021-06-20", "product_access": [{"key": "jira-software", "last_active": "2021-01-01"}, {"key": "confluence", "last_active": "2021-06-20"}]},
+				{"account_id": "account-disabled", "account_status": "inactive", "email": "disabled@go-atlassian.io", "last_active": "2020-01-01"}
+			], "links": {"next": "/admin/v1/orgs/organization-id/users?cursor=page-2"}}`,
+			"page-2": `{"data": [
+				{"account_id": "account-product", "account_status": "active", "email": "product@go-atlassian.io", "access_billable": true,
+				 "last_active": "2021-06-01T10:00:00Z", "product_access": [{"key": "jira-servicedesk", "last_active": "2021-06-01T10:00:00Z"}]},
+				{"account_id": "account-invited", "account_status": "active", "email": "invited@go-atlassian.io", "access_billable": true},
+				{"account_id": "account-allowed", "account_status": "active", "email": "service@go-atlassian.io", "access_billable": true, "last_active": "2020-01-01"},
+				{"account_id": "account-group", "account_status": "active", "email": "Admin@go-atlassian.io", "access_billable": true, "last_active": "2020-01-01"},
+				{"account_id": "account-free", "account_status": "active", "email": "free@go-atlassian.io", "access_billable": false, "last_active": "2020-01-01"},
+				{"account_id": "account-unparsed", "account_status": "active", "email": "unparsed@go-atlassian.io", "access_billable": true, "last_active": "01/02/2020"}
+			], "links": {}}`,
+		}
+	)
+
+	mux.HandleFunc("/admin/v1/orgs/organization-id/users", func(w http.ResponseWriter, r *http.Request) {
+
+		page, ok := pages[r.URL.Query().Get("cursor")]
+		if !ok {
+			t.Errorf("unexpected cursor %v", r.URL.RawQuery)
+		}
+
+		_, _ = w.Write([]byte(page))
+	})
+
+	mux.HandleFunc("/scim/directory/directory-id/Groups", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `displayName eq "site-admins"`, r.URL.Query().Get("filter"))
+		_, _ = w.Write([]byte(`{"totalResults": 1, "Resources": [{"id": "group-id", "displayName": "site-admins"}]}`))
+	})
+
+	mux.HandleFunc("/scim/directory/directory-id/Groups/group-id", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "group-id", "displayName": "site-admins", "members": [{"value": "scim-user-id"}]}`))
+	})
+
+	mux.HandleFunc("/scim/directory/directory-id/Users/scim-user-id", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "scim-user-id", "userName": "admin@go-atlassian.io", "emails": [{"value": "admin@go-atlassian.io"}]}`))
+	})
+
+	mux.HandleFunc("/users/", func(w http.ResponseWriter, r *http.Request) {
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if strings.HasSuffix(r.URL.Path, "/disable") && !f.rateLimited {
+			f.rateLimited = true
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		if strings.Contains(r.URL.Path, "account-failed") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		var payload struct {
+			Message string `json:"message"`
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+
+		f.calls = append(f.calls, strings.TrimSpace(fmt.Sprintf("%v %v", r.URL.Path, payload.Message)))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	return mux
+}
+
+func candidateAccountIDs(candidates []*UserLifecycleCandidateScheme) (ids []string) {
+
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.AccountID)
+	}
+
+	return
+}
+
+func TestUserService_Stale(t *testing.T) {
+
+	var now = time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name         string
+		opts         *UserStaleOptionsScheme
+		wantAccounts []string
+		wantExcluded []string
+		wantErr      bool
+	}{
+		{
+			name: "StaleWhenTheAllowListsAreSet",
+			opts: &UserStaleOptionsScheme{
+				InactiveDays:        90,
+				ProductInactiveDays: map[string]int{"jira-servicedesk": 14},
+				BillableOnly:        true,
+				ExcludedAccountIDs:  []string{"ACCOUNT-ALLOWED"},
+				ExcludedGroups:      []string{"site-admins"},
+				DirectoryID:         "directory-id",
+				Now:                 now,
+			},
+			wantAccounts: []string{"account-stale", "account-product"},
+			wantExcluded: []string{"account-allowed", "account-group", "account-unparsed"},
+		},
+		{
+			name: "StaleWhenTheNeverActiveAccountsAreIncluded",
+			opts: &UserStaleOptionsScheme{
+				InactiveDays:       90,
+				IncludeNeverActive: true,
+				ExcludedEmails:     []string{"service@go-atlassian.io", "admin@go-atlassian.io"},
+				Now:                now,
+			},
+			wantAccounts: []string{"account-free", "account-stale", "account-invited"},
+			wantExcluded: []string{"account-allowed", "account-group", "account-unparsed"},
+		},
+		{
+			name:    "StaleWhenTheInactiveDaysAreNotProvided",
+			opts:    &UserStaleOptionsScheme{Now: now},
+			wantErr: true,
+		},
+		{
+			name:    "StaleWhenTheDirectoryIsNotProvided",
+			opts:    &UserStaleOptionsScheme{InactiveDays: 90, ExcludedGroups: []string{"site-admins"}},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockServer := httptest.NewServer((&fakeUserLifecycleServer{}).handler(t))
+			defer mockServer.Close()
+
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotPlan, err := mockClient.User.Stale(context.Background(), "organization-id", testCase.opts)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantAccounts, candidateAccountIDs(gotPlan.Accounts))
+			assert.Equal(t, testCase.wantExcluded, candidateAccountIDs(gotPlan.Excluded))
+		})
+	}
+}
+
+func TestUserService_DisableBulk(t *testing.T) {
+
+	fakeServer := &fakeUserLifecycleServer{}
+
+	mockServer := httptest.NewServer(fakeServer.handler(t))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan := &UserLifecyclePlanScheme{
+		OrganizationID: "organization-id",
+		Accounts: []*UserLifecycleCandidateScheme{
+			{AccountID: "account-stale"},
+			{AccountID: "account-failed"},
+			{AccountID: "account-product"},
+		},
+	}
+
+	var (
+		undoLog = new(bytes.Buffer)
+		opts    = &UserLifecycleOptionsScheme{Message: "Inactive for 90 days", MaxRetries: 1, UndoLog: undoLog}
+	)
+
+	result, err := mockClient.User.DisableBulk(context.Background(), plan, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"account-stale", "account-product"}, result.Succeeded)
+
+	if assert.Len(t, result.Failed, 1) {
+		assert.Equal(t, "account-failed", result.Failed[0].AccountID)
+	}
+
+	// The undo log re-enables exactly the disabled accounts
+	result, err = mockClient.User.Revert(context.Background(), bytes.NewReader(undoLog.Bytes()), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"account-stale", "account-product"}, result.Succeeded)
+
+	assert.Equal(t, []string{
+		"/users/account-stale/manage/lifecycle/disable Inactive for 90 days",
+		"/users/account-product/manage/lifecycle/disable Inactive for 90 days",
+		"/users/account-stale/manage/lifecycle/enable",
+		"/users/account-product/manage/lifecycle/enable",
+	}, fakeServer.calls)
+
+	_, err = mockClient.User.DisableBulk(context.Background(), nil, opts)
+	assert.Error(t, err)
+
+	_, err = mockClient.User.Revert(context.Background(), strings.NewReader("{invalid"), nil)
+	assert.Error(t, err)
+}
@@ -23,7 +23,7 @@ func (u *UserTokenService) Gets(ctx context.Context, accountID string) (result *
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/api-tokens", accountID)
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "users/{accountID}/manage/api-tokens", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -67,7 +67,7 @@ func (u *UserTokenService) Delete(ctx context.Context, accountID, tokenID string
 
 	var endpoint = fmt.Sprintf("/users/%v/manage/api-tokens/%v", accountID, tokenID)
 
-	request, err := u.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodDelete, "users/{accountID}/manage/api-tokens/{tokenID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,293 @@
+package admin
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	UserTokenNeverUsedReason = "never-used"
+	UserTokenRotationReason  = "rotation-window"
+	UserTokenUnusedReason    = "unused"
+)
+
+type UserTokenReportOptionsScheme struct {
+	RotationDays  int // Flags the tokens created more days ago than the rotation window, 0 disables the check
+	UnusedDays    int // Flags the tokens not used in the last days, 0 disables the check
+	NeverUsedDays int // Flags the tokens never used and created more days ago, 0 flags every token never used
+
+	Interval   time.Duration // The minimum time between requests
+	MaxRetries int           // The retries of the requests rejected by the rate limit
+
+	Now time.Time // The reference time to calculate the token age, the default value is the current time
+}
+
+// UserTokenReportScheme contains the API tokens flagged by the hygiene checks, the revoke decisions
+// are stored on every finding, so the report can be exported as the audit trail of the run. The accounts
+// whose tokens can't be read are reported as a finding without token and with the error.
+type UserTokenReportScheme struct {
+	OrganizationID string                    `json:"organizationId"`
+	GeneratedAt    time.Time                 `json:"generatedAt"`
+	Accounts       int                       `json:"accounts"`
+	Tokens         int                       `json:"tokens"`
+	Findings       []*UserTokenFindingScheme `json:"findings,omitempty"`
+}
+
+type UserTokenFindingScheme struct {
+	AccountID  string    `json:"accountId"`
+	Name       string    `json:"name,omitempty"`
+	Email      string    `json:"email,omitempty"`
+	TokenID    string    `json:"tokenId"`
+	Label      string    `json:"label,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastAccess time.Time `json:"lastAccess"`
+	Reasons    []string  `json:"reasons"`
+
+	Approved  bool       `json:"approved"`
+	Revoked   bool       `json:"revoked"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// Report reads the API tokens of every managed account and flags the tokens never used, older than the rotation window
+// or unused for the configured days, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. organizationID = ID of the organization (REQUIRED)
+// 3. opts = the hygiene checks and rate limit options (REQUIRED)
+// The accounts that fail don't stop the report, their error is stored on a finding without token.
+// Library Docs: N/A
+func (u *UserTokenService) Report(ctx context.Context, organizationID string, opts *UserTokenReportOptionsScheme) (report *UserTokenReportScheme, err error) {
+
+	if len(organizationID) == 0 {
+		return nil, fmt.Errorf("error!, please provide a valid organizationID value")
+	}
+
+	if opts == nil {
+		return nil, fmt.Errorf("error!, please provide a valid UserTokenReportOptionsScheme pointer")
+	}
+
+	if opts.RotationDays < 0 || opts.UnusedDays < 0 || opts.NeverUsedDays < 0 {
+		return nil, fmt.Errorf("error!, the RotationDays, UnusedDays and NeverUsedDays values can't be negative")
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var (
+		throttle = &requestThrottle{interval: opts.Interval, maxRetries: opts.MaxRetries, logger: u.client.logger}
+		days     = func(since time.Time) int { return int(now.Sub(since).Hours() / 24) }
+	)
+
+	report = &UserTokenReportScheme{OrganizationID: organizationID, GeneratedAt: now}
+
+	for cursor := ""; ; {
+
+		var page *OrganizationUserPageScheme
+		err = throttle.call(ctx, func(ctx context.Context) (response *Response, err error) {
+			page, response, err = u.client.Organization.Users(ctx, organizationID, cursor)
+			return
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range page.Data {
+
+			var tokens *UserTokensScheme
+			err = throttle.call(ctx, func(ctx context.Context) (response *Response, err error) {
+				tokens, response, err = u.Gets(ctx, user.AccountID)
+				return
+			})
+
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			// The account is reported with the error, the rest of the accounts are still checked
+			if err != nil {
+
+				report.Findings = append(report.Findings, &UserTokenFindingScheme{
+					AccountID: user.AccountID,
+					Name:      user.Name,
+					Email:     user.Email,
+					Error:     fmt.Sprintf("error!, the API tokens of the account %v can't be read: %v", user.AccountID, err),
+				})
+
+				continue
+			}
+
+			report.Accounts++
+
+			for _, token := range *tokens {
+
+				report.Tokens++
+
+				var reasons []string
+				if token.LastAccess.IsZero() && days(token.CreatedAt) >= opts.NeverUsedDays {
+					reasons = append(reasons, UserTokenNeverUsedReason)
+				}
+
+				if opts.RotationDays > 0 && days(token.CreatedAt) >= opts.RotationDays {
+					reasons = append(reasons, UserTokenRotationReason)
+				}
+
+				if opts.UnusedDays > 0 && !token.LastAccess.IsZero() && days(token.LastAccess) >= opts.UnusedDays {
+					reasons = append(reasons, UserTokenUnusedReason)
+				}
+
+				if len(reasons) == 0 {
+					continue
+				}
+
+				report.Findings = append(report.Findings, &UserTokenFindingScheme{
+					AccountID:  user.AccountID,
+					Name:       user.Name,
+					Email:      user.Email,
+					TokenID:    token.ID,
+					Label:      token.Label,
+					CreatedAt:  token.CreatedAt,
+					LastAccess: token.LastAccess,
+					Reasons:    reasons,
+				})
+			}
+		}
+
+		cursor, err = nextCursor(page.Links.Next)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cursor) == 0 || len(page.Data) == 0 {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// Revoke deletes the flagged API tokens approved by the approve func, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. report = the report returned by the Report func (REQUIRED)
+// 3. approve = func called with every finding, only the approved tokens are deleted (REQUIRED)
+// 4. opts = the rate limit options
+// The decisions and the errors are stored on the report findings, the failed tokens don't stop the run.
+// Library Docs: N/A
+func (u *UserTokenService) Revoke(ctx context.Context, report *UserTokenReportScheme, approve func(finding *UserTokenFindingScheme) bool, opts *UserTokenReportOptionsScheme) (err error) {
+
+	if report == nil {
+		return fmt.Errorf("error!, please provide a valid UserTokenReportScheme pointer")
+	}
+
+	if approve == nil {
+		return fmt.Errorf("error!, please provide a valid approve func")
+	}
+
+	if opts == nil {
+		opts = &UserTokenReportOptionsScheme{}
+	}
+
+	throttle := &requestThrottle{interval: opts.Interval, maxRetries: opts.MaxRetries, logger: u.client.logger}
+
+	for _, finding := range report.Findings {
+
+		// The findings without token are the accounts whose tokens couldn't be read
+		if finding.Revoked || len(finding.TokenID) == 0 {
+			continue
+		}
+
+		finding.Approved = approve(finding)
+		if !finding.Approved {
+			continue
+		}
+
+		err = throttle.call(ctx, func(ctx context.Context) (*Response, error) {
+			return u.Delete(ctx, finding.AccountID, finding.TokenID)
+		})
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil {
+			finding.Error = err.Error()
+			continue
+		}
+
+		revokedAt := time.Now().UTC()
+		finding.Revoked, finding.RevokedAt, finding.Error = true, &revokedAt, ""
+	}
+
+	return nil
+}
+
+// WriteJSON writes the report as an indented JSON document.
+func (r *UserTokenReportScheme) WriteJSON(writer io.Writer) error {
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes a row per finding with the revoke decision, the first row is the header.
+func (r *UserTokenReportScheme) WriteCSV(writer io.Writer) error {
+
+	formatTime := func(value time.Time) string {
+
+		if value.IsZero() {
+			return ""
+		}
+
+		return value.UTC().Format(time.RFC3339)
+	}
+
+	formatOptionalTime := func(value *time.Time) string {
+
+		if value == nil {
+			return ""
+		}
+
+		return formatTime(*value)
+	}
+
+	csvWriter := csv.NewWriter(writer)
+
+	header := []string{"account_id", "name", "email", "token_id", "label", "created_at", "last_access", "reasons", "approved", "revoked", "revoked_at", "error"}
+	if err := csvWriter.Write(header); err != nil {
+		return err
+	}
+
+	for _, finding := range r.Findings {
+
+		row := []string{
+			finding.AccountID,
+			finding.Name,
+			finding.Email,
+			finding.TokenID,
+			finding.Label,
+			formatTime(finding.CreatedAt),
+			formatTime(finding.LastAccess),
+			strings.Join(finding.Reasons, ";"),
+			strconv.FormatBool(finding.Approved),
+			strconv.FormatBool(finding.Revoked),
+			formatOptionalTime(finding.RevokedAt),
+			finding.Error,
+		}
+
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
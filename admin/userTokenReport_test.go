@@ -0,0 +1,166 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func startUserTokenReportServer(t *testing.T, deleted *[]string) *httptest.Server {
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/admin/v1/orgs/organization-id/users", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Query().Get("cursor") == "page-2" {
+			_, _ = w.Write([]byte(`{"data": [{"account_id": "account-2", "name": "Charlie", "email": "charlie@go-atlassian.io"},
+				{"account_id": "account-3", "name": "Denise", "email": "denise@go-atlassian.io"}], "links": {}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data": [{"account_id": "account-1", "name": "Carlos", "email": "carlos@go-atlassian.io"}],
+			"links": {"next": "/admin/v1/orgs/organization-id/users?cursor=page-2"}}`))
+	})
+
+	mux.HandleFunc("/users/account-1/manage/api-tokens", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"id": "token-never-used", "label": "ci", "createdAt": "2021-05-01T07:22:07.894Z"},
+			{"id": "token-recent", "label": "recent", "createdAt": "2021-06-20T07:22:07.894Z"},
+			{"id": "token-active", "label": "active", "createdAt": "2021-03-01T07:22:07.894Z", "lastAccess": "2021-06-30T02:56:27.872Z"}
+		]`))
+	})
+
+	mux.HandleFunc("/users/account-2/manage/api-tokens", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": "token-old", "label": "old", "createdAt": "2020-08-01T07:22:07.894Z", "lastAccess": "2021-01-07T02:56:27.872Z"}]`))
+	})
+
+	mux.HandleFunc("/users/account-3/manage/api-tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	mux.HandleFunc("/users/account-2/manage/api-tokens/token-old", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		*deleted = append(*deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/users/account-1/manage/api-tokens/token-never-used", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestUserTokenService_Report(t *testing.T) {
+
+	var deleted []string
+
+	mockServer := startUserTokenReportServer(t, &deleted)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &UserTokenReportOptionsScheme{
+		RotationDays:  180,
+		UnusedDays:    90,
+		NeverUsedDays: 14,
+		Now:           time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	report, err := mockClient.User.Token.Report(context.Background(), "organization-id", opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, 2, report.Accounts)
+	assert.Equal(t, 4, report.Tokens)
+
+	if !assert.Len(t, report.Findings, 3) {
+		return
+	}
+
+	assert.Equal(t, "token-never-used", report.Findings[0].TokenID)
+	assert.Equal(t, []string{UserTokenNeverUsedReason}, report.Findings[0].Reasons)
+
+	assert.Equal(t, "token-old", report.Findings[1].TokenID)
+	assert.Equal(t, "charlie@go-atlassian.io", report.Findings[1].Email)
+	assert.Equal(t, []string{UserTokenRotationReason, UserTokenUnusedReason}, report.Findings[1].Reasons)
+
+	// The account whose tokens can't be read doesn't stop the report
+	assert.Equal(t, "account-3", report.Findings[2].AccountID)
+	assert.Empty(t, report.Findings[2].TokenID)
+	assert.Contains(t, report.Findings[2].Error, "account-3")
+
+	// Every finding is approved, the failed deletion is recorded on the audit trail
+	err = mockClient.User.Token.Revoke(context.Background(), report, func(finding *UserTokenFindingScheme) bool { return true }, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/users/account-2/manage/api-tokens/token-old"}, deleted)
+
+	assert.False(t, report.Findings[0].Revoked)
+	assert.NotEmpty(t, report.Findings[0].Error)
+	assert.Nil(t, report.Findings[0].RevokedAt)
+	assert.True(t, report.Findings[1].Revoked)
+	assert.NotNil(t, report.Findings[1].RevokedAt)
+	assert.False(t, report.Findings[2].Approved)
+
+	output := new(bytes.Buffer)
+	assert.NoError(t, report.WriteCSV(output))
+
+	rows, err := csv.NewReader(output).ReadAll()
+	assert.NoError(t, err)
+
+	if assert.Len(t, rows, 4) {
+		assert.Equal(t, "token_id", rows[0][3])
+		assert.Equal(t, []string{"account-2", "Charlie", "charlie@go-atlassian.io", "token-old", "old", "2020-08-01T07:22:07Z",
+			"2021-01-07T02:56:27Z", "rotation-window;unused", "true", "true"}, rows[2][:10])
+	}
+
+	output.Reset()
+	assert.NoError(t, report.WriteJSON(output))
+
+	decoded := new(UserTokenReportScheme)
+	assert.NoError(t, json.Unmarshal(output.Bytes(), decoded))
+	assert.Equal(t, report.Findings[1].TokenID, decoded.Findings[1].TokenID)
+	assert.True(t, decoded.Findings[1].Revoked)
+
+	// The revoked time is only written for the revoked tokens
+	var findings struct {
+		Findings []map[string]interface{} `json:"findings"`
+	}
+
+	assert.NoError(t, json.Unmarshal(output.Bytes(), &findings))
+	assert.NotContains(t, findings.Findings[0], "revokedAt")
+	assert.Contains(t, findings.Findings[1], "revokedAt")
+}
+
+func TestUserTokenService_ReportWhenTheParametersAreNotValid(t *testing.T) {
+
+	mockClient, err := startMockClient("https://api.atlassian.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = mockClient.User.Token.Report(context.Background(), "", &UserTokenReportOptionsScheme{})
+	assert.Error(t, err)
+
+	_, err = mockClient.User.Token.Report(context.Background(), "organization-id", nil)
+	assert.Error(t, err)
+
+	_, err = mockClient.User.Token.Report(context.Background(), "organization-id", &UserTokenReportOptionsScheme{UnusedDays: -1})
+	assert.Error(t, err)
+
+	err = mockClient.User.Token.Revoke(context.Background(), &UserTokenReportScheme{}, nil, nil)
+	assert.Error(t, err)
+
+	err = mockClient.User.Token.Revoke(context.Background(), nil, func(*UserTokenFindingScheme) bool { return true }, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package bitbucket
+
+type AuthenticationService struct {
+	client *Client
+
+	basicAuthProvided bool
+	username, token   string
+
+	bearerTokenProvided bool
+	bearerToken         string
+
+	userAgentProvided bool
+	agent             string
+}
+
+// SetBasicAuth sets the username and the app password used to authenticate the requests
+func (a *AuthenticationService) SetBasicAuth(username, appPassword string) {
+
+	a.username = username
+	a.token = appPassword
+
+	a.basicAuthProvided = true
+}
+
+// SetBearerToken sets the OAuth 2.0 access token used to authenticate the requests, it takes precedence over the app password
+func (a *AuthenticationService) SetBearerToken(token string) {
+
+	a.bearerToken = token
+
+	a.bearerTokenProvided = true
+}
+
+func (a *AuthenticationService) SetUserAgent(agent string) {
+
+	a.agent = agent
+
+	a.userAgentProvided = true
+}
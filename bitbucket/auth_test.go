@@ -0,0 +1,69 @@
+package bitbucket
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestAuthenticationService(t *testing.T) {
+
+	testCases := []struct {
+		name        string
+		username    string
+		appPassword string
+		bearerToken string
+		want        func(t *testing.T, request *http.Request)
+	}{
+		{
+			name:        "AuthenticateWhenTheAppPasswordIsProvided",
+			username:    "ctreminiom",
+			appPassword: "$APP_PASSWORD",
+			want: func(t *testing.T, request *http.Request) {
+
+				username, password, ok := request.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "ctreminiom", username)
+				assert.Equal(t, "$APP_PASSWORD", password)
+			},
+		},
+		{
+			name:        "AuthenticateWhenTheBearerTokenIsProvided",
+			username:    "ctreminiom",
+			appPassword: "$APP_PASSWORD",
+			bearerToken: "$ACCESS_TOKEN",
+			want: func(t *testing.T, request *http.Request) {
+				assert.Equal(t, "Bearer $ACCESS_TOKEN", request.Header.Get("Authorization"))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			mockClient, err := New(nil, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, DefaultSite, mockClient.Site.String())
+
+			mockClient.Auth.SetBasicAuth(testCase.username, testCase.appPassword)
+			mockClient.Auth.SetUserAgent("curl/7.54.0")
+
+			if len(testCase.bearerToken) != 0 {
+				mockClient.Auth.SetBearerToken(testCase.bearerToken)
+			}
+
+			request, err := mockClient.newRequest(context.Background(), http.MethodGet, "2.0/user", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, "https://api.bitbucket.org/2.0/user", request.URL.String())
+			assert.Equal(t, "curl/7.54.0", request.Header.Get("User-Agent"))
+			testCase.want(t, request)
+		})
+	}
+}
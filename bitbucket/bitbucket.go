@@ -0,0 +1,235 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultSite is the Bitbucket Cloud REST API, it's used when the site is not provided
+const DefaultSite = "https://api.bitbucket.org/"
+
+type Client struct {
+	HTTP *http.Client
+	Site *url.URL
+
+	Auth       *AuthenticationService
+	Workspace  *WorkspaceService
+	Repository *RepositoryService
+}
+
+// New returns a Bitbucket Cloud client, the empty site uses the DefaultSite value
+func New(httpClient *http.Client, site string) (client *Client, err error) {
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	if len(site) == 0 {
+		site = DefaultSite
+	}
+
+	if !strings.HasSuffix(site, "/") {
+		site += "/"
+	}
+
+	siteAsURL, err := url.Parse(site)
+	if err != nil {
+		return
+	}
+
+	client = &Client{}
+	client.HTTP = httpClient
+	client.Site = siteAsURL
+
+	client.Auth = &AuthenticationService{client: client}
+	client.Workspace = &WorkspaceService{client: client}
+
+	client.Repository = &RepositoryService{
+		client:  client,
+		Branch:  &BranchService{client: client},
+		Commit:  &CommitService{client: client},
+		Webhook: &WebhookService{client: client},
+		PullRequest: &PullRequestService{
+			client:  client,
+			Comment: &PullRequestCommentService{client: client},
+		},
+		Pipeline: &PipelineService{client: client},
+	}
+
+	return
+}
+
+func (c *Client) newRequest(ctx context.Context, method, urlAsString string, payload interface{}) (request *http.Request, err error) {
+
+	if ctx == nil {
+		return nil, errors.New("the context param is nil, please provide a valid one")
+	}
+
+	relativePath, err := url.Parse(urlAsString)
+	if err != nil {
+		return
+	}
+
+	relativePath.Path = strings.TrimLeft(relativePath.Path, "/")
+	relativePath.RawPath = strings.TrimLeft(relativePath.RawPath, "/")
+
+	endpointPath := c.Site.ResolveReference(relativePath)
+	var payloadBuffer io.ReadWriter
+	if payload != nil {
+		payloadBuffer = new(bytes.Buffer)
+		if err = json.NewEncoder(payloadBuffer).Encode(payload); err != nil {
+			return
+		}
+	}
+
+	request, err = http.NewRequestWithContext(ctx, method, endpointPath.String(), payloadBuffer)
+	if err != nil {
+		return
+	}
+
+	if c.Auth.bearerTokenProvided {
+		request.Header.Set("Authorization", fmt.Sprintf("Bearer %v", c.Auth.bearerToken))
+	} else if c.Auth.basicAuthProvided {
+		request.SetBasicAuth(c.Auth.username, c.Auth.token)
+	}
+
+	if c.Auth.userAgentProvided {
+		request.Header.Set("User-Agent", c.Auth.agent)
+	}
+
+	return
+}
+
+func (c *Client) Do(request *http.Request) (response *Response, err error) {
+
+	httpResponse, err := c.HTTP.Do(request)
+	if err != nil {
+		return
+	}
+
+	response, err = checkResponse(httpResponse, request.URL.String())
+	if err != nil {
+		return
+	}
+
+	response, err = newResponse(httpResponse, request.URL.String())
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+type Response struct {
+	StatusCode  int
+	Endpoint    string
+	Headers     map[string][]string
+	BodyAsBytes []byte
+	Method      string
+}
+
+func newResponse(http *http.Response, endpoint string) (response *Response, err error) {
+
+	var statusCode = http.StatusCode
+
+	var httpResponseAsBytes []byte
+	if http.ContentLength != 0 {
+		httpResponseAsBytes, err = ioutil.ReadAll(http.Body)
+		if err != nil {
+			return
+		}
+	}
+
+	newResponse := Response{
+		StatusCode:  statusCode,
+		Headers:     http.Header,
+		BodyAsBytes: httpResponseAsBytes,
+		Endpoint:    endpoint,
+		Method:      http.Request.Method,
+	}
+
+	return &newResponse, nil
+}
+
+func checkResponse(http *http.Response, endpoint string) (response *Response, err error) {
+
+	var statusCode = http.StatusCode
+	if 200 <= statusCode && statusCode <= 299 {
+		return
+	}
+
+	var httpResponseAsBytes []byte
+	if http.ContentLength != 0 {
+		httpResponseAsBytes, err = ioutil.ReadAll(http.Body)
+		if err != nil {
+			return
+		}
+	}
+
+	newErrorResponse := Response{
+		StatusCode:  statusCode,
+		Headers:     http.Header,
+		BodyAsBytes: httpResponseAsBytes,
+		Endpoint:    endpoint,
+		Method:      http.Request.Method,
+	}
+
+	return &newErrorResponse, fmt.Errorf("request failed. Please analyze the request body for more details. Status Code: %d", statusCode)
+}
+
+// paginationParams adds the page and pagelen query parameters, Bitbucket pages start at 1
+func paginationParams(params url.Values, page, pageLen int) {
+
+	if page > 0 {
+		params.Add("page", fmt.Sprint(page))
+	}
+
+	if pageLen > 0 {
+		params.Add("pagelen", fmt.Sprint(pageLen))
+	}
+}
+
+// withQuery appends the encoded query parameters to the endpoint
+func withQuery(endpoint string, params url.Values) string {
+
+	if len(params) == 0 {
+		return endpoint
+	}
+
+	return fmt.Sprintf("%v?%v", endpoint, params.Encode())
+}
+
+type LinkScheme struct {
+	Href string `json:"href,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type LinksScheme struct {
+	Self     *LinkScheme   `json:"self,omitempty"`
+	HTML     *LinkScheme   `json:"html,omitempty"`
+	Avatar   *LinkScheme   `json:"avatar,omitempty"`
+	Commits  *LinkScheme   `json:"commits,omitempty"`
+	Diff     *LinkScheme   `json:"diff,omitempty"`
+	Comments *LinkScheme   `json:"comments,omitempty"`
+	Approve  *LinkScheme   `json:"approve,omitempty"`
+	Merge    *LinkScheme   `json:"merge,omitempty"`
+	Clone    []*LinkScheme `json:"clone,omitempty"`
+}
+
+// AccountScheme represents a Bitbucket user or team, the account ID is the Atlassian account ID shared with Jira
+type AccountScheme struct {
+	Type        string       `json:"type,omitempty"`
+	UUID        string       `json:"uuid,omitempty"`
+	AccountID   string       `json:"account_id,omitempty"`
+	Nickname    string       `json:"nickname,omitempty"`
+	DisplayName string       `json:"display_name,omitempty"`
+	Links       *LinksScheme `json:"links,omitempty"`
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/bitbucket"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	var (
+		username    = os.Getenv("USERNAME")
+		appPassword = os.Getenv("APP_PASSWORD")
+	)
+
+	instance, err := bitbucket.New(nil, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetBasicAuth(username, appPassword)
+
+	payload := &bitbucket.PipelinePayloadScheme{
+		Target: &bitbucket.PipelineTargetScheme{
+			RefType:  "branch",
+			RefName:  "main",
+			Selector: &bitbucket.PipelineSelectorScheme{Type: "custom", Pattern: "deploy"},
+		},
+		Variables: []*bitbucket.PipelineVariableScheme{
+			{Key: "ENVIRONMENT", Value: "staging"},
+		},
+	}
+
+	pipeline, response, err := instance.Repository.Pipeline.Trigger(context.Background(), "ctreminiom", "go-atlassian", payload)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	for !pipeline.Completed() {
+
+		time.Sleep(10 * time.Second)
+
+		pipeline, _, err = instance.Repository.Pipeline.Get(context.Background(), "ctreminiom", "go-atlassian", pipeline.UUID)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Println(pipeline.BuildNumber, pipeline.State.Name)
+	}
+
+	log.Println("The pipeline finished, successful:", pipeline.Successful())
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/bitbucket"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		username    = os.Getenv("USERNAME")
+		appPassword = os.Getenv("APP_PASSWORD")
+	)
+
+	instance, err := bitbucket.New(nil, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetBasicAuth(username, appPassword)
+	instance.Auth.SetUserAgent("curl/7.54.0")
+
+	payload := &bitbucket.PullRequestPayloadScheme{
+		Title:             "KP-12 Bitbucket module",
+		Description:       "Adds the Bitbucket module",
+		Source:            &bitbucket.PullRequestEndpointScheme{Branch: &bitbucket.BranchScheme{Name: "feature/KP-12-bitbucket"}},
+		Destination:       &bitbucket.PullRequestEndpointScheme{Branch: &bitbucket.BranchScheme{Name: "main"}},
+		CloseSourceBranch: true,
+	}
+
+	pullRequest, response, err := instance.Repository.PullRequest.Create(context.Background(), "ctreminiom", "go-atlassian", payload)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+	log.Println(pullRequest.ID, pullRequest.Title, pullRequest.Links.HTML.Href)
+
+	_, _, err = instance.Repository.PullRequest.Comment.Add(context.Background(), "ctreminiom", "go-atlassian", pullRequest.ID, "Ready for review", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
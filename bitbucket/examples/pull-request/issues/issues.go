@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/bitbucket"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host        = os.Getenv("HOST")
+		mail        = os.Getenv("MAIL")
+		token       = os.Getenv("TOKEN")
+		accessToken = os.Getenv("BITBUCKET_ACCESS_TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+
+	instance, err := bitbucket.New(nil, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The OAuth 2.0 access token of a Bitbucket OAuth consumer
+	instance.Auth.SetBearerToken(accessToken)
+
+	issues, err := instance.Repository.PullRequest.Issues(context.Background(), "ctreminiom", "go-atlassian", 7, atlassian, []string{"summary"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, issue := range issues {
+		log.Println(issue.Key, issue.Fields.Summary)
+	}
+}
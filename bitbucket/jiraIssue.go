@@ -0,0 +1,138 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"regexp"
+	"strings"
+)
+
+// IssueKeyRegexp matches the Jira issue keys, e.g: KP-12, the project keys start with an uppercase letter.
+// The key is the first group, the boundary before the key is matched because \b doesn't split the words
+// joined by an underscore, e.g: feature_KP-12_fix, the issue number takes every digit after the dash.
+var IssueKeyRegexp = regexp.MustCompile(`(?:^|[^A-Za-z0-9])([A-Z][A-Z0-9_]+-[1-9][0-9]*)`)
+
+// issueKeysPerSearch is the number of issue keys resolved by each JQL search
+const issueKeysPerSearch = 50
+
+// IssueKeys returns the unique Jira issue keys mentioned on the texts, in order of appearance
+func IssueKeys(texts ...string) (keys []string) {
+
+	seen := make(map[string]bool)
+
+	for _, text := range texts {
+		for _, match := range IssueKeyRegexp.FindAllStringSubmatch(text, -1) {
+
+			key := match[1]
+
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	return
+}
+
+// IssueKeys returns the Jira issue keys mentioned on the commit message
+func (c *CommitScheme) IssueKeys() []string {
+
+	if c == nil {
+		return nil
+	}
+
+	return IssueKeys(c.Message)
+}
+
+// IssueKeys returns the Jira issue keys mentioned on the title, the description and the source branch of the pull request
+func (p *PullRequestScheme) IssueKeys() []string {
+
+	if p == nil {
+		return nil
+	}
+
+	var branch string
+	if p.Source != nil && p.Source.Branch != nil {
+		branch = p.Source.Branch.Name
+	}
+
+	return IssueKeys(p.Title, p.Description, branch)
+}
+
+// ResolveIssues returns the Jira issues of the keys, in the same order. The keys that don't match an issue
+// or can't be browsed by the user are skipped, e.g: the keys of the commit messages that look like an issue key.
+func ResolveIssues(ctx context.Context, client *jira.Client, keys, fields, expand []string) (issues []*jira.IssueScheme, err error) {
+
+	if client == nil {
+		return nil, fmt.Errorf("error, please provide a valid jira.Client pointer")
+	}
+
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	keys = IssueKeys(strings.Join(keys, " "))
+	issuesByKey := make(map[string]*jira.IssueScheme, len(keys))
+
+	for start := 0; start < len(keys); start += issueKeysPerSearch {
+
+		end := start + issueKeysPerSearch
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		// The warn validation ignores the keys of the projects that don't exist
+		jql := fmt.Sprintf("key in (%v)", strings.Join(keys[start:end], ", "))
+
+		page, _, err := client.Issue.Search.Post(ctx, jql, fields, expand, 0, end-start, "warn")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Issues {
+			issuesByKey[issue.Key] = issue
+		}
+	}
+
+	for _, key := range keys {
+		if issue, ok := issuesByKey[key]; ok {
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues, nil
+}
+
+// Issues returns the Jira issues mentioned on the pull request and on its commits.
+// Docs: N/A
+func (p *PullRequestService) Issues(ctx context.Context, workspace, repoSlug string, pullRequestID int, client *jira.Client, fields []string) (issues []*jira.IssueScheme, err error) {
+
+	pullRequest, _, err := p.Get(ctx, workspace, repoSlug, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := pullRequest.IssueKeys()
+
+	for page := 1; ; page++ {
+
+		commits, _, err := p.Commits(ctx, workspace, repoSlug, pullRequestID, page, 50)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, commit := range commits.Values {
+			keys = append(keys, commit.IssueKeys()...)
+		}
+
+		if len(commits.Next) == 0 || len(commits.Values) == 0 {
+			break
+		}
+	}
+
+	return ResolveIssues(ctx, client, keys, fields, nil)
+}
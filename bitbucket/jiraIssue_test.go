@@ -0,0 +1,136 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestIssueKeys(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		texts []string
+		want  []string
+	}{
+		{
+			name:  "IssueKeysWhenTheTextsContainKeys",
+			texts: []string{"KP-12 Add the pull requests", "feature/KP-12-bitbucket", "Fixes ABC_2-7 and KP-13."},
+			want:  []string{"KP-12", "ABC_2-7", "KP-13"},
+		},
+		{
+			name:  "IssueKeysWhenTheBranchJoinsTheKeysWithUnderscores",
+			texts: []string{"KP-12_fix", "bugfix_KP-14_login", "KP-15KP-16 KP-17"},
+			want:  []string{"KP-12", "KP-14", "KP-15", "KP-17"},
+		},
+		{
+			name:  "IssueKeysWhenTheKeysAreNotValid",
+			texts: []string{"kp-12, aKP-12, K-12 and KP-0 are not keys"},
+			want:  nil,
+		},
+		{
+			name:  "IssueKeysWhenTheTextsAreEmpty",
+			texts: nil,
+			want:  nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, IssueKeys(testCase.texts...))
+		})
+	}
+}
+
+func TestPullRequestService_Issues(t *testing.T) {
+
+	var searches []string
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": 7, "title": "KP-12 Bitbucket module", "description": "Adds the module", "source": {"branch": {"name": "feature/KP-12-bitbucket"}}}`))
+	})
+
+	mux.HandleFunc("/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Query().Get("page") == "1" {
+			_, _ = w.Write([]byte(`{"next": "https://api.bitbucket.org/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=2",
+				"values": [{"hash": "9f8e7d6c5b4a", "message": "KP-12 Add the pull requests"}, {"hash": "6a1c2f9e0b3d", "message": "Fix the UTF-8 encoding, NOPE-1"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"values": [{"hash": "1a2b3c4d5e6f", "message": "KP-10 Add the Confluence module"}]}`))
+	})
+
+	mux.HandleFunc("/rest/api/3/search", func(w http.ResponseWriter, r *http.Request) {
+
+		var payload struct {
+			Jql           string `json:"jql"`
+			ValidateQuery string `json:"validateQuery"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		searches = append(searches, payload.Jql)
+		assert.Equal(t, "warn", payload.ValidateQuery)
+
+		// UTF-8 and NOPE-1 don't exist, Jira returns the existing issues in a different order
+		_, _ = w.Write([]byte(`{"total": 2, "issues": [{"key": "KP-10", "fields": {"summary": "Confluence module"}}, {"key": "KP-12", "fields": {"summary": "Bitbucket module"}}],
+			"warningMessages": ["The issue key 'UTF-8' for field 'key' is invalid.", "The issue key 'NOPE-1' for field 'key' is invalid."]}`))
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	bitbucketClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jiraClient, err := jira.New(nil, mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := bitbucketClient.Repository.PullRequest.Issues(context.Background(), "ctreminiom", "go-atlassian", 7, jiraClient, []string{"summary"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if assert.Len(t, issues, 2) {
+		assert.Equal(t, "KP-12", issues[0].Key)
+		assert.Equal(t, "KP-10", issues[1].Key)
+	}
+
+	assert.Equal(t, []string{"key in (KP-12, UTF-8, NOPE-1, KP-10)"}, searches)
+
+	_, err = bitbucketClient.Repository.PullRequest.Issues(context.Background(), "ctreminiom", "go-atlassian", 8, jiraClient, nil)
+	assert.Error(t, err)
+
+	_, err = ResolveIssues(context.Background(), nil, []string{"KP-12"}, nil, nil)
+	assert.Error(t, err)
+
+	issues, err = ResolveIssues(context.Background(), jiraClient, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, issues)
+
+	// The keys are resolved in batches
+	var keys []string
+	for i := 1; i <= issueKeysPerSearch+1; i++ {
+		keys = append(keys, "KP-"+strings.Repeat("1", i))
+	}
+
+	searches = nil
+	_, err = ResolveIssues(context.Background(), jiraClient, keys, nil, nil)
+	assert.NoError(t, err)
+	assert.Len(t, searches, 2)
+}
@@ -0,0 +1,227 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type PipelineService struct{ client *Client }
+
+const (
+	PipelinePendingState    = "PENDING"
+	PipelineInProgressState = "IN_PROGRESS"
+	PipelineCompletedState  = "COMPLETED"
+
+	PipelineSuccessfulResult = "SUCCESSFUL"
+	PipelineFailedResult     = "FAILED"
+	PipelineErrorResult      = "ERROR"
+	PipelineStoppedResult    = "STOPPED"
+)
+
+type PipelinePageScheme struct {
+	Size     int               `json:"size,omitempty"`
+	Page     int               `json:"page,omitempty"`
+	PageLen  int               `json:"pagelen,omitempty"`
+	Next     string            `json:"next,omitempty"`
+	Previous string            `json:"previous,omitempty"`
+	Values   []*PipelineScheme `json:"values,omitempty"`
+}
+
+type PipelineScheme struct {
+	Type              string                `json:"type,omitempty"`
+	UUID              string                `json:"uuid,omitempty"`
+	BuildNumber       int                   `json:"build_number,omitempty"`
+	Creator           *AccountScheme        `json:"creator,omitempty"`
+	Repository        *RepositoryScheme     `json:"repository,omitempty"`
+	Target            *PipelineTargetScheme `json:"target,omitempty"`
+	Trigger           *PipelineTypeScheme   `json:"trigger,omitempty"`
+	State             *PipelineStateScheme  `json:"state,omitempty"`
+	CreatedOn         string                `json:"created_on,omitempty"`
+	CompletedOn       string                `json:"completed_on,omitempty"`
+	BuildSecondsUsed  int                   `json:"build_seconds_used,omitempty"`
+	DurationInSeconds int                   `json:"duration_in_seconds,omitempty"`
+	Links             *LinksScheme          `json:"links,omitempty"`
+}
+
+type PipelineTargetScheme struct {
+	Type     string                  `json:"type,omitempty"`
+	RefType  string                  `json:"ref_type,omitempty"`
+	RefName  string                  `json:"ref_name,omitempty"`
+	Commit   *CommitScheme           `json:"commit,omitempty"`
+	Selector *PipelineSelectorScheme `json:"selector,omitempty"`
+}
+
+// PipelineSelectorScheme selects the pipeline of the bitbucket-pipelines.yml file, e.g: the custom pipeline "deploy"
+type PipelineSelectorScheme struct {
+	Type    string `json:"type,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+type PipelineTypeScheme struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type PipelineStateScheme struct {
+	Type   string              `json:"type,omitempty"`
+	Name   string              `json:"name,omitempty"`
+	Result *PipelineTypeScheme `json:"result,omitempty"`
+	Stage  *PipelineTypeScheme `json:"stage,omitempty"`
+}
+
+type PipelineVariableScheme struct {
+	Key     string `json:"key,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Secured bool   `json:"secured,omitempty"`
+}
+
+type PipelinePayloadScheme struct {
+	Target    *PipelineTargetScheme     `json:"target,omitempty"`
+	Variables []*PipelineVariableScheme `json:"variables,omitempty"`
+}
+
+// Completed returns true when the pipeline finished, the result contains the outcome, e.g: SUCCESSFUL
+func (p *PipelineScheme) Completed() bool {
+	return p != nil && p.State != nil && p.State.Name == PipelineCompletedState
+}
+
+// Successful returns true when the pipeline finished successfully
+func (p *PipelineScheme) Successful() bool {
+	return p.Completed() && p.State.Result != nil && p.State.Result.Name == PipelineSuccessfulResult
+}
+
+// Triggers a pipeline, the target selects the branch, the commit and the pipeline of the bitbucket-pipelines.yml file.
+// Docs: N/A
+func (p *PipelineService) Trigger(ctx context.Context, workspace, repoSlug string, payload *PipelinePayloadScheme) (result *PipelineScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "pipelines/")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if payload == nil || payload.Target == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid PipelinePayloadScheme pointer with the target")
+	}
+
+	if len(payload.Target.Type) == 0 {
+		payload.Target.Type = "pipeline_ref_target"
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PipelineScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns the pipelines of a repository, the sort value orders the pipelines, e.g: -created_on.
+// Docs: N/A
+func (p *PipelineService) Gets(ctx context.Context, workspace, repoSlug, sort string, page, pageLen int) (result *PipelinePageScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "pipelines/")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	if len(sort) != 0 {
+		params.Add("sort", sort)
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodGet, withQuery(endpoint, params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PipelinePageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a pipeline, the state contains the status of the pipeline.
+// Docs: N/A
+func (p *PipelineService) Get(ctx context.Context, workspace, repoSlug, pipelineUUID string) (result *PipelineScheme, response *Response, err error) {
+
+	if len(pipelineUUID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid pipelineUUID value")
+	}
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, fmt.Sprintf("pipelines/%v", url.PathEscape(pipelineUUID)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PipelineScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Stops a running pipeline.
+// Docs: N/A
+func (p *PipelineService) Stop(ctx context.Context, workspace, repoSlug, pipelineUUID string) (response *Response, err error) {
+
+	if len(pipelineUUID) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid pipelineUUID value")
+	}
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, fmt.Sprintf("pipelines/%v/stopPipeline", url.PathEscape(pipelineUUID)))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,795 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestPipelineService_Trigger(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		payload             *PipelinePayloadScheme
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:      "TriggerWhenTheParametersAreCorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PipelinePayloadScheme{
+				Target: &PipelineTargetScheme{
+					RefType:  "branch",
+					RefName:  "main",
+					Selector: &PipelineSelectorScheme{Type: "custom", Pattern: "deploy"},
+				},
+				Variables: []*PipelineVariableScheme{{Key: "ENVIRONMENT", Value: "staging"}},
+			},
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:      "TriggerWhenTheWorkspaceIsNotProvided",
+			workspace: "",
+			repoSlug:  "go-atlassian",
+			payload: &PipelinePayloadScheme{
+				Target: &PipelineTargetScheme{
+					RefType:  "branch",
+					RefName:  "main",
+					Selector: &PipelineSelectorScheme{Type: "custom", Pattern: "deploy"},
+				},
+				Variables: []*PipelineVariableScheme{{Key: "ENVIRONMENT", Value: "staging"}},
+			},
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:      "TriggerWhenTheRepoSlugIsNotProvided",
+			workspace: "ctreminiom",
+			repoSlug:  "",
+			payload: &PipelinePayloadScheme{
+				Target: &PipelineTargetScheme{
+					RefType:  "branch",
+					RefName:  "main",
+					Selector: &PipelineSelectorScheme{Type: "custom", Pattern: "deploy"},
+				},
+				Variables: []*PipelineVariableScheme{{Key: "ENVIRONMENT", Value: "staging"}},
+			},
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "TriggerWhenThePayloadIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			payload:            nil,
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "TriggerWhenTheTargetIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			payload:            &PipelinePayloadScheme{},
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:      "TriggerWhenTheRequestMethodIsIncorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PipelinePayloadScheme{
+				Target: &PipelineTargetScheme{
+					RefType:  "branch",
+					RefName:  "main",
+					Selector: &PipelineSelectorScheme{Type: "custom", Pattern: "deploy"},
+				},
+				Variables: []*PipelineVariableScheme{{Key: "ENVIRONMENT", Value: "staging"}},
+			},
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:      "TriggerWhenTheStatusCodeIsIncorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PipelinePayloadScheme{
+				Target: &PipelineTargetScheme{
+					RefType:  "branch",
+					RefName:  "main",
+					Selector: &PipelineSelectorScheme{Type: "custom", Pattern: "deploy"},
+				},
+				Variables: []*PipelineVariableScheme{{Key: "ENVIRONMENT", Value: "staging"}},
+			},
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:      "TriggerWhenTheContextIsNil",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PipelinePayloadScheme{
+				Target: &PipelineTargetScheme{
+					RefType:  "branch",
+					RefName:  "main",
+					Selector: &PipelineSelectorScheme{Type: "custom", Pattern: "deploy"},
+				},
+				Variables: []*PipelineVariableScheme{{Key: "ENVIRONMENT", Value: "staging"}},
+			},
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:      "TriggerWhenTheResponseBodyHasADifferentFormat",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PipelinePayloadScheme{
+				Target: &PipelineTargetScheme{
+					RefType:  "branch",
+					RefName:  "main",
+					Selector: &PipelineSelectorScheme{Type: "custom", Pattern: "deploy"},
+				},
+				Variables: []*PipelineVariableScheme{{Key: "ENVIRONMENT", Value: "staging"}},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PipelineService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Trigger(testCase.context, testCase.workspace, testCase.repoSlug, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.False(t, gotResult.Completed())
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPipelineService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                      string
+		workspace, repoSlug, sort string
+		page, pageLen             int
+		mockFile                  string
+		wantHTTPMethod            string
+		endpoint                  string
+		context                   context.Context
+		wantHTTPCodeReturn        int
+		wantErr                   bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			sort:               "-created_on",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pipelines.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/?page=1&pagelen=10&sort=-created_on",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			sort:               "-created_on",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pipelines.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/?page=1&pagelen=10&sort=-created_on",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			sort:               "-created_on",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pipelines.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/?page=1&pagelen=10&sort=-created_on",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			sort:               "-created_on",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pipelines.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/?page=1&pagelen=10&sort=-created_on",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			sort:               "-created_on",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pipelines.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/?page=1&pagelen=10&sort=-created_on",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			sort:               "-created_on",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pipelines.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/?page=1&pagelen=10&sort=-created_on",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			sort:               "-created_on",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/?page=1&pagelen=10&sort=-created_on",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PipelineService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.workspace, testCase.repoSlug, testCase.sort, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, pipeline := range gotResult.Values {
+					t.Log(pipeline.BuildNumber, pipeline.State.Name, pipeline.Successful())
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPipelineService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                              string
+		workspace, repoSlug, pipelineUUID string
+		mockFile                          string
+		wantHTTPMethod                    string
+		endpoint                          string
+		context                           context.Context
+		wantHTTPCodeReturn                int
+		wantErr                           bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenThePipelineUUIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "",
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "./mocks/get-pipeline.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PipelineService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pipelineUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, PipelineInProgressState, gotResult.State.Name)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPipelineService_Stop(t *testing.T) {
+
+	testCases := []struct {
+		name                              string
+		workspace, repoSlug, pipelineUUID string
+		mockFile                          string
+		wantHTTPMethod                    string
+		endpoint                          string
+		context                           context.Context
+		wantHTTPCodeReturn                int
+		wantErr                           bool
+	}{
+		{
+			name:               "StopWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}/stopPipeline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "StopWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}/stopPipeline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "StopWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}/stopPipeline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "StopWhenThePipelineUUIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}/stopPipeline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "StopWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}/stopPipeline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "StopWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}/stopPipeline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "StopWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pipelineUUID:       "{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pipelines/{a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d}/stopPipeline",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PipelineService{client: mockClient}
+
+			gotResponse, err := service.Stop(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pipelineUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
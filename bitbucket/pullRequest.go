@@ -0,0 +1,333 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type PullRequestService struct {
+	client  *Client
+	Comment *PullRequestCommentService
+}
+
+const (
+	PullRequestOpenState       = "OPEN"
+	PullRequestMergedState     = "MERGED"
+	PullRequestDeclinedState   = "DECLINED"
+	PullRequestSupersededState = "SUPERSEDED"
+
+	MergeCommitStrategy = "merge_commit"
+	SquashStrategy      = "squash"
+	FastForwardStrategy = "fast_forward"
+)
+
+type PullRequestPageScheme struct {
+	Size     int                  `json:"size,omitempty"`
+	Page     int                  `json:"page,omitempty"`
+	PageLen  int                  `json:"pagelen,omitempty"`
+	Next     string               `json:"next,omitempty"`
+	Previous string               `json:"previous,omitempty"`
+	Values   []*PullRequestScheme `json:"values,omitempty"`
+}
+
+type PullRequestScheme struct {
+	Type              string                          `json:"type,omitempty"`
+	ID                int                             `json:"id,omitempty"`
+	Title             string                          `json:"title,omitempty"`
+	Description       string                          `json:"description,omitempty"`
+	State             string                          `json:"state,omitempty"`
+	Author            *AccountScheme                  `json:"author,omitempty"`
+	Source            *PullRequestEndpointScheme      `json:"source,omitempty"`
+	Destination       *PullRequestEndpointScheme      `json:"destination,omitempty"`
+	MergeCommit       *CommitScheme                   `json:"merge_commit,omitempty"`
+	CloseSourceBranch bool                            `json:"close_source_branch,omitempty"`
+	ClosedBy          *AccountScheme                  `json:"closed_by,omitempty"`
+	Reason            string                          `json:"reason,omitempty"`
+	CommentCount      int                             `json:"comment_count,omitempty"`
+	TaskCount         int                             `json:"task_count,omitempty"`
+	Reviewers         []*AccountScheme                `json:"reviewers,omitempty"`
+	Participants      []*PullRequestParticipantScheme `json:"participants,omitempty"`
+	CreatedOn         string                          `json:"created_on,omitempty"`
+	UpdatedOn         string                          `json:"updated_on,omitempty"`
+	Links             *LinksScheme                    `json:"links,omitempty"`
+}
+
+type PullRequestEndpointScheme struct {
+	Branch     *BranchScheme     `json:"branch,omitempty"`
+	Commit     *CommitScheme     `json:"commit,omitempty"`
+	Repository *RepositoryScheme `json:"repository,omitempty"`
+}
+
+type PullRequestParticipantScheme struct {
+	Type           string         `json:"type,omitempty"`
+	User           *AccountScheme `json:"user,omitempty"`
+	Role           string         `json:"role,omitempty"`
+	Approved       bool           `json:"approved,omitempty"`
+	State          string         `json:"state,omitempty"`
+	ParticipatedOn string         `json:"participated_on,omitempty"`
+}
+
+type PullRequestPayloadScheme struct {
+	Title             string                     `json:"title,omitempty"`
+	Description       string                     `json:"description,omitempty"`
+	Source            *PullRequestEndpointScheme `json:"source,omitempty"`
+	Destination       *PullRequestEndpointScheme `json:"destination,omitempty"`
+	Reviewers         []*AccountScheme           `json:"reviewers,omitempty"`
+	CloseSourceBranch bool                       `json:"close_source_branch,omitempty"`
+}
+
+type PullRequestMergePayloadScheme struct {
+	Type              string `json:"type,omitempty"`
+	Message           string `json:"message,omitempty"`
+	CloseSourceBranch bool   `json:"close_source_branch,omitempty"`
+	MergeStrategy     string `json:"merge_strategy,omitempty"`
+}
+
+// Returns the pull requests of a repository, the open pull requests are returned when the states are not provided.
+// Docs: N/A
+func (p *PullRequestService) Gets(ctx context.Context, workspace, repoSlug string, states []string, query string, page, pageLen int) (result *PullRequestPageScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "pullrequests")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	for _, state := range states {
+		params.Add("state", state)
+	}
+
+	if len(query) != 0 {
+		params.Add("q", query)
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodGet, withQuery(endpoint, params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PullRequestPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a pull request.
+// Docs: N/A
+func (p *PullRequestService) Get(ctx context.Context, workspace, repoSlug string, pullRequestID int) (result *PullRequestScheme, response *Response, err error) {
+	return p.send(ctx, http.MethodGet, workspace, repoSlug, pullRequestID, "", nil)
+}
+
+// Creates a new pull request, the destination is the main branch of the repository when it's not provided.
+// Docs: N/A
+func (p *PullRequestService) Create(ctx context.Context, workspace, repoSlug string, payload *PullRequestPayloadScheme) (result *PullRequestScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "pullrequests")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if payload == nil || len(payload.Title) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid PullRequestPayloadScheme pointer with the title")
+	}
+
+	if payload.Source == nil || payload.Source.Branch == nil || len(payload.Source.Branch.Name) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid source branch value")
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PullRequestScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Updates a pull request, e.g: the title, the description or the reviewers.
+// Docs: N/A
+func (p *PullRequestService) Update(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *PullRequestPayloadScheme) (result *PullRequestScheme, response *Response, err error) {
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid PullRequestPayloadScheme pointer")
+	}
+
+	return p.send(ctx, http.MethodPut, workspace, repoSlug, pullRequestID, "", payload)
+}
+
+// Merges a pull request, the merge strategy of the repository is used when the payload doesn't contain one.
+// Docs: N/A
+func (p *PullRequestService) Merge(ctx context.Context, workspace, repoSlug string, pullRequestID int, payload *PullRequestMergePayloadScheme) (result *PullRequestScheme, response *Response, err error) {
+
+	if payload == nil {
+		payload = &PullRequestMergePayloadScheme{}
+	}
+
+	if len(payload.Type) == 0 {
+		payload.Type = "pullrequest"
+	}
+
+	return p.send(ctx, http.MethodPost, workspace, repoSlug, pullRequestID, "merge", payload)
+}
+
+// Declines a pull request.
+// Docs: N/A
+func (p *PullRequestService) Decline(ctx context.Context, workspace, repoSlug string, pullRequestID int) (result *PullRequestScheme, response *Response, err error) {
+	return p.send(ctx, http.MethodPost, workspace, repoSlug, pullRequestID, "decline", nil)
+}
+
+// Approves a pull request as the authenticated user.
+// Docs: N/A
+func (p *PullRequestService) Approve(ctx context.Context, workspace, repoSlug string, pullRequestID int) (result *PullRequestParticipantScheme, response *Response, err error) {
+
+	endpoint, err := pullRequestEndpoint(workspace, repoSlug, pullRequestID, "approve")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PullRequestParticipantScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Removes the approval of the authenticated user.
+// Docs: N/A
+func (p *PullRequestService) Unapprove(ctx context.Context, workspace, repoSlug string, pullRequestID int) (response *Response, err error) {
+
+	endpoint, err := pullRequestEndpoint(workspace, repoSlug, pullRequestID, "approve")
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns the commits of a pull request.
+// Docs: N/A
+func (p *PullRequestService) Commits(ctx context.Context, workspace, repoSlug string, pullRequestID, page, pageLen int) (result *CommitPageScheme, response *Response, err error) {
+
+	endpoint, err := pullRequestEndpoint(workspace, repoSlug, pullRequestID, "commits")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	request, err := p.client.newRequest(ctx, http.MethodGet, withQuery(endpoint, params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(CommitPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+func (p *PullRequestService) send(ctx context.Context, method, workspace, repoSlug string, pullRequestID int, resource string, payload interface{}) (result *PullRequestScheme, response *Response, err error) {
+
+	endpoint, err := pullRequestEndpoint(workspace, repoSlug, pullRequestID, resource)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := p.client.newRequest(ctx, method, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	if payload != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PullRequestScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// pullRequestEndpoint validates the pull request ID and returns the endpoint of the pull request resource
+func pullRequestEndpoint(workspace, repoSlug string, pullRequestID int, resource string) (string, error) {
+
+	if pullRequestID == 0 {
+		return "", fmt.Errorf("error, please provide a valid pullRequestID value")
+	}
+
+	pullRequest := "pullrequests/" + strconv.Itoa(pullRequestID)
+	if len(resource) != 0 {
+		pullRequest = fmt.Sprintf("%v/%v", pullRequest, resource)
+	}
+
+	return repositoryEndpoint(workspace, repoSlug, pullRequest)
+}
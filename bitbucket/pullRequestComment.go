@@ -0,0 +1,149 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type PullRequestCommentService struct{ client *Client }
+
+type PullRequestCommentPageScheme struct {
+	Size     int                         `json:"size,omitempty"`
+	Page     int                         `json:"page,omitempty"`
+	PageLen  int                         `json:"pagelen,omitempty"`
+	Next     string                      `json:"next,omitempty"`
+	Previous string                      `json:"previous,omitempty"`
+	Values   []*PullRequestCommentScheme `json:"values,omitempty"`
+}
+
+type PullRequestCommentScheme struct {
+	Type      string                           `json:"type,omitempty"`
+	ID        int                              `json:"id,omitempty"`
+	Content   *PullRequestCommentContentScheme `json:"content,omitempty"`
+	User      *AccountScheme                   `json:"user,omitempty"`
+	Inline    *PullRequestCommentInlineScheme  `json:"inline,omitempty"`
+	Parent    *PullRequestCommentScheme        `json:"parent,omitempty"`
+	Deleted   bool                             `json:"deleted,omitempty"`
+	CreatedOn string                           `json:"created_on,omitempty"`
+	UpdatedOn string                           `json:"updated_on,omitempty"`
+	Links     *LinksScheme                     `json:"links,omitempty"`
+}
+
+type PullRequestCommentContentScheme struct {
+	Raw    string `json:"raw,omitempty"`
+	Markup string `json:"markup,omitempty"`
+	HTML   string `json:"html,omitempty"`
+}
+
+// PullRequestCommentInlineScheme places the comment on a line of a file, From is the line on the old version and To on the new version
+type PullRequestCommentInlineScheme struct {
+	Path string `json:"path,omitempty"`
+	From int    `json:"from,omitempty"`
+	To   int    `json:"to,omitempty"`
+}
+
+// Returns the comments of a pull request, including the inline comments and the replies.
+// Docs: N/A
+func (p *PullRequestCommentService) Gets(ctx context.Context, workspace, repoSlug string, pullRequestID, page, pageLen int) (result *PullRequestCommentPageScheme, response *Response, err error) {
+
+	endpoint, err := pullRequestEndpoint(workspace, repoSlug, pullRequestID, "comments")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	request, err := p.client.newRequest(ctx, http.MethodGet, withQuery(endpoint, params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PullRequestCommentPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Adds a comment to a pull request, the raw text uses the markdown format.
+// The inline value places the comment on a file and the parentID replies to a comment, both are optional.
+// Docs: N/A
+func (p *PullRequestCommentService) Add(ctx context.Context, workspace, repoSlug string, pullRequestID int, raw string, inline *PullRequestCommentInlineScheme, parentID int) (result *PullRequestCommentScheme, response *Response, err error) {
+
+	if len(raw) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid raw value")
+	}
+
+	endpoint, err := pullRequestEndpoint(workspace, repoSlug, pullRequestID, "comments")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload := &PullRequestCommentScheme{
+		Content: &PullRequestCommentContentScheme{Raw: raw},
+		Inline:  inline,
+	}
+
+	if parentID != 0 {
+		payload.Parent = &PullRequestCommentScheme{ID: parentID}
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(PullRequestCommentScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes a comment of a pull request.
+// Docs: N/A
+func (p *PullRequestCommentService) Delete(ctx context.Context, workspace, repoSlug string, pullRequestID, commentID int) (response *Response, err error) {
+
+	if commentID == 0 {
+		return nil, fmt.Errorf("error, please provide a valid commentID value")
+	}
+
+	endpoint, err := pullRequestEndpoint(workspace, repoSlug, pullRequestID, "comments/"+strconv.Itoa(commentID))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := p.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = p.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
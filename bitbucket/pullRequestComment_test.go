@@ -0,0 +1,629 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestPullRequestCommentService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                         string
+		workspace, repoSlug          string
+		pullRequestID, page, pageLen int
+		mockFile                     string
+		wantHTTPMethod               string
+		endpoint                     string
+		context                      context.Context
+		wantHTTPCodeReturn           int
+		wantErr                      bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-pull-request-comments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-pull-request-comments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-pull-request-comments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-pull-request-comments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-pull-request-comments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-pull-request-comments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-pull-request-comments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestCommentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, comment := range gotResult.Values {
+					t.Log(comment.ID, comment.Content.Raw)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestCommentService_Add(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		pullRequestID       int
+		raw                 string
+		inline              *PullRequestCommentInlineScheme
+		parentID            int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "AddWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			raw:                "Done",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/add-pull-request-comment.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "AddWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			raw:                "Done",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/add-pull-request-comment.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			raw:                "Done",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/add-pull-request-comment.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			raw:                "Done",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/add-pull-request-comment.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddWhenTheRawIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			raw:                "",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/add-pull-request-comment.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			raw:                "Done",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/add-pull-request-comment.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			raw:                "Done",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/add-pull-request-comment.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			raw:                "Done",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/add-pull-request-comment.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			raw:                "Done",
+			inline:             &PullRequestCommentInlineScheme{Path: "bitbucket/pullRequest.go", To: 42},
+			parentID:           301,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestCommentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Add(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID, testCase.raw, testCase.inline, testCase.parentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestCommentService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name                     string
+		workspace, repoSlug      string
+		pullRequestID, commentID int
+		mockFile                 string
+		wantHTTPMethod           string
+		endpoint                 string
+		context                  context.Context
+		wantHTTPCodeReturn       int
+		wantErr                  bool
+	}{
+		{
+			name:               "DeleteWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			commentID:          302,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments/302",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			commentID:          302,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments/302",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			commentID:          302,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments/302",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			commentID:          302,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments/302",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheCommentIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			commentID:          0,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments/302",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			commentID:          302,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments/302",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			commentID:          302,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments/302",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			commentID:          302,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/comments/302",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestCommentService{client: mockClient}
+
+			gotResponse, err := service.Delete(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID, testCase.commentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
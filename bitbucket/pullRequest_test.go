@@ -0,0 +1,1805 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestPullRequestService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		states              []string
+		query               string
+		page, pageLen       int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			states:             []string{PullRequestOpenState, PullRequestMergedState},
+			query:              `author.account_id = "5b86be50b8e3cb5895860d6d"`,
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pull-requests.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests?page=1&pagelen=10&q=author.account_id+%3D+%225b86be50b8e3cb5895860d6d%22&state=OPEN&state=MERGED",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			states:             []string{PullRequestOpenState, PullRequestMergedState},
+			query:              `author.account_id = "5b86be50b8e3cb5895860d6d"`,
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pull-requests.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests?page=1&pagelen=10&q=author.account_id+%3D+%225b86be50b8e3cb5895860d6d%22&state=OPEN&state=MERGED",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			states:             []string{PullRequestOpenState, PullRequestMergedState},
+			query:              `author.account_id = "5b86be50b8e3cb5895860d6d"`,
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pull-requests.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests?page=1&pagelen=10&q=author.account_id+%3D+%225b86be50b8e3cb5895860d6d%22&state=OPEN&state=MERGED",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			states:             []string{PullRequestOpenState, PullRequestMergedState},
+			query:              `author.account_id = "5b86be50b8e3cb5895860d6d"`,
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pull-requests.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests?page=1&pagelen=10&q=author.account_id+%3D+%225b86be50b8e3cb5895860d6d%22&state=OPEN&state=MERGED",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			states:             []string{PullRequestOpenState, PullRequestMergedState},
+			query:              `author.account_id = "5b86be50b8e3cb5895860d6d"`,
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pull-requests.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests?page=1&pagelen=10&q=author.account_id+%3D+%225b86be50b8e3cb5895860d6d%22&state=OPEN&state=MERGED",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			states:             []string{PullRequestOpenState, PullRequestMergedState},
+			query:              `author.account_id = "5b86be50b8e3cb5895860d6d"`,
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-pull-requests.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests?page=1&pagelen=10&q=author.account_id+%3D+%225b86be50b8e3cb5895860d6d%22&state=OPEN&state=MERGED",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			states:             []string{PullRequestOpenState, PullRequestMergedState},
+			query:              `author.account_id = "5b86be50b8e3cb5895860d6d"`,
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests?page=1&pagelen=10&q=author.account_id+%3D+%225b86be50b8e3cb5895860d6d%22&state=OPEN&state=MERGED",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.workspace, testCase.repoSlug, testCase.states, testCase.query, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, pullRequest := range gotResult.Values {
+					t.Log(pullRequest.ID, pullRequest.Title, pullRequest.State)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		pullRequestID       int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, []string{"KP-12", "KP-13"}, gotResult.IssueKeys())
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		payload             *PullRequestPayloadScheme
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:      "CreateWhenTheParametersAreCorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PullRequestPayloadScheme{
+				Title:       "KP-12 Bitbucket module",
+				Description: "Adds the Bitbucket module",
+				Source:      &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "feature/KP-12-bitbucket"}},
+				Destination: &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "main"}},
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:      "CreateWhenTheWorkspaceIsNotProvided",
+			workspace: "",
+			repoSlug:  "go-atlassian",
+			payload: &PullRequestPayloadScheme{
+				Title:       "KP-12 Bitbucket module",
+				Description: "Adds the Bitbucket module",
+				Source:      &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "feature/KP-12-bitbucket"}},
+				Destination: &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "main"}},
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheRepoSlugIsNotProvided",
+			workspace: "ctreminiom",
+			repoSlug:  "",
+			payload: &PullRequestPayloadScheme{
+				Title:       "KP-12 Bitbucket module",
+				Description: "Adds the Bitbucket module",
+				Source:      &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "feature/KP-12-bitbucket"}},
+				Destination: &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "main"}},
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenThePayloadIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			payload:            nil,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheSourceBranchIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module"},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheRequestMethodIsIncorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PullRequestPayloadScheme{
+				Title:       "KP-12 Bitbucket module",
+				Description: "Adds the Bitbucket module",
+				Source:      &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "feature/KP-12-bitbucket"}},
+				Destination: &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "main"}},
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheStatusCodeIsIncorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PullRequestPayloadScheme{
+				Title:       "KP-12 Bitbucket module",
+				Description: "Adds the Bitbucket module",
+				Source:      &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "feature/KP-12-bitbucket"}},
+				Destination: &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "main"}},
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheContextIsNil",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PullRequestPayloadScheme{
+				Title:       "KP-12 Bitbucket module",
+				Description: "Adds the Bitbucket module",
+				Source:      &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "feature/KP-12-bitbucket"}},
+				Destination: &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "main"}},
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheResponseBodyHasADifferentFormat",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &PullRequestPayloadScheme{
+				Title:       "KP-12 Bitbucket module",
+				Description: "Adds the Bitbucket module",
+				Source:      &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "feature/KP-12-bitbucket"}},
+				Destination: &PullRequestEndpointScheme{Branch: &BranchScheme{Name: "main"}},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.workspace, testCase.repoSlug, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestService_Update(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		pullRequestID       int
+		payload             *PullRequestPayloadScheme
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "UpdateWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module", Description: "Adds the Bitbucket module, it also fixes KP-13"},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "UpdateWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module", Description: "Adds the Bitbucket module, it also fixes KP-13"},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module", Description: "Adds the Bitbucket module, it also fixes KP-13"},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module", Description: "Adds the Bitbucket module, it also fixes KP-13"},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenThePayloadIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			payload:            nil,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module", Description: "Adds the Bitbucket module, it also fixes KP-13"},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module", Description: "Adds the Bitbucket module, it also fixes KP-13"},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module", Description: "Adds the Bitbucket module, it also fixes KP-13"},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			payload:            &PullRequestPayloadScheme{Title: "KP-12 Bitbucket module", Description: "Adds the Bitbucket module, it also fixes KP-13"},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Update(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestService_Merge(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		pullRequestID       int
+		payload             *PullRequestMergePayloadScheme
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:          "MergeWhenTheParametersAreCorrect",
+			workspace:     "ctreminiom",
+			repoSlug:      "go-atlassian",
+			pullRequestID: 7,
+			payload: &PullRequestMergePayloadScheme{
+				Message:           "KP-12 Bitbucket module",
+				CloseSourceBranch: true,
+				MergeStrategy:     SquashStrategy,
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:          "MergeWhenTheWorkspaceIsNotProvided",
+			workspace:     "",
+			repoSlug:      "go-atlassian",
+			pullRequestID: 7,
+			payload: &PullRequestMergePayloadScheme{
+				Message:           "KP-12 Bitbucket module",
+				CloseSourceBranch: true,
+				MergeStrategy:     SquashStrategy,
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:          "MergeWhenTheRepoSlugIsNotProvided",
+			workspace:     "ctreminiom",
+			repoSlug:      "",
+			pullRequestID: 7,
+			payload: &PullRequestMergePayloadScheme{
+				Message:           "KP-12 Bitbucket module",
+				CloseSourceBranch: true,
+				MergeStrategy:     SquashStrategy,
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:          "MergeWhenThePullRequestIDIsNotProvided",
+			workspace:     "ctreminiom",
+			repoSlug:      "go-atlassian",
+			pullRequestID: 0,
+			payload: &PullRequestMergePayloadScheme{
+				Message:           "KP-12 Bitbucket module",
+				CloseSourceBranch: true,
+				MergeStrategy:     SquashStrategy,
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:          "MergeWhenTheRequestMethodIsIncorrect",
+			workspace:     "ctreminiom",
+			repoSlug:      "go-atlassian",
+			pullRequestID: 7,
+			payload: &PullRequestMergePayloadScheme{
+				Message:           "KP-12 Bitbucket module",
+				CloseSourceBranch: true,
+				MergeStrategy:     SquashStrategy,
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:          "MergeWhenTheStatusCodeIsIncorrect",
+			workspace:     "ctreminiom",
+			repoSlug:      "go-atlassian",
+			pullRequestID: 7,
+			payload: &PullRequestMergePayloadScheme{
+				Message:           "KP-12 Bitbucket module",
+				CloseSourceBranch: true,
+				MergeStrategy:     SquashStrategy,
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:          "MergeWhenTheContextIsNil",
+			workspace:     "ctreminiom",
+			repoSlug:      "go-atlassian",
+			pullRequestID: 7,
+			payload: &PullRequestMergePayloadScheme{
+				Message:           "KP-12 Bitbucket module",
+				CloseSourceBranch: true,
+				MergeStrategy:     SquashStrategy,
+			},
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:          "MergeWhenTheResponseBodyHasADifferentFormat",
+			workspace:     "ctreminiom",
+			repoSlug:      "go-atlassian",
+			pullRequestID: 7,
+			payload: &PullRequestMergePayloadScheme{
+				Message:           "KP-12 Bitbucket module",
+				CloseSourceBranch: true,
+				MergeStrategy:     SquashStrategy,
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "MergeWhenThePayloadIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			payload:            nil,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/merge",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Merge(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestService_Decline(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		pullRequestID       int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "DeclineWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/decline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeclineWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/decline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeclineWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/decline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeclineWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/decline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeclineWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/decline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeclineWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/decline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeclineWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/get-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/decline",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeclineWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/decline",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Decline(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestService_Approve(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		pullRequestID       int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "ApproveWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/approve-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ApproveWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/approve-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ApproveWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			mockFile:           "./mocks/approve-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ApproveWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			mockFile:           "./mocks/approve-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ApproveWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/approve-pull-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ApproveWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/approve-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ApproveWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/approve-pull-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ApproveWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Approve(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.True(t, gotResult.Approved)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestService_Unapprove(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		pullRequestID       int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "UnapproveWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "UnapproveWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UnapproveWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UnapproveWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UnapproveWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UnapproveWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UnapproveWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/approve",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResponse, err := service.Unapprove(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestPullRequestService_Commits(t *testing.T) {
+
+	testCases := []struct {
+		name                         string
+		workspace, repoSlug          string
+		pullRequestID, page, pageLen int
+		mockFile                     string
+		wantHTTPMethod               string
+		endpoint                     string
+		context                      context.Context
+		wantHTTPCodeReturn           int
+		wantErr                      bool
+	}{
+		{
+			name:               "CommitsWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CommitsWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CommitsWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			pullRequestID:      7,
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CommitsWhenThePullRequestIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      0,
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CommitsWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CommitsWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CommitsWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=1&pagelen=50",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CommitsWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			pullRequestID:      7,
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/pullrequests/7/commits?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &PullRequestService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Commits(testCase.context, testCase.workspace, testCase.repoSlug, testCase.pullRequestID, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, commit := range gotResult.Values {
+					t.Log(commit.Hash, commit.IssueKeys())
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
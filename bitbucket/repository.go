@@ -0,0 +1,216 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type RepositoryService struct {
+	client      *Client
+	Branch      *BranchService
+	Commit      *CommitService
+	PullRequest *PullRequestService
+	Pipeline    *PipelineService
+	Webhook     *WebhookService
+}
+
+type RepositoryPageScheme struct {
+	Size     int                 `json:"size,omitempty"`
+	Page     int                 `json:"page,omitempty"`
+	PageLen  int                 `json:"pagelen,omitempty"`
+	Next     string              `json:"next,omitempty"`
+	Previous string              `json:"previous,omitempty"`
+	Values   []*RepositoryScheme `json:"values,omitempty"`
+}
+
+type RepositoryScheme struct {
+	Type        string                   `json:"type,omitempty"`
+	UUID        string                   `json:"uuid,omitempty"`
+	FullName    string                   `json:"full_name,omitempty"`
+	Name        string                   `json:"name,omitempty"`
+	Slug        string                   `json:"slug,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	SCM         string                   `json:"scm,omitempty"`
+	IsPrivate   bool                     `json:"is_private,omitempty"`
+	Language    string                   `json:"language,omitempty"`
+	ForkPolicy  string                   `json:"fork_policy,omitempty"`
+	CreatedOn   string                   `json:"created_on,omitempty"`
+	UpdatedOn   string                   `json:"updated_on,omitempty"`
+	Size        int                      `json:"size,omitempty"`
+	HasIssues   bool                     `json:"has_issues,omitempty"`
+	HasWiki     bool                     `json:"has_wiki,omitempty"`
+	MainBranch  *BranchScheme            `json:"mainbranch,omitempty"`
+	Owner       *AccountScheme           `json:"owner,omitempty"`
+	Workspace   *WorkspaceScheme         `json:"workspace,omitempty"`
+	Project     *RepositoryProjectScheme `json:"project,omitempty"`
+	Links       *LinksScheme             `json:"links,omitempty"`
+}
+
+type RepositoryProjectScheme struct {
+	Type  string       `json:"type,omitempty"`
+	UUID  string       `json:"uuid,omitempty"`
+	Key   string       `json:"key,omitempty"`
+	Name  string       `json:"name,omitempty"`
+	Links *LinksScheme `json:"links,omitempty"`
+}
+
+type RepositoryPayloadScheme struct {
+	SCM         string                   `json:"scm,omitempty"`
+	Description string                   `json:"description,omitempty"`
+	IsPrivate   bool                     `json:"is_private"`
+	ForkPolicy  string                   `json:"fork_policy,omitempty"`
+	Language    string                   `json:"language,omitempty"`
+	Project     *RepositoryProjectScheme `json:"project,omitempty"`
+}
+
+// Returns the repositories of a workspace, the query filters the repositories, e.g: name ~ "api".
+// Docs: N/A
+func (r *RepositoryService) Gets(ctx context.Context, workspace, query, sort string, page, pageLen int) (result *RepositoryPageScheme, response *Response, err error) {
+
+	if len(workspace) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid workspace value")
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	if len(query) != 0 {
+		params.Add("q", query)
+	}
+
+	if len(sort) != 0 {
+		params.Add("sort", sort)
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("2.0/repositories/%v", url.PathEscape(workspace)), params)
+
+	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RepositoryPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns the object describing a repository.
+// Docs: N/A
+func (r *RepositoryService) Get(ctx context.Context, workspace, repoSlug string) (result *RepositoryScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RepositoryScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Creates a new repository, the repositories are created on the oldest project of the workspace when the project is not provided.
+// Docs: N/A
+func (r *RepositoryService) Create(ctx context.Context, workspace, repoSlug string, payload *RepositoryPayloadScheme) (result *RepositoryScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid RepositoryPayloadScheme pointer")
+	}
+
+	if len(payload.SCM) == 0 {
+		payload.SCM = "git"
+	}
+
+	request, err := r.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RepositoryScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes a repository, the deletion is permanent.
+// Docs: N/A
+func (r *RepositoryService) Delete(ctx context.Context, workspace, repoSlug string) (response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "")
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := r.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// repositoryEndpoint validates the workspace and the repository and returns the endpoint of the repository resource
+func repositoryEndpoint(workspace, repoSlug, resource string) (string, error) {
+
+	if len(workspace) == 0 {
+		return "", fmt.Errorf("error, please provide a valid workspace value")
+	}
+
+	if len(repoSlug) == 0 {
+		return "", fmt.Errorf("error, please provide a valid repoSlug value")
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v", url.PathEscape(workspace), url.PathEscape(repoSlug))
+	if len(resource) != 0 {
+		endpoint = fmt.Sprintf("%v/%v", endpoint, resource)
+	}
+
+	return endpoint, nil
+}
@@ -0,0 +1,164 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type BranchService struct{ client *Client }
+
+type BranchPageScheme struct {
+	Size     int             `json:"size,omitempty"`
+	Page     int             `json:"page,omitempty"`
+	PageLen  int             `json:"pagelen,omitempty"`
+	Next     string          `json:"next,omitempty"`
+	Previous string          `json:"previous,omitempty"`
+	Values   []*BranchScheme `json:"values,omitempty"`
+}
+
+type BranchScheme struct {
+	Type                 string        `json:"type,omitempty"`
+	Name                 string        `json:"name,omitempty"`
+	Target               *CommitScheme `json:"target,omitempty"`
+	MergeStrategies      []string      `json:"merge_strategies,omitempty"`
+	DefaultMergeStrategy string        `json:"default_merge_strategy,omitempty"`
+	Links                *LinksScheme  `json:"links,omitempty"`
+}
+
+// Returns the branches of a repository, the query filters the branches, e.g: name ~ "KP-".
+// Docs: N/A
+func (b *BranchService) Gets(ctx context.Context, workspace, repoSlug, query string, page, pageLen int) (result *BranchPageScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "refs/branches")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	if len(query) != 0 {
+		params.Add("q", query)
+	}
+
+	request, err := b.client.newRequest(ctx, http.MethodGet, withQuery(endpoint, params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = b.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(BranchPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a branch and its head commit.
+// Docs: N/A
+func (b *BranchService) Get(ctx context.Context, workspace, repoSlug, name string) (result *BranchScheme, response *Response, err error) {
+
+	if len(name) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid branch name value")
+	}
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, fmt.Sprintf("refs/branches/%v", url.PathEscape(name)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := b.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = b.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(BranchScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Creates a new branch from the target, the target is a commit hash.
+// Docs: N/A
+func (b *BranchService) Create(ctx context.Context, workspace, repoSlug, name, target string) (result *BranchScheme, response *Response, err error) {
+
+	if len(name) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid branch name value")
+	}
+
+	if len(target) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid target value")
+	}
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "refs/branches")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload := &BranchScheme{Name: name, Target: &CommitScheme{Hash: target}}
+
+	request, err := b.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = b.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(BranchScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes a branch, the main branch can't be deleted.
+// Docs: N/A
+func (b *BranchService) Delete(ctx context.Context, workspace, repoSlug, name string) (response *Response, err error) {
+
+	if len(name) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid branch name value")
+	}
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, fmt.Sprintf("refs/branches/%v", url.PathEscape(name)))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := b.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = b.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
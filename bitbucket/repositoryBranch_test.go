@@ -0,0 +1,752 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestBranchService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		workspace, repoSlug, query string
+		page, pageLen              int
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			query:              `name ~ "KP-"`,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-branches.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches?q=name+~+%22KP-%22",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			query:              `name ~ "KP-"`,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-branches.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches?q=name+~+%22KP-%22",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			query:              `name ~ "KP-"`,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-branches.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches?q=name+~+%22KP-%22",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			query:              `name ~ "KP-"`,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-branches.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches?q=name+~+%22KP-%22",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			query:              `name ~ "KP-"`,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-branches.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches?q=name+~+%22KP-%22",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			query:              `name ~ "KP-"`,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-branches.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches?q=name+~+%22KP-%22",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			query:              `name ~ "KP-"`,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches?q=name+~+%22KP-%22",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &BranchService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.workspace, testCase.repoSlug, testCase.query, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, branch := range gotResult.Values {
+					t.Log(branch.Name, branch.Target.Hash)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestBranchService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                            string
+		workspace, repoSlug, branchName string
+		mockFile                        string
+		wantHTTPMethod                  string
+		endpoint                        string
+		context                         context.Context
+		wantHTTPCodeReturn              int
+		wantErr                         bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheBranchNameIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &BranchService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.workspace, testCase.repoSlug, testCase.branchName)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestBranchService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name                                    string
+		workspace, repoSlug, branchName, target string
+		mockFile                                string
+		wantHTTPMethod                          string
+		endpoint                                string
+		context                                 context.Context
+		wantHTTPCodeReturn                      int
+		wantErr                                 bool
+	}{
+		{
+			name:               "CreateWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			target:             "6a1c2f9e0b3d",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			target:             "6a1c2f9e0b3d",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			branchName:         "feature/KP-12-bitbucket",
+			target:             "6a1c2f9e0b3d",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheBranchNameIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "",
+			target:             "6a1c2f9e0b3d",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheTargetIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			target:             "",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			target:             "6a1c2f9e0b3d",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			target:             "6a1c2f9e0b3d",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			target:             "6a1c2f9e0b3d",
+			mockFile:           "./mocks/get-branch.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			target:             "6a1c2f9e0b3d",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &BranchService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.workspace, testCase.repoSlug, testCase.branchName, testCase.target)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestBranchService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name                            string
+		workspace, repoSlug, branchName string
+		mockFile                        string
+		wantHTTPMethod                  string
+		endpoint                        string
+		context                         context.Context
+		wantHTTPCodeReturn              int
+		wantErr                         bool
+	}{
+		{
+			name:               "DeleteWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheBranchNameIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			branchName:         "feature/KP-12-bitbucket",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/refs/branches/feature/KP-12-bitbucket",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &BranchService{client: mockClient}
+
+			gotResponse, err := service.Delete(testCase.context, testCase.workspace, testCase.repoSlug, testCase.branchName)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
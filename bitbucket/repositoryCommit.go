@@ -0,0 +1,123 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type CommitService struct{ client *Client }
+
+type CommitPageScheme struct {
+	Size     int             `json:"size,omitempty"`
+	Page     int             `json:"page,omitempty"`
+	PageLen  int             `json:"pagelen,omitempty"`
+	Next     string          `json:"next,omitempty"`
+	Previous string          `json:"previous,omitempty"`
+	Values   []*CommitScheme `json:"values,omitempty"`
+}
+
+type CommitScheme struct {
+	Type       string              `json:"type,omitempty"`
+	Hash       string              `json:"hash,omitempty"`
+	Date       string              `json:"date,omitempty"`
+	Message    string              `json:"message,omitempty"`
+	Author     *CommitAuthorScheme `json:"author,omitempty"`
+	Parents    []*CommitScheme     `json:"parents,omitempty"`
+	Repository *RepositoryScheme   `json:"repository,omitempty"`
+	Links      *LinksScheme        `json:"links,omitempty"`
+}
+
+type CommitAuthorScheme struct {
+	Type string         `json:"type,omitempty"`
+	Raw  string         `json:"raw,omitempty"`
+	User *AccountScheme `json:"user,omitempty"`
+}
+
+type GetCommitsOptionsScheme struct {
+	Include []string // The branches or commits to include, e.g: the feature branch
+	Exclude []string // The branches or commits to exclude, e.g: the main branch
+	Path    string   // Returns only the commits that modified the path
+}
+
+// Returns the commits of a repository in topological order, the newest commits first.
+// Docs: N/A
+func (c *CommitService) Gets(ctx context.Context, workspace, repoSlug string, options *GetCommitsOptionsScheme, page, pageLen int) (result *CommitPageScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "commits")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	if options != nil {
+
+		for _, include := range options.Include {
+			params.Add("include", include)
+		}
+
+		for _, exclude := range options.Exclude {
+			params.Add("exclude", exclude)
+		}
+
+		if len(options.Path) != 0 {
+			params.Add("path", options.Path)
+		}
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, withQuery(endpoint, params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(CommitPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a commit, the commit value is the full hash or a prefix of the hash.
+// Docs: N/A
+func (c *CommitService) Get(ctx context.Context, workspace, repoSlug, commit string) (result *CommitScheme, response *Response, err error) {
+
+	if len(commit) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid commit value")
+	}
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, fmt.Sprintf("commit/%v", url.PathEscape(commit)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(CommitScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,418 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCommitService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		options             *GetCommitsOptionsScheme
+		page, pageLen       int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:      "GetsWhenTheParametersAreCorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			options: &GetCommitsOptionsScheme{
+				Include: []string{"feature/KP-12-bitbucket"},
+				Exclude: []string{"main"},
+			},
+			page:               1,
+			pageLen:            30,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commits?exclude=main&include=feature%2FKP-12-bitbucket&page=1&pagelen=30",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:      "GetsWhenTheWorkspaceIsNotProvided",
+			workspace: "",
+			repoSlug:  "go-atlassian",
+			options: &GetCommitsOptionsScheme{
+				Include: []string{"feature/KP-12-bitbucket"},
+				Exclude: []string{"main"},
+			},
+			page:               1,
+			pageLen:            30,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commits?exclude=main&include=feature%2FKP-12-bitbucket&page=1&pagelen=30",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "GetsWhenTheRepoSlugIsNotProvided",
+			workspace: "ctreminiom",
+			repoSlug:  "",
+			options: &GetCommitsOptionsScheme{
+				Include: []string{"feature/KP-12-bitbucket"},
+				Exclude: []string{"main"},
+			},
+			page:               1,
+			pageLen:            30,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commits?exclude=main&include=feature%2FKP-12-bitbucket&page=1&pagelen=30",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "GetsWhenTheRequestMethodIsIncorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			options: &GetCommitsOptionsScheme{
+				Include: []string{"feature/KP-12-bitbucket"},
+				Exclude: []string{"main"},
+			},
+			page:               1,
+			pageLen:            30,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commits?exclude=main&include=feature%2FKP-12-bitbucket&page=1&pagelen=30",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "GetsWhenTheStatusCodeIsIncorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			options: &GetCommitsOptionsScheme{
+				Include: []string{"feature/KP-12-bitbucket"},
+				Exclude: []string{"main"},
+			},
+			page:               1,
+			pageLen:            30,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commits?exclude=main&include=feature%2FKP-12-bitbucket&page=1&pagelen=30",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:      "GetsWhenTheContextIsNil",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			options: &GetCommitsOptionsScheme{
+				Include: []string{"feature/KP-12-bitbucket"},
+				Exclude: []string{"main"},
+			},
+			page:               1,
+			pageLen:            30,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commits?exclude=main&include=feature%2FKP-12-bitbucket&page=1&pagelen=30",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "GetsWhenTheResponseBodyHasADifferentFormat",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			options: &GetCommitsOptionsScheme{
+				Include: []string{"feature/KP-12-bitbucket"},
+				Exclude: []string{"main"},
+			},
+			page:               1,
+			pageLen:            30,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commits?exclude=main&include=feature%2FKP-12-bitbucket&page=1&pagelen=30",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheOptionsAreNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			options:            nil,
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-commits.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commits",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &CommitService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.workspace, testCase.repoSlug, testCase.options, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, commit := range gotResult.Values {
+					t.Log(commit.Hash, commit.Message)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestCommitService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                        string
+		workspace, repoSlug, commit string
+		mockFile                    string
+		wantHTTPMethod              string
+		endpoint                    string
+		context                     context.Context
+		wantHTTPCodeReturn          int
+		wantErr                     bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			commit:             "9f8e7d6c5b4a",
+			mockFile:           "./mocks/get-commit.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commit/9f8e7d6c5b4a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			commit:             "9f8e7d6c5b4a",
+			mockFile:           "./mocks/get-commit.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commit/9f8e7d6c5b4a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			commit:             "9f8e7d6c5b4a",
+			mockFile:           "./mocks/get-commit.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commit/9f8e7d6c5b4a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheCommitIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			commit:             "",
+			mockFile:           "./mocks/get-commit.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commit/9f8e7d6c5b4a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			commit:             "9f8e7d6c5b4a",
+			mockFile:           "./mocks/get-commit.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commit/9f8e7d6c5b4a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			commit:             "9f8e7d6c5b4a",
+			mockFile:           "./mocks/get-commit.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commit/9f8e7d6c5b4a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			commit:             "9f8e7d6c5b4a",
+			mockFile:           "./mocks/get-commit.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commit/9f8e7d6c5b4a",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			commit:             "9f8e7d6c5b4a",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/commit/9f8e7d6c5b4a",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &CommitService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.workspace, testCase.repoSlug, testCase.commit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, []string{"KP-12"}, gotResult.IssueKeys())
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
@@ -0,0 +1,180 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type WebhookService struct{ client *Client }
+
+const (
+	WebhookRepositoryPushEvent      = "repo:push"
+	WebhookPullRequestCreatedEvent  = "pullrequest:created"
+	WebhookPullRequestUpdatedEvent  = "pullrequest:updated"
+	WebhookPullRequestApprovedEvent = "pullrequest:approved"
+	WebhookPullRequestMergedEvent   = "pullrequest:fulfilled"
+	WebhookPullRequestDeclinedEvent = "pullrequest:rejected"
+	WebhookPullRequestCommentEvent  = "pullrequest:comment_created"
+	WebhookBuildStatusCreatedEvent  = "repo:commit_status_created"
+	WebhookBuildStatusUpdatedEvent  = "repo:commit_status_updated"
+)
+
+type WebhookPageScheme struct {
+	Size     int              `json:"size,omitempty"`
+	Page     int              `json:"page,omitempty"`
+	PageLen  int              `json:"pagelen,omitempty"`
+	Next     string           `json:"next,omitempty"`
+	Previous string           `json:"previous,omitempty"`
+	Values   []*WebhookScheme `json:"values,omitempty"`
+}
+
+type WebhookScheme struct {
+	Type        string       `json:"type,omitempty"`
+	UUID        string       `json:"uuid,omitempty"`
+	URL         string       `json:"url,omitempty"`
+	Description string       `json:"description,omitempty"`
+	SubjectType string       `json:"subject_type,omitempty"`
+	Active      bool         `json:"active,omitempty"`
+	Events      []string     `json:"events,omitempty"`
+	CreatedAt   string       `json:"created_at,omitempty"`
+	Links       *LinksScheme `json:"links,omitempty"`
+}
+
+type WebhookPayloadScheme struct {
+	Description string   `json:"description,omitempty"`
+	URL         string   `json:"url,omitempty"`
+	Active      bool     `json:"active"`
+	Events      []string `json:"events,omitempty"`
+}
+
+// Returns the webhooks installed on a repository.
+// Docs: N/A
+func (w *WebhookService) Gets(ctx context.Context, workspace, repoSlug string, page, pageLen int) (result *WebhookPageScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "hooks")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	request, err := w.client.newRequest(ctx, http.MethodGet, withQuery(endpoint, params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WebhookPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Creates a webhook on a repository, the events are the keys of the webhook events, e.g: repo:push.
+// Docs: N/A
+func (w *WebhookService) Create(ctx context.Context, workspace, repoSlug string, payload *WebhookPayloadScheme) (result *WebhookScheme, response *Response, err error) {
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, "hooks")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if payload == nil || len(payload.URL) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid WebhookPayloadScheme pointer with the URL")
+	}
+
+	if len(payload.Events) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid events value")
+	}
+
+	request, err := w.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WebhookScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a webhook of a repository.
+// Docs: N/A
+func (w *WebhookService) Get(ctx context.Context, workspace, repoSlug, webhookUUID string) (result *WebhookScheme, response *Response, err error) {
+
+	if len(webhookUUID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid webhookUUID value")
+	}
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, fmt.Sprintf("hooks/%v", url.PathEscape(webhookUUID)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := w.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WebhookScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes a webhook of a repository.
+// Docs: N/A
+func (w *WebhookService) Delete(ctx context.Context, workspace, repoSlug, webhookUUID string) (response *Response, err error) {
+
+	if len(webhookUUID) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid webhookUUID value")
+	}
+
+	endpoint, err := repositoryEndpoint(workspace, repoSlug, fmt.Sprintf("hooks/%v", url.PathEscape(webhookUUID)))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := w.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,772 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestWebhookService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		page, pageLen       int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks?page=1&pagelen=10",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &WebhookService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.workspace, testCase.repoSlug, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, webhook := range gotResult.Values {
+					t.Log(webhook.UUID, webhook.URL, webhook.Events)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWebhookService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		workspace, repoSlug string
+		payload             *WebhookPayloadScheme
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:      "CreateWhenTheParametersAreCorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &WebhookPayloadScheme{
+				Description: "Jira automation",
+				URL:         "https://your-app.example.com/bitbucket",
+				Active:      true,
+				Events:      []string{WebhookRepositoryPushEvent, WebhookPullRequestMergedEvent},
+			},
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:      "CreateWhenTheWorkspaceIsNotProvided",
+			workspace: "",
+			repoSlug:  "go-atlassian",
+			payload: &WebhookPayloadScheme{
+				Description: "Jira automation",
+				URL:         "https://your-app.example.com/bitbucket",
+				Active:      true,
+				Events:      []string{WebhookRepositoryPushEvent, WebhookPullRequestMergedEvent},
+			},
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheRepoSlugIsNotProvided",
+			workspace: "ctreminiom",
+			repoSlug:  "",
+			payload: &WebhookPayloadScheme{
+				Description: "Jira automation",
+				URL:         "https://your-app.example.com/bitbucket",
+				Active:      true,
+				Events:      []string{WebhookRepositoryPushEvent, WebhookPullRequestMergedEvent},
+			},
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenThePayloadIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			payload:            nil,
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheEventsAreNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			payload:            &WebhookPayloadScheme{URL: "https://your-app.example.com/bitbucket"},
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheRequestMethodIsIncorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &WebhookPayloadScheme{
+				Description: "Jira automation",
+				URL:         "https://your-app.example.com/bitbucket",
+				Active:      true,
+				Events:      []string{WebhookRepositoryPushEvent, WebhookPullRequestMergedEvent},
+			},
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheStatusCodeIsIncorrect",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &WebhookPayloadScheme{
+				Description: "Jira automation",
+				URL:         "https://your-app.example.com/bitbucket",
+				Active:      true,
+				Events:      []string{WebhookRepositoryPushEvent, WebhookPullRequestMergedEvent},
+			},
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheContextIsNil",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &WebhookPayloadScheme{
+				Description: "Jira automation",
+				URL:         "https://your-app.example.com/bitbucket",
+				Active:      true,
+				Events:      []string{WebhookRepositoryPushEvent, WebhookPullRequestMergedEvent},
+			},
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:      "CreateWhenTheResponseBodyHasADifferentFormat",
+			workspace: "ctreminiom",
+			repoSlug:  "go-atlassian",
+			payload: &WebhookPayloadScheme{
+				Description: "Jira automation",
+				URL:         "https://your-app.example.com/bitbucket",
+				Active:      true,
+				Events:      []string{WebhookRepositoryPushEvent, WebhookPullRequestMergedEvent},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &WebhookService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.workspace, testCase.repoSlug, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWebhookService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                             string
+		workspace, repoSlug, webhookUUID string
+		mockFile                         string
+		wantHTTPMethod                   string
+		endpoint                         string
+		context                          context.Context
+		wantHTTPCodeReturn               int
+		wantErr                          bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheWebhookUUIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "",
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "./mocks/get-webhook.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &WebhookService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.workspace, testCase.repoSlug, testCase.webhookUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name                             string
+		workspace, repoSlug, webhookUUID string
+		mockFile                         string
+		wantHTTPMethod                   string
+		endpoint                         string
+		context                          context.Context
+		wantHTTPCodeReturn               int
+		wantErr                          bool
+	}{
+		{
+			name:               "DeleteWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRepoSlugIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheWebhookUUIDIsNotProvided",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			repoSlug:           "go-atlassian",
+			webhookUUID:        "{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/2.0/repositories/ctreminiom/go-atlassian/hooks/{5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9}",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &WebhookService{client: mockClient}
+
+			gotResponse, err := service.Delete(testCase.context, testCase.workspace, testCase.repoSlug, testCase.webhookUUID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
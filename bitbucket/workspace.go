@@ -0,0 +1,138 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type WorkspaceService struct{ client *Client }
+
+type WorkspacePageScheme struct {
+	Size     int                `json:"size,omitempty"`
+	Page     int                `json:"page,omitempty"`
+	PageLen  int                `json:"pagelen,omitempty"`
+	Next     string             `json:"next,omitempty"`
+	Previous string             `json:"previous,omitempty"`
+	Values   []*WorkspaceScheme `json:"values,omitempty"`
+}
+
+type WorkspaceScheme struct {
+	Type      string       `json:"type,omitempty"`
+	UUID      string       `json:"uuid,omitempty"`
+	Name      string       `json:"name,omitempty"`
+	Slug      string       `json:"slug,omitempty"`
+	IsPrivate bool         `json:"is_private,omitempty"`
+	CreatedOn string       `json:"created_on,omitempty"`
+	Links     *LinksScheme `json:"links,omitempty"`
+}
+
+type WorkspaceMembershipPageScheme struct {
+	Size     int                          `json:"size,omitempty"`
+	Page     int                          `json:"page,omitempty"`
+	PageLen  int                          `json:"pagelen,omitempty"`
+	Next     string                       `json:"next,omitempty"`
+	Previous string                       `json:"previous,omitempty"`
+	Values   []*WorkspaceMembershipScheme `json:"values,omitempty"`
+}
+
+type WorkspaceMembershipScheme struct {
+	Type      string           `json:"type,omitempty"`
+	User      *AccountScheme   `json:"user,omitempty"`
+	Workspace *WorkspaceScheme `json:"workspace,omitempty"`
+	Links     *LinksScheme     `json:"links,omitempty"`
+}
+
+// Returns the workspaces accessible to the authenticated user.
+// Docs: N/A
+func (w *WorkspaceService) Gets(ctx context.Context, page, pageLen int) (result *WorkspacePageScheme, response *Response, err error) {
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	var endpoint = withQuery("2.0/workspaces", params)
+
+	request, err := w.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WorkspacePageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns the requested workspace, the workspace value is the slug or the UUID surrounded by curly-braces.
+// Docs: N/A
+func (w *WorkspaceService) Get(ctx context.Context, workspace string) (result *WorkspaceScheme, response *Response, err error) {
+
+	if len(workspace) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid workspace value")
+	}
+
+	var endpoint = fmt.Sprintf("2.0/workspaces/%v", url.PathEscape(workspace))
+
+	request, err := w.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WorkspaceScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns the members of a workspace.
+// Docs: N/A
+func (w *WorkspaceService) Members(ctx context.Context, workspace string, page, pageLen int) (result *WorkspaceMembershipPageScheme, response *Response, err error) {
+
+	if len(workspace) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid workspace value")
+	}
+
+	params := url.Values{}
+	paginationParams(params, page, pageLen)
+
+	var endpoint = withQuery(fmt.Sprintf("2.0/workspaces/%v/members", url.PathEscape(workspace)), params)
+
+	request, err := w.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WorkspaceMembershipPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,469 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestWorkspaceService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		page, pageLen      int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-workspaces.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-workspaces.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/workspaces?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-workspaces.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/get-workspaces.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces?page=1&pagelen=10",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			page:               1,
+			pageLen:            10,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces?page=1&pagelen=10",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenThePaginationIsNotProvided",
+			page:               0,
+			pageLen:            0,
+			mockFile:           "./mocks/get-workspaces.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &WorkspaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, workspace := range gotResult.Values {
+					t.Log(workspace.UUID, workspace.Slug)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWorkspaceService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		workspace          string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			mockFile:           "./mocks/get-workspace.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			mockFile:           "./mocks/get-workspace.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			mockFile:           "./mocks/get-workspace.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/workspaces/ctreminiom",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			mockFile:           "./mocks/get-workspace.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			mockFile:           "./mocks/get-workspace.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &WorkspaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.workspace)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWorkspaceService_Members(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		workspace          string
+		page, pageLen      int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "MembersWhenTheParametersAreCorrect",
+			workspace:          "ctreminiom",
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-workspace-members.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom/members?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "MembersWhenTheWorkspaceIsNotProvided",
+			workspace:          "",
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-workspace-members.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom/members?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "MembersWhenTheRequestMethodIsIncorrect",
+			workspace:          "ctreminiom",
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-workspace-members.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/2.0/workspaces/ctreminiom/members?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "MembersWhenTheStatusCodeIsIncorrect",
+			workspace:          "ctreminiom",
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-workspace-members.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom/members?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "MembersWhenTheContextIsNil",
+			workspace:          "ctreminiom",
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/get-workspace-members.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom/members?page=1&pagelen=50",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "MembersWhenTheResponseBodyHasADifferentFormat",
+			workspace:          "ctreminiom",
+			page:               1,
+			pageLen:            50,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/2.0/workspaces/ctreminiom/members?page=1&pagelen=50",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &WorkspaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Members(testCase.context, testCase.workspace, testCase.page, testCase.pageLen)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, member := range gotResult.Values {
+					t.Log(member.User.AccountID, member.User.DisplayName)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
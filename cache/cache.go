@@ -0,0 +1,336 @@
+// Package cache stores the responses of the GET requests of the go-atlassian clients, e.g: the fields, priorities
+// and issue types fetched by the workers, so the read-mostly metadata isn't requested again until its TTL expires.
+//
+// The cached endpoints are configured with the endpoint templates of the service methods, e.g: "rest/api/3/field".
+// The expired entries are revalidated with the If-None-Match and If-Modified-Since headers when the API returned
+// the ETag or the Last-Modified headers, and the entries are invalidated after the related writes, e.g: a
+// "PUT rest/api/3/field/{fieldID}" request invalidates the "rest/api/3/field" entries.
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTTLs are the TTLs of the read-mostly metadata of Jira, they're used when the options don't contain TTLs
+var DefaultTTLs = map[string]time.Duration{
+	"rest/api/3/field":        10 * time.Minute,
+	"rest/api/3/priority":     time.Hour,
+	"rest/api/3/resolution":   time.Hour,
+	"rest/api/3/issuetype":    10 * time.Minute,
+	"rest/api/3/project/type": time.Hour,
+	"rest/api/3/serverInfo":   time.Hour,
+}
+
+// Backend stores the entries of the cache, e.g: NewLRU and NewFile. The backends must be safe for concurrent use.
+type Backend interface {
+	// Get returns the entry of the key, it returns nil when the key isn't stored
+	Get(key string) (*EntryScheme, error)
+	Set(key string, entry *EntryScheme) error
+	Delete(key string) error
+}
+
+type EntryScheme struct {
+	Route        string      `json:"route"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"lastModified,omitempty"`
+	Stored       time.Time   `json:"stored"`  // The time the request of the entry was sent
+	Expires      time.Time   `json:"expires"` // The time the entry must be revalidated
+}
+
+type OptionsScheme struct {
+	Backend     Backend                  // The storage of the entries, defaults to NewLRU(DefaultCapacity)
+	TTLs        map[string]time.Duration // The TTLs keyed by the endpoint templates of the GET requests, defaults to DefaultTTLs
+	Invalidates map[string][]string      // The cached routes invalidated by the writes keyed by "METHOD route", besides the prefixes of the write routes
+}
+
+// Cache caches the GET requests of the configured routes, the nil cache sends every request
+type Cache struct {
+	backend     Backend
+	ttls        map[string]time.Duration
+	invalidates map[string][]string
+	now         func() time.Time
+
+	mu          sync.Mutex
+	invalidated map[string]time.Time
+	stats       StatsScheme
+}
+
+type StatsScheme struct {
+	Hits          int64 // The requests returned from the cache without sending them
+	Misses        int64 // The requests sent because the entry wasn't stored
+	Revalidations int64 // The expired entries revalidated with a conditional request
+	NotModified   int64 // The revalidations answered with the 304 status code
+	Stores        int64 // The responses stored
+	Invalidations int64 // The routes invalidated by the writes
+	Errors        int64 // The errors of the backend, the requests are sent when the backend fails
+}
+
+// New returns the cache of the options
+func New(opts *OptionsScheme) (*Cache, error) {
+
+	if opts == nil {
+		return nil, fmt.Errorf("error, please provide a valid OptionsScheme pointer")
+	}
+
+	cache := &Cache{
+		backend:     opts.Backend,
+		ttls:        make(map[string]time.Duration),
+		invalidates: make(map[string][]string),
+		now:         time.Now,
+		invalidated: make(map[string]time.Time),
+	}
+
+	if cache.backend == nil {
+		cache.backend = NewLRU(DefaultCapacity)
+	}
+
+	ttls := opts.TTLs
+	if len(ttls) == 0 {
+		ttls = DefaultTTLs
+	}
+
+	for route, ttl := range ttls {
+
+		if ttl <= 0 {
+			return nil, fmt.Errorf("error, please provide a valid TTL for %v", route)
+		}
+
+		cache.ttls[strings.Trim(route, "/")] = ttl
+	}
+
+	for write, routes := range opts.Invalidates {
+
+		fields := strings.Fields(write)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("error, please provide the invalidation %v as \"METHOD route\"", write)
+		}
+
+		key := strings.ToUpper(fields[0]) + " " + strings.Trim(fields[1], "/")
+		for _, route := range routes {
+			cache.invalidates[key] = append(cache.invalidates[key], strings.Trim(route, "/"))
+		}
+	}
+
+	return cache, nil
+}
+
+// Do returns the cached response of the request or sends it with the send function, the route is the endpoint
+// template of the request, e.g: "rest/api/3/field", it's empty when the request isn't a service method.
+func (c *Cache) Do(request *http.Request, route string, send func(request *http.Request) (*http.Response, error)) (*http.Response, error) {
+
+	if c == nil || len(route) == 0 {
+		return send(request)
+	}
+
+	if request.Method != http.MethodGet {
+
+		response, err := send(request)
+		if err == nil && response.StatusCode < http.StatusBadRequest {
+			c.invalidate(request.Method, route)
+		}
+
+		return response, err
+	}
+
+	ttl, ok := c.ttls[route]
+	if !ok {
+		return send(request)
+	}
+
+	key := c.key(request)
+	started := c.now()
+
+	entry, err := c.backend.Get(key)
+	if err != nil {
+		c.count(func(stats *StatsScheme) { stats.Errors++ })
+		entry = nil
+	}
+
+	if entry != nil && c.fresh(entry, started) {
+		c.count(func(stats *StatsScheme) { stats.Hits++ })
+		return entry.response(request), nil
+	}
+
+	// The expired entries with the validators are revalidated with a conditional request
+	conditional := entry != nil && (len(entry.ETag) != 0 || len(entry.LastModified) != 0)
+
+	if conditional {
+
+		c.count(func(stats *StatsScheme) { stats.Revalidations++ })
+
+		request = request.Clone(request.Context())
+
+		if len(entry.ETag) != 0 {
+			request.Header.Set("If-None-Match", entry.ETag)
+		}
+
+		if len(entry.LastModified) != 0 {
+			request.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+
+	} else {
+		c.count(func(stats *StatsScheme) { stats.Misses++ })
+	}
+
+	response, err := send(request)
+	if err != nil {
+		return response, err
+	}
+
+	if conditional && response.StatusCode == http.StatusNotModified {
+
+		_ = response.Body.Close()
+
+		entry.Stored = started
+		entry.Expires = started.Add(ttl)
+
+		c.store(key, entry)
+		c.count(func(stats *StatsScheme) { stats.NotModified++ })
+
+		return entry.response(request), nil
+	}
+
+	// The entries of the resources that aren't returned anymore are deleted, e.g: after a 404 status code
+	if entry != nil && response.StatusCode != http.StatusOK {
+		if err := c.backend.Delete(key); err != nil {
+			c.count(func(stats *StatsScheme) { stats.Errors++ })
+		}
+	}
+
+	if response.StatusCode != http.StatusOK || strings.Contains(strings.ToLower(response.Header.Get("Cache-Control")), "no-store") {
+		return response, nil
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	_ = response.Body.Close()
+
+	response.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return response, err
+	}
+
+	c.store(key, &EntryScheme{
+		Route:        route,
+		Header:       response.Header.Clone(),
+		Body:         body,
+		ETag:         response.Header.Get("ETag"),
+		LastModified: response.Header.Get("Last-Modified"),
+		Stored:       started,
+		Expires:      started.Add(ttl),
+	})
+
+	return response, nil
+}
+
+// Invalidate expires the entries of the routes, e.g: after the metadata was changed by another process
+func (c *Cache) Invalidate(routes ...string) {
+
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for _, route := range routes {
+		c.invalidated[strings.Trim(route, "/")] = now
+		c.stats.Invalidations++
+	}
+}
+
+// Stats returns the counters of the cache, e.g: to compute the hit ratio
+func (c *Cache) Stats() *StatsScheme {
+
+	if c == nil {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	return &stats
+}
+
+// invalidate expires the cached routes related to the write, the cached routes prefixing the write route
+// are related, e.g: "rest/api/3/field" is invalidated by "PUT rest/api/3/field/{fieldID}".
+func (c *Cache) invalidate(method, route string) {
+
+	var routes []string
+	for cached := range c.ttls {
+		if route == cached || strings.HasPrefix(route, cached+"/") {
+			routes = append(routes, cached)
+		}
+	}
+
+	routes = append(routes, c.invalidates[method+" "+route]...)
+
+	if len(routes) != 0 {
+		c.Invalidate(routes...)
+	}
+}
+
+// fresh returns true when the entry isn't expired and its route wasn't invalidated after its request was sent
+func (c *Cache) fresh(entry *EntryScheme, now time.Time) bool {
+
+	if !now.Before(entry.Expires) {
+		return false
+	}
+
+	c.mu.Lock()
+	invalidated, ok := c.invalidated[entry.Route]
+	c.mu.Unlock()
+
+	return !ok || entry.Stored.After(invalidated)
+}
+
+func (c *Cache) store(key string, entry *EntryScheme) {
+
+	if err := c.backend.Set(key, entry); err != nil {
+		c.count(func(stats *StatsScheme) { stats.Errors++ })
+		return
+	}
+
+	c.count(func(stats *StatsScheme) { stats.Stores++ })
+}
+
+func (c *Cache) count(update func(stats *StatsScheme)) {
+	c.mu.Lock()
+	update(&c.stats)
+	c.mu.Unlock()
+}
+
+// key returns the key of the request, the credentials are hashed into the key because the responses
+// depend on the permissions of the user.
+func (c *Cache) key(request *http.Request) string {
+
+	credentials := sha256.Sum256([]byte(request.Header.Get("Authorization")))
+	return request.Method + " " + request.URL.String() + " " + hex.EncodeToString(credentials[:8])
+}
+
+// response returns the entry as a response of the request
+func (e *EntryScheme) response(request *http.Request) *http.Response {
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       request,
+	}
+}
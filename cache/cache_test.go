@@ -0,0 +1,275 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	current time.Time
+}
+
+func (f *fakeClock) now() time.Time                 { return f.current }
+func (f *fakeClock) advance(duration time.Duration) { f.current = f.current.Add(duration) }
+
+// mockAPI serves the fields with an ETag, the priorities without validators and counts the requests
+type mockAPI struct {
+	server   *httptest.Server
+	requests map[string]int
+	etag     string
+	status   int
+}
+
+func startMockAPI() *mockAPI {
+
+	api := &mockAPI{requests: make(map[string]int), etag: `"v1"`, status: http.StatusOK}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/api/3/field", func(w http.ResponseWriter, r *http.Request) {
+
+		api.requests[r.Method+" "+r.URL.Path]++
+
+		if r.Header.Get("If-None-Match") == api.etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", api.etag)
+		w.WriteHeader(api.status)
+		_, _ = w.Write([]byte(`[{"id": "summary", "name": "Summary"}]`))
+	})
+
+	mux.HandleFunc("/rest/api/3/priority", func(w http.ResponseWriter, r *http.Request) {
+		api.requests[r.Method+" "+r.URL.Path]++
+		_, _ = w.Write([]byte(`[{"id": "1", "name": "Highest"}]`))
+	})
+
+	mux.HandleFunc("/rest/api/3/resolution", func(w http.ResponseWriter, r *http.Request) {
+		api.requests[r.Method+" "+r.URL.Path]++
+		w.Header().Set("Cache-Control", "no-store")
+		_, _ = w.Write([]byte(`[{"id": "10000", "name": "Done"}]`))
+	})
+
+	mux.HandleFunc("/rest/api/3/field/customfield_10000", func(w http.ResponseWriter, r *http.Request) {
+		api.requests[r.Method+" "+r.URL.Path]++
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	api.server = httptest.NewServer(mux)
+
+	return api
+}
+
+func newFakeCache(t *testing.T, opts *OptionsScheme) (*Cache, *fakeClock) {
+
+	cache, err := New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clock := &fakeClock{current: time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)}
+	cache.now = clock.now
+
+	return cache, clock
+}
+
+func do(t *testing.T, cache *Cache, method, url, route, authorization string) (int, string) {
+
+	request, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request.Header.Set("Authorization", authorization)
+
+	response, err := cache.Do(request, route, http.DefaultClient.Do)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.StatusCode, string(body)
+}
+
+func TestNew(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		opts    *OptionsScheme
+		wantErr bool
+	}{
+		{
+			name: "CreateTheCacheWhenTheOptionsAreCorrect",
+			opts: &OptionsScheme{TTLs: map[string]time.Duration{"/rest/api/3/field": time.Minute}, Invalidates: map[string][]string{"post rest/api/3/screens": {"rest/api/3/field"}}},
+		},
+		{
+			name: "CreateTheCacheWhenTheTTLsAreNotProvided",
+			opts: &OptionsScheme{},
+		},
+		{
+			name:    "CreateTheCacheWhenTheOptionsAreNotProvided",
+			wantErr: true,
+		},
+		{
+			name:    "CreateTheCacheWhenATTLIsNotPositive",
+			opts:    &OptionsScheme{TTLs: map[string]time.Duration{"rest/api/3/field": 0}},
+			wantErr: true,
+		},
+		{
+			name:    "CreateTheCacheWhenAnInvalidationHasNotMethod",
+			opts:    &OptionsScheme{Invalidates: map[string][]string{"rest/api/3/screens": {"rest/api/3/field"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			cache, err := New(testCase.opts)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, cache)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, cache)
+		})
+	}
+}
+
+func TestCache_Do(t *testing.T) {
+
+	api := startMockAPI()
+	defer api.server.Close()
+
+	cache, clock := newFakeCache(t, &OptionsScheme{
+		TTLs: map[string]time.Duration{
+			"rest/api/3/field":      time.Minute,
+			"rest/api/3/priority":   time.Minute,
+			"rest/api/3/resolution": time.Minute,
+		},
+		Invalidates: map[string][]string{"POST rest/api/3/screens": {"rest/api/3/priority"}},
+	})
+
+	fields := api.server.URL + "/rest/api/3/field"
+
+	// The first request is sent and the next one is returned from the cache
+	for index := 0; index < 2; index++ {
+
+		status, body := do(t, cache, http.MethodGet, fields, "rest/api/3/field", "Basic a")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, `[{"id": "summary", "name": "Summary"}]`, body)
+	}
+
+	assert.Equal(t, 1, api.requests["GET /rest/api/3/field"])
+
+	// The responses of other credentials aren't shared
+	do(t, cache, http.MethodGet, fields, "rest/api/3/field", "Basic b")
+	assert.Equal(t, 2, api.requests["GET /rest/api/3/field"])
+
+	// The expired entry is revalidated with its ETag
+	clock.advance(2 * time.Minute)
+
+	status, body := do(t, cache, http.MethodGet, fields, "rest/api/3/field", "Basic a")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `[{"id": "summary", "name": "Summary"}]`, body)
+	assert.Equal(t, 3, api.requests["GET /rest/api/3/field"])
+
+	do(t, cache, http.MethodGet, fields, "rest/api/3/field", "Basic a")
+	assert.Equal(t, 3, api.requests["GET /rest/api/3/field"])
+
+	// The write of a field invalidates the fields
+	clock.advance(time.Second)
+	do(t, cache, http.MethodPut, fields+"/customfield_10000", "rest/api/3/field/{fieldID}", "Basic a")
+
+	clock.advance(time.Second)
+	api.etag = `"v2"`
+
+	do(t, cache, http.MethodGet, fields, "rest/api/3/field", "Basic a")
+	assert.Equal(t, 4, api.requests["GET /rest/api/3/field"])
+
+	// The configured invalidations expire the related routes
+	priorities := api.server.URL + "/rest/api/3/priority"
+
+	do(t, cache, http.MethodGet, priorities, "rest/api/3/priority", "Basic a")
+	do(t, cache, http.MethodPost, priorities, "rest/api/3/screens", "Basic a")
+
+	clock.advance(time.Second)
+
+	do(t, cache, http.MethodGet, priorities, "rest/api/3/priority", "Basic a")
+	assert.Equal(t, 2, api.requests["GET /rest/api/3/priority"])
+
+	// The no-store responses aren't cached
+	resolutions := api.server.URL + "/rest/api/3/resolution"
+
+	do(t, cache, http.MethodGet, resolutions, "rest/api/3/resolution", "Basic a")
+	do(t, cache, http.MethodGet, resolutions, "rest/api/3/resolution", "Basic a")
+	assert.Equal(t, 2, api.requests["GET /rest/api/3/resolution"])
+
+	// The entries of the deleted resources are removed
+	clock.advance(2 * time.Minute)
+	api.etag, api.status = `"v3"`, http.StatusNotFound
+
+	status, _ = do(t, cache, http.MethodGet, fields, "rest/api/3/field", "Basic b")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	entry, err := cache.backend.Get(cache.key(newRequest(t, fields, "Basic b")))
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	assert.Equal(t, &StatsScheme{
+		Hits:          2,
+		Misses:        6,
+		Revalidations: 3,
+		NotModified:   1,
+		Stores:        6,
+		Invalidations: 2,
+	}, cache.Stats())
+}
+
+func newRequest(t *testing.T, url, authorization string) *http.Request {
+
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request.Header.Set("Authorization", authorization)
+	return request
+}
+
+func TestCache_Nil(t *testing.T) {
+
+	api := startMockAPI()
+	defer api.server.Close()
+
+	var cache *Cache
+
+	// The nil cache sends every request
+	for index := 0; index < 2; index++ {
+
+		request := newRequest(t, api.server.URL+"/rest/api/3/priority", "")
+
+		response, err := cache.Do(request, "rest/api/3/priority", http.DefaultClient.Do)
+		assert.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	assert.Equal(t, 2, api.requests["GET /rest/api/3/priority"])
+
+	cache.Invalidate("rest/api/3/priority")
+	assert.Nil(t, cache.Stats())
+}
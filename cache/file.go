@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// File stores the entries as JSON files of a directory, so the entries are shared by the processes
+// of the same host and they're kept after the restarts.
+type File struct {
+	directory string
+}
+
+// NewFile returns the file backend of the directory, the directory is created when it doesn't exist
+func NewFile(directory string) (*File, error) {
+
+	if len(directory) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid directory value")
+	}
+
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, err
+	}
+
+	return &File{directory: directory}, nil
+}
+
+func (f *File) Get(key string) (*EntryScheme, error) {
+
+	content, err := ioutil.ReadFile(f.path(key))
+	if err != nil {
+
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	entry := new(EntryScheme)
+	if err = json.Unmarshal(content, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// Set writes the entry to a temporary file and renames it, so the readers don't see partial entries
+func (f *File) Set(key string, entry *EntryScheme) error {
+
+	content, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	temporary, err := ioutil.TempFile(f.directory, "entry-*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(temporary.Name())
+
+	if _, err = temporary.Write(content); err != nil {
+		_ = temporary.Close()
+		return err
+	}
+
+	if err = temporary.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(temporary.Name(), f.path(key))
+}
+
+func (f *File) Delete(key string) error {
+
+	if err := os.Remove(f.path(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// path returns the file of the key, the keys are hashed because they contain the URLs of the requests
+func (f *File) path(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(f.directory, hex.EncodeToString(hash[:])+".json")
+}
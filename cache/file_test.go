@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFile(t *testing.T) {
+
+	backend, err := NewFile(filepath.Join(t.TempDir(), "cache"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := backend.Get("GET https://ctreminiom.atlassian.net/rest/api/3/field")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	stored := &EntryScheme{
+		Route:   "rest/api/3/field",
+		Header:  http.Header{"Content-Type": []string{"application/json"}},
+		Body:    []byte(`[{"id": "summary"}]`),
+		ETag:    `"v1"`,
+		Stored:  time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC),
+		Expires: time.Date(2021, 5, 1, 12, 10, 0, 0, time.UTC),
+	}
+
+	assert.NoError(t, backend.Set("GET https://ctreminiom.atlassian.net/rest/api/3/field", stored))
+
+	entry, err = backend.Get("GET https://ctreminiom.atlassian.net/rest/api/3/field")
+	assert.NoError(t, err)
+	assert.Equal(t, stored, entry)
+
+	// The temporary files are removed after the rename
+	files, err := ioutil.ReadDir(backend.directory)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	assert.NoError(t, backend.Delete("GET https://ctreminiom.atlassian.net/rest/api/3/field"))
+	assert.NoError(t, backend.Delete("GET https://ctreminiom.atlassian.net/rest/api/3/field"))
+
+	entry, err = backend.Get("GET https://ctreminiom.atlassian.net/rest/api/3/field")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	_, err = NewFile("")
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DefaultCapacity is the number of entries of the LRU used when the options don't contain a backend
+const DefaultCapacity = 1000
+
+// LRU stores the entries in memory, the least recently used entry is evicted once the capacity is reached
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	elements map[string]*list.Element
+}
+
+type lruElement struct {
+	key   string
+	entry EntryScheme
+}
+
+// NewLRU returns the in-memory backend with the capacity, the capacity defaults to DefaultCapacity
+func NewLRU(capacity int) *LRU {
+
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+
+	return &LRU{capacity: capacity, order: list.New(), elements: make(map[string]*list.Element)}
+}
+
+// Get returns a copy of the entry, so the entries can't be modified by the callers
+func (l *LRU) Get(key string) (*EntryScheme, error) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.elements[key]
+	if !ok {
+		return nil, nil
+	}
+
+	l.order.MoveToFront(element)
+
+	entry := element.Value.(*lruElement).entry
+	return &entry, nil
+}
+
+func (l *LRU) Set(key string, entry *EntryScheme) error {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.elements[key]; ok {
+		element.Value.(*lruElement).entry = *entry
+		l.order.MoveToFront(element)
+		return nil
+	}
+
+	l.elements[key] = l.order.PushFront(&lruElement{key: key, entry: *entry})
+
+	for l.order.Len() > l.capacity {
+
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+
+		delete(l.elements, oldest.Value.(*lruElement).key)
+	}
+
+	return nil
+}
+
+func (l *LRU) Delete(key string) error {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.elements[key]; ok {
+		l.order.Remove(element)
+		delete(l.elements, key)
+	}
+
+	return nil
+}
+
+// Len returns the number of entries stored
+func (l *LRU) Len() int {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.order.Len()
+}
@@ -0,0 +1,37 @@
+package cache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLRU(t *testing.T) {
+
+	lru := NewLRU(2)
+
+	assert.NoError(t, lru.Set("a", &EntryScheme{Route: "rest/api/3/field"}))
+	assert.NoError(t, lru.Set("b", &EntryScheme{Route: "rest/api/3/priority"}))
+
+	// The read moves the entry to the front, so "b" is the least recently used
+	entry, err := lru.Get("a")
+	assert.NoError(t, err)
+	assert.Equal(t, "rest/api/3/field", entry.Route)
+
+	assert.NoError(t, lru.Set("c", &EntryScheme{Route: "rest/api/3/resolution"}))
+	assert.Equal(t, 2, lru.Len())
+
+	entry, err = lru.Get("b")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	// The returned entries are copies
+	entry, _ = lru.Get("c")
+	entry.Route = "rest/api/3/issuetype"
+
+	entry, _ = lru.Get("c")
+	assert.Equal(t, "rest/api/3/resolution", entry.Route)
+
+	assert.NoError(t, lru.Delete("c"))
+	assert.NoError(t, lru.Delete("c"))
+	assert.Equal(t, 1, lru.Len())
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+func (a *app) adminCommand() *command {
+
+	return &command{
+		name:        "admin",
+		description: "List the users and the audit events of an Atlassian organization.",
+		commands: []*command{
+			a.adminUsersCommand(),
+			a.adminEventsCommand(),
+		},
+	}
+}
+
+func (a *app) adminUsersCommand() *command {
+
+	var (
+		organizationID string
+		all            bool
+	)
+
+	return &command{
+		name:        "users",
+		usage:       "[flags]",
+		description: "List the managed users of the organization.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&organizationID, "org", "", "the organization ID, defaults to the organization of the profile")
+			fs.BoolVar(&all, "all", false, "fetch all the pages of users")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			client, organizationID, err := a.admin(organizationID)
+			if err != nil {
+				return err
+			}
+
+			var (
+				cursor string
+				users  []*admin.OrganizationUserPageScheme
+			)
+
+			for {
+
+				page, response, err := client.Organization.Users(ctx, organizationID, cursor)
+				if err != nil {
+					return apiError(err, response)
+				}
+
+				users = append(users, page)
+
+				next := cursorOf(page.Links.Next)
+				if !all || len(next) == 0 || next == cursor || len(page.Data) == 0 {
+					break
+				}
+
+				cursor = next
+			}
+
+			var data []interface{}
+
+			t := &table{header: []string{"account id", "name", "email", "status", "last active"}}
+			for _, page := range users {
+				for _, user := range page.Data {
+					t.add(user.AccountID, user.Name, user.Email, user.AccountStatus, user.LastActive)
+					data = append(data, user)
+				}
+			}
+
+			if data == nil {
+				data = []interface{}{}
+			}
+
+			return a.print(data, t)
+		},
+	}
+}
+
+func (a *app) adminEventsCommand() *command {
+
+	var (
+		organizationID string
+		options        admin.OrganizationEventOptScheme
+		from, to       string
+		all            bool
+	)
+
+	return &command{
+		name:        "events",
+		usage:       "[flags]",
+		description: "List the audit log events of the organization, the newest events first.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&organizationID, "org", "", "the organization ID, defaults to the organization of the profile")
+			fs.StringVar(&options.Q, "query", "", "a query term to search the events")
+			fs.StringVar(&options.Action, "action", "", "the action of the events, e.g: user_added_to_group")
+			fs.StringVar(&from, "from", "", "the earliest time of the events, e.g: 2021-06-01 or 2021-06-01T09:00:00Z")
+			fs.StringVar(&to, "to", "", "the latest time of the events, e.g: 2021-06-30 or 2021-06-30T18:00:00Z")
+			fs.BoolVar(&all, "all", false, "fetch all the pages of events")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			var err error
+
+			if options.From, err = parseTime(from); err != nil {
+				return err
+			}
+
+			if options.To, err = parseTime(to); err != nil {
+				return err
+			}
+
+			client, organizationID, err := a.admin(organizationID)
+			if err != nil {
+				return err
+			}
+
+			var (
+				cursor string
+				events []admin.OrganizationEventDataScheme
+			)
+
+			for {
+
+				page, response, err := client.Organization.Events(ctx, organizationID, &options, cursor)
+				if err != nil {
+					return apiError(err, response)
+				}
+
+				events = append(events, page.Data...)
+
+				next := page.Meta.Next
+				if len(next) == 0 {
+					next = cursorOf(page.Links.Next)
+				}
+
+				if !all || len(next) == 0 || next == cursor || len(page.Data) == 0 {
+					break
+				}
+
+				cursor = next
+			}
+
+			t := &table{header: []string{"id", "time", "action", "actor"}}
+			for _, event := range events {
+				t.add(event.ID, event.Attributes.Time, event.Attributes.Action, event.Attributes.Actor.Name)
+			}
+
+			if events == nil {
+				events = []admin.OrganizationEventDataScheme{}
+			}
+
+			return a.print(events, t)
+		},
+	}
+}
+
+// cursorOf returns the cursor parameter of a next page link
+func cursorOf(link string) string {
+
+	if len(link) == 0 {
+		return ""
+	}
+
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Query().Get("cursor")
+}
+
+// parseTime parses a RFC 3339 time, a date or a UNIX epoch time, the empty value returns the zero time
+func parseTime(value string) (time.Time, error) {
+
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("the time %q is not valid, use a RFC 3339 time, a date or a UNIX epoch time", value)
+}
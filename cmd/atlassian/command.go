@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// command is a node of the CLI tree, the groups contain sub-commands and the leaves run an action
+type command struct {
+	name        string
+	usage       string
+	description string
+	commands    []*command
+	choices     []string
+	flags       func(fs *flag.FlagSet)
+	run         func(ctx context.Context, args []string) error
+}
+
+func (c *command) find(name string) *command {
+
+	for _, sub := range c.commands {
+		if sub.name == name {
+			return sub
+		}
+	}
+
+	return nil
+}
+
+// flagSet returns the flags accepted by the command, the global flags included
+func (c *command) flagSet(global func(fs *flag.FlagSet)) *flag.FlagSet {
+
+	fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	global(fs)
+
+	if c.flags != nil {
+		c.flags(fs)
+	}
+
+	return fs
+}
+
+func (c *command) printUsage(w io.Writer, path string) {
+
+	if c.run != nil {
+		_, _ = fmt.Fprintf(w, "Usage: %v %v\n\n%v\n", path, c.usage, c.description)
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "Usage: %v <command> [flags]\n\n%v\n\nCommands:\n", path, c.description)
+
+	for _, sub := range c.commands {
+		_, _ = fmt.Fprintf(w, "  %-12v %v\n", sub.name, sub.description)
+	}
+
+	_, _ = fmt.Fprintf(w, "\nRun '%v <command> --help' for more information about a command.\n", path)
+}
+
+// parseFlags parses the flags placed before, between and after the positional arguments,
+// the arguments after the "--" terminator are not parsed.
+func parseFlags(fs *flag.FlagSet, args []string) (positional []string, err error) {
+
+	for {
+
+		if err = fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		rest := fs.Args()
+		if len(rest) == 0 {
+			return positional, nil
+		}
+
+		// The flag package consumes the terminator, the remaining arguments are positional
+		if len(rest) < len(args) && args[len(args)-len(rest)-1] == "--" {
+			return append(positional, rest...), nil
+		}
+
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// stringsFlag is a flag that can be repeated, e.g: --field labels=ops --field priority=High
+type stringsFlag []string
+
+func (s *stringsFlag) String() string { return strings.Join(*s, ",") }
+
+func (s *stringsFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// splitList splits a comma-separated flag value, the empty values are ignored
+func splitList(value string) (values []string) {
+
+	for _, item := range strings.Split(value, ",") {
+
+		if item = strings.TrimSpace(item); len(item) != 0 {
+			values = append(values, item)
+		}
+	}
+
+	return
+}
+
+// requireArgs checks the number of positional arguments of a command
+func requireArgs(args []string, names ...string) error {
+
+	if len(args) < len(names) {
+		return fmt.Errorf("missing the %v argument", names[len(args)])
+	}
+
+	if len(args) > len(names) {
+		return fmt.Errorf("unexpected argument %q", args[len(names)])
+	}
+
+	return nil
+}
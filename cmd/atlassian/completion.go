@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var completionShells = []string{"bash", "zsh", "fish"}
+
+func (a *app) completionCommand() *command {
+
+	return &command{
+		name:        "completion",
+		usage:       "<bash|zsh|fish>",
+		description: "Print the shell completion script, e.g: source <(atlassian completion bash)",
+		choices:     completionShells,
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "shell"); err != nil {
+				return err
+			}
+
+			root := a.root()
+
+			switch args[0] {
+			case "bash":
+				return bashCompletion(a.stdout, root, a.globalFlags)
+			case "zsh":
+
+				if _, err := fmt.Fprint(a.stdout, "#compdef atlassian\n\nautoload -U +X bashcompinit && bashcompinit\n\n"); err != nil {
+					return err
+				}
+
+				return bashCompletion(a.stdout, root, a.globalFlags)
+			case "fish":
+				return fishCompletion(a.stdout, root, a.globalFlags)
+			}
+
+			return fmt.Errorf("the shell %q is not supported, use one of %v", args[0], strings.Join(completionShells, ", "))
+		},
+	}
+}
+
+// completionNode is a command of the tree with the space-separated names of its parents
+type completionNode struct {
+	path    string
+	parents []string
+	command *command
+}
+
+// walk returns the commands of the tree in depth-first order, the root command included
+func walk(root *command) (nodes []*completionNode) {
+
+	var visit func(node *completionNode)
+	visit = func(node *completionNode) {
+
+		nodes = append(nodes, node)
+
+		for _, sub := range node.command.commands {
+
+			path := sub.name
+			if len(node.path) != 0 {
+				path = node.path + " " + sub.name
+			}
+
+			parents := append(append([]string{}, node.parents...), sub.name)
+			visit(&completionNode{path: path, parents: parents, command: sub})
+		}
+	}
+
+	visit(&completionNode{command: root})
+	return
+}
+
+// completionWords returns the words completed after the command, the sub-commands of the groups
+// and the positional choices and flags of the leaves.
+func completionWords(c *command, global func(fs *flag.FlagSet)) (words []string) {
+
+	for _, sub := range c.commands {
+		words = append(words, sub.name)
+	}
+
+	if c.run == nil {
+		return
+	}
+
+	words = append(words, c.choices...)
+
+	c.flagSet(global).VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > 1 {
+			words = append(words, "--"+f.Name)
+		}
+	})
+
+	return
+}
+
+func bashCompletion(w io.Writer, root *command, global func(fs *flag.FlagSet)) error {
+
+	var paths, cases strings.Builder
+
+	for _, node := range walk(root) {
+
+		if len(node.path) != 0 {
+			paths.WriteString(fmt.Sprintf("            %q) path=\"$candidate\" ;;\n", node.path))
+		}
+
+		cases.WriteString(fmt.Sprintf("        %q) words=%q ;;\n", node.path, strings.Join(completionWords(node.command, global), " ")))
+	}
+
+	_, err := fmt.Fprintf(w, `# bash completion for atlassian
+_atlassian() {
+    local cur candidate path word words
+    cur="${COMP_WORDS[COMP_CWORD]}"
+
+    case "${COMP_WORDS[COMP_CWORD-1]}" in
+        --output|-o)
+            COMPREPLY=($(compgen -W %q -- "$cur"))
+            return
+            ;;
+    esac
+
+    path=""
+    for word in "${COMP_WORDS[@]:1:COMP_CWORD-1}"; do
+        candidate="${path:+$path }$word"
+        case "$candidate" in
+%v        esac
+    done
+
+    case "$path" in
+%v    esac
+
+    COMPREPLY=($(compgen -W "$words" -- "$cur"))
+}
+
+complete -F _atlassian atlassian
+`, strings.Join(outputFormats, " "), paths.String(), cases.String())
+
+	return err
+}
+
+func fishCompletion(w io.Writer, root *command, global func(fs *flag.FlagSet)) error {
+
+	var builder strings.Builder
+
+	builder.WriteString("# fish completion for atlassian\ncomplete -c atlassian -f\n")
+	builder.WriteString(fmt.Sprintf("complete -c atlassian -l output -s o -x -a %q -d 'the output format'\n", strings.Join(outputFormats, " ")))
+	builder.WriteString("complete -c atlassian -l profile -x -d 'the profile of the config file'\n")
+	builder.WriteString("complete -c atlassian -l config -r -F -d 'the config file'\n")
+
+	for _, node := range walk(root) {
+
+		var names []string
+		for _, sub := range node.command.commands {
+			names = append(names, sub.name)
+		}
+
+		// The parents must be seen and the sub-commands not, e.g: the issue group before its get command
+		var conditions []string
+		for _, parent := range node.parents {
+			conditions = append(conditions, "__fish_seen_subcommand_from "+parent)
+		}
+
+		if node.command.run == nil {
+
+			if len(node.parents) == 0 {
+				conditions = append(conditions, "__fish_use_subcommand")
+			} else {
+				conditions = append(conditions, "not __fish_seen_subcommand_from "+strings.Join(names, " "))
+			}
+
+			for _, sub := range node.command.commands {
+				builder.WriteString(fmt.Sprintf("complete -c atlassian -n '%v' -a %v -d '%v'\n", strings.Join(conditions, "; and "), sub.name, fishEscape(sub.description)))
+			}
+
+			continue
+		}
+
+		condition := strings.Join(conditions, "; and ")
+
+		for _, choice := range node.command.choices {
+			builder.WriteString(fmt.Sprintf("complete -c atlassian -n '%v' -a %v\n", condition, choice))
+		}
+
+		node.command.flagSet(func(fs *flag.FlagSet) {}).VisitAll(func(f *flag.Flag) {
+			builder.WriteString(fmt.Sprintf("complete -c atlassian -n '%v' -l %v -d '%v'\n", condition, f.Name, fishEscape(f.Usage)))
+		})
+	}
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+func fishEscape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// defaultProfile is the profile used when the config file and the environment don't select one
+const defaultProfile = "default"
+
+// profile contains the credentials of an Atlassian site, the admin values are used by the admin commands
+type profile struct {
+	Site           string `json:"site,omitempty"`
+	Email          string `json:"email,omitempty"`
+	Token          string `json:"token,omitempty"`
+	AdminToken     string `json:"admin_token,omitempty"`
+	OrganizationID string `json:"organization_id,omitempty"`
+}
+
+// config is the content of the config file, e.g:
+//
+//	{
+//	  "default_profile": "work",
+//	  "profiles": {
+//	    "work": {"site": "https://example.atlassian.net", "email": "me@example.com", "token": "..."}
+//	  }
+//	}
+type config struct {
+	DefaultProfile string              `json:"default_profile,omitempty"`
+	Profiles       map[string]*profile `json:"profiles,omitempty"`
+}
+
+// configPath returns the location of the config file, ATLASSIAN_CONFIG overrides the user config directory
+func configPath(getenv func(string) string) (string, error) {
+
+	if path := getenv("ATLASSIAN_CONFIG"); len(path) != 0 {
+		return path, nil
+	}
+
+	directory, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(directory, "atlassian", "config.json"), nil
+}
+
+// readConfig reads the config file, the missing file returns an empty config
+func readConfig(path string) (*config, error) {
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+
+		if os.IsNotExist(err) {
+			return &config{}, nil
+		}
+
+		return nil, err
+	}
+
+	result := new(config)
+	if err = json.Unmarshal(content, result); err != nil {
+		return nil, fmt.Errorf("the config file %v is not valid, %v", path, err)
+	}
+
+	return result, nil
+}
+
+// resolveProfile returns the selected profile with the ATLASSIAN_* environment variables applied on top,
+// the name is chosen by the --profile flag, then ATLASSIAN_PROFILE and then the default_profile of the file.
+func resolveProfile(cfg *config, name string, getenv func(string) string) (*profile, error) {
+
+	var explicit = len(name) != 0
+
+	if !explicit {
+		name = getenv("ATLASSIAN_PROFILE")
+		explicit = len(name) != 0
+	}
+
+	if !explicit {
+		name = cfg.DefaultProfile
+	}
+
+	if len(name) == 0 {
+		name = defaultProfile
+	}
+
+	result := new(profile)
+
+	if stored, ok := cfg.Profiles[name]; ok && stored != nil {
+		*result = *stored
+	} else if explicit {
+		return nil, fmt.Errorf("the profile %q doesn't exist in the config file", name)
+	}
+
+	overrides := []struct {
+		variable string
+		value    *string
+	}{
+		{"ATLASSIAN_SITE", &result.Site},
+		{"ATLASSIAN_EMAIL", &result.Email},
+		{"ATLASSIAN_TOKEN", &result.Token},
+		{"ATLASSIAN_ADMIN_TOKEN", &result.AdminToken},
+		{"ATLASSIAN_ORGANIZATION_ID", &result.OrganizationID},
+	}
+
+	for _, override := range overrides {
+		if value := getenv(override.variable); len(value) != 0 {
+			*override.value = value
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveProfile(t *testing.T) {
+
+	cfg := &config{
+		DefaultProfile: "work",
+		Profiles: map[string]*profile{
+			"work":    {Site: "https://work.atlassian.net", Email: "work@example.com", Token: "work-token"},
+			"sandbox": {Site: "https://sandbox.atlassian.net", Email: "sandbox@example.com", Token: "sandbox-token", OrganizationID: "org-1"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		cfg         *config
+		profileName string
+		env         map[string]string
+		want        *profile
+		wantErr     bool
+	}{
+		{
+			name: "ResolveProfileWhenTheDefaultProfileIsUsed",
+			cfg:  cfg,
+			want: &profile{Site: "https://work.atlassian.net", Email: "work@example.com", Token: "work-token"},
+		},
+		{
+			name:        "ResolveProfileWhenTheFlagSelectsTheProfile",
+			cfg:         cfg,
+			profileName: "sandbox",
+			env:         map[string]string{"ATLASSIAN_PROFILE": "work"},
+			want:        &profile{Site: "https://sandbox.atlassian.net", Email: "sandbox@example.com", Token: "sandbox-token", OrganizationID: "org-1"},
+		},
+		{
+			name: "ResolveProfileWhenTheEnvironmentSelectsTheProfile",
+			cfg:  cfg,
+			env:  map[string]string{"ATLASSIAN_PROFILE": "sandbox", "ATLASSIAN_TOKEN": "env-token"},
+			want: &profile{Site: "https://sandbox.atlassian.net", Email: "sandbox@example.com", Token: "env-token", OrganizationID: "org-1"},
+		},
+		{
+			name: "ResolveProfileWhenTheConfigIsEmpty",
+			cfg:  &config{},
+			env: map[string]string{
+				"ATLASSIAN_SITE":            "https://env.atlassian.net",
+				"ATLASSIAN_EMAIL":           "env@example.com",
+				"ATLASSIAN_TOKEN":           "env-token",
+				"ATLASSIAN_ADMIN_TOKEN":     "admin-token",
+				"ATLASSIAN_ORGANIZATION_ID": "org-2",
+			},
+			want: &profile{Site: "https://env.atlassian.net", Email: "env@example.com", Token: "env-token", AdminToken: "admin-token", OrganizationID: "org-2"},
+		},
+		{
+			name:        "ResolveProfileWhenTheProfileDoesNotExist",
+			cfg:         cfg,
+			profileName: "production",
+			wantErr:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := resolveProfile(testCase.cfg, testCase.profileName, func(key string) string { return testCase.env[key] })
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+
+	// The stored profile isn't modified by the environment overrides
+	assert.Equal(t, "sandbox-token", cfg.Profiles["sandbox"].Token)
+}
+
+func TestReadConfig(t *testing.T) {
+
+	directory, err := ioutil.TempDir("", "atlassian")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(directory)
+
+	path := filepath.Join(directory, "config.json")
+
+	cfg, err := readConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &config{}, cfg)
+
+	content := `{"default_profile": "work", "profiles": {"work": {"site": "https://work.atlassian.net", "admin_token": "admin-token"}}}`
+	if err = ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err = readConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "work", cfg.DefaultProfile)
+	assert.Equal(t, "admin-token", cfg.Profiles["work"].AdminToken)
+
+	if err = ioutil.WriteFile(path, []byte("site: https://work.atlassian.net"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = readConfig(path)
+	assert.Error(t, err)
+
+	path, err = configPath(func(key string) string {
+		return map[string]string{"ATLASSIAN_CONFIG": "/etc/atlassian.json"}[key]
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "/etc/atlassian.json", path)
+}
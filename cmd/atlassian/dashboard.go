@@ -0,0 +1,351 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"strconv"
+	"strings"
+)
+
+func (a *app) dashboardCommand() *command {
+
+	return &command{
+		name:        "dashboard",
+		description: "List, get, create, update, copy and delete the Jira dashboards.",
+		commands: []*command{
+			a.dashboardListCommand(),
+			a.dashboardGetCommand(),
+			a.dashboardCreateCommand(),
+			a.dashboardUpdateCommand(),
+			a.dashboardCopyCommand(),
+			a.dashboardDeleteCommand(),
+		},
+	}
+}
+
+func (a *app) dashboardListCommand() *command {
+
+	var (
+		filter              string
+		startAt, maxResults int
+	)
+
+	return &command{
+		name:        "list",
+		usage:       "[flags]",
+		description: "List the dashboards owned by or shared with the user.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&filter, "filter", "", "list only the my or favourite dashboards")
+			fs.IntVar(&startAt, "start", 0, "the index of the first dashboard")
+			fs.IntVar(&maxResults, "max", 50, "the maximum number of dashboards")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			page, response, err := client.Dashboard.Gets(ctx, startAt, maxResults, filter)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			t := &table{header: []string{"id", "name", "favourite", "view"}}
+			for _, dashboard := range page.Dashboards {
+				t.add(dashboard.ID, dashboard.Name, strconv.FormatBool(dashboard.IsFavourite), dashboard.View)
+			}
+
+			return a.print(page.Dashboards, t)
+		},
+	}
+}
+
+func (a *app) dashboardGetCommand() *command {
+
+	return &command{
+		name:        "get",
+		usage:       "<dashboard-id>",
+		description: "Print a dashboard.",
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "dashboard-id"); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			dashboard, response, err := client.Dashboard.Get(ctx, args[0])
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			return a.print(dashboard, dashboardDetails(dashboard))
+		},
+	}
+}
+
+// dashboardFlags contains the flags used to create, update and copy the dashboards
+type dashboardFlags struct {
+	name, description string
+	shares            stringsFlag
+}
+
+func (d *dashboardFlags) register(fs *flag.FlagSet, action string) {
+	fs.StringVar(&d.name, "name", "", "the name of the "+action+" dashboard")
+	fs.StringVar(&d.description, "description", "", "the description of the "+action+" dashboard")
+	fs.Var(&d.shares, "share", "a share permission: authenticated, global, project:<id> or group:<name>, it can be repeated")
+}
+
+func (d *dashboardFlags) permissions() (*[]jira.SharePermissionScheme, error) {
+	return sharePermissions(d.shares)
+}
+
+func (a *app) dashboardCreateCommand() *command {
+
+	var values dashboardFlags
+
+	return &command{
+		name:        "create",
+		usage:       "--name <name> [flags]",
+		description: "Create a dashboard, it's shared with the authenticated users when the share permissions aren't provided.",
+		flags: func(fs *flag.FlagSet) {
+			values.register(fs, "new")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			if len(values.name) == 0 {
+				return fmt.Errorf("the --name flag is required")
+			}
+
+			if len(values.shares) == 0 {
+				values.shares = stringsFlag{"authenticated"}
+			}
+
+			permissions, err := values.permissions()
+			if err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			dashboard, response, err := client.Dashboard.Create(ctx, values.name, values.description, permissions)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			return a.print(dashboard, dashboardDetails(dashboard))
+		},
+	}
+}
+
+func (a *app) dashboardUpdateCommand() *command {
+
+	var values dashboardFlags
+
+	return &command{
+		name:        "update",
+		usage:       "<dashboard-id> [flags]",
+		description: "Update a dashboard, the current name and share permissions are kept when the flags aren't provided.",
+		flags: func(fs *flag.FlagSet) {
+			values.register(fs, "updated")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "dashboard-id"); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			name, permissions, err := dashboardDefaults(ctx, client, args[0], &values)
+			if err != nil {
+				return err
+			}
+
+			dashboard, response, err := client.Dashboard.Update(ctx, args[0], name, values.description, permissions)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			return a.print(dashboard, dashboardDetails(dashboard))
+		},
+	}
+}
+
+func (a *app) dashboardCopyCommand() *command {
+
+	var values dashboardFlags
+
+	return &command{
+		name:        "copy",
+		usage:       "<dashboard-id> [flags]",
+		description: "Copy a dashboard, the copy uses the name and share permissions of the dashboard when the flags aren't provided.",
+		flags: func(fs *flag.FlagSet) {
+			values.register(fs, "copied")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "dashboard-id"); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			name, permissions, err := dashboardDefaults(ctx, client, args[0], &values)
+			if err != nil {
+				return err
+			}
+
+			dashboard, response, err := client.Dashboard.Copy(ctx, args[0], name, values.description, permissions)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			return a.print(dashboard, dashboardDetails(dashboard))
+		},
+	}
+}
+
+func (a *app) dashboardDeleteCommand() *command {
+
+	return &command{
+		name:        "delete",
+		usage:       "<dashboard-id>",
+		description: "Delete a dashboard.",
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "dashboard-id"); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			if response, err := client.Dashboard.Delete(ctx, args[0]); err != nil {
+				return apiError(err, response)
+			}
+
+			return a.message("The dashboard %v was deleted", args[0])
+		},
+	}
+}
+
+// dashboardDefaults returns the name and the share permissions of the flags, the values of the
+// current dashboard are used when the flags aren't provided.
+func dashboardDefaults(ctx context.Context, client *jira.Client, dashboardID string, values *dashboardFlags) (string, *[]jira.SharePermissionScheme, error) {
+
+	if len(values.name) != 0 && len(values.shares) != 0 {
+
+		permissions, err := values.permissions()
+		return values.name, permissions, err
+	}
+
+	current, response, err := client.Dashboard.Get(ctx, dashboardID)
+	if err != nil {
+		return "", nil, apiError(err, response)
+	}
+
+	name := values.name
+	if len(name) == 0 {
+		name = current.Name
+	}
+
+	if len(values.shares) != 0 {
+
+		permissions, err := values.permissions()
+		return name, permissions, err
+	}
+
+	var permissions []jira.SharePermissionScheme
+	for _, permission := range current.SharePermissions {
+		permissions = append(permissions, jira.SharePermissionScheme{
+			Type:    permission.Type,
+			Project: permission.Project,
+			Role:    permission.Role,
+			Group:   permission.Group,
+		})
+	}
+
+	if len(permissions) == 0 {
+		permissions = append(permissions, jira.SharePermissionScheme{Type: "authenticated"})
+	}
+
+	return name, &permissions, nil
+}
+
+// sharePermissions parses the share permission flags, e.g: authenticated, project:10000 or group:jira-users
+func sharePermissions(values []string) (*[]jira.SharePermissionScheme, error) {
+
+	var permissions []jira.SharePermissionScheme
+
+	for _, value := range values {
+
+		index := strings.Index(value, ":")
+		if index == -1 {
+			permissions = append(permissions, jira.SharePermissionScheme{Type: value})
+			continue
+		}
+
+		kind, target := value[:index], value[index+1:]
+
+		switch kind {
+		case "project":
+			permissions = append(permissions, jira.SharePermissionScheme{Type: kind, Project: &jira.ProjectScheme{ID: target}})
+		case "group":
+			permissions = append(permissions, jira.SharePermissionScheme{Type: kind, Group: &jira.GroupScheme{Name: target}})
+		default:
+			return nil, fmt.Errorf("the share permission %q is not valid, use authenticated, global, project:<id> or group:<name>", value)
+		}
+	}
+
+	return &permissions, nil
+}
+
+func dashboardDetails(dashboard *jira.DashboardScheme) *table {
+
+	var shares []interface{}
+	for _, permission := range dashboard.SharePermissions {
+
+		switch {
+		case permission.Project != nil:
+			shares = append(shares, permission.Type+":"+permission.Project.Key)
+		case permission.Group != nil:
+			shares = append(shares, permission.Type+":"+permission.Group.Name)
+		default:
+			shares = append(shares, permission.Type)
+		}
+	}
+
+	return details(
+		"id", dashboard.ID,
+		"name", dashboard.Name,
+		"favourite", strconv.FormatBool(dashboard.IsFavourite),
+		"shared with", displayValue(shares),
+		"view", dashboard.View,
+	)
+}
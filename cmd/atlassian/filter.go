@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"strconv"
+)
+
+func (a *app) filterCommand() *command {
+
+	return &command{
+		name:        "filter",
+		description: "List, get, create, update and delete the Jira filters.",
+		commands: []*command{
+			a.filterListCommand(),
+			a.filterGetCommand(),
+			a.filterCreateCommand(),
+			a.filterUpdateCommand(),
+			a.filterDeleteCommand(),
+		},
+	}
+}
+
+func (a *app) filterListCommand() *command {
+
+	var (
+		name                string
+		mine, favorite      bool
+		startAt, maxResults int
+	)
+
+	return &command{
+		name:        "list",
+		usage:       "[flags]",
+		description: "Search the filters, or list the filters owned or favorited by the user.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&name, "name", "", "filter the filters by the name")
+			fs.BoolVar(&mine, "mine", false, "list the filters owned by the user")
+			fs.BoolVar(&favorite, "favorite", false, "list the favorite filters of the user")
+			fs.IntVar(&startAt, "start", 0, "the index of the first filter")
+			fs.IntVar(&maxResults, "max", 50, "the maximum number of filters")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			var filters []*jira.FilterScheme
+
+			switch {
+
+			case mine || favorite:
+
+				var (
+					result   *[]jira.FilterScheme
+					response *jira.Response
+				)
+
+				if mine {
+					result, response, err = client.Filter.My(ctx, favorite, nil)
+				} else {
+					result, response, err = client.Filter.Favorite(ctx)
+				}
+
+				if err != nil {
+					return apiError(err, response)
+				}
+
+				for index := range *result {
+					filters = append(filters, &(*result)[index])
+				}
+
+			default:
+
+				options := &jira.FilterSearchOptionScheme{Name: name, Expand: []string{"owner", "jql"}}
+
+				page, response, err := client.Filter.Search(ctx, options, startAt, maxResults)
+				if err != nil {
+					return apiError(err, response)
+				}
+
+				for _, node := range page.Values {
+					filters = append(filters, &jira.FilterScheme{
+						Self:      node.Self,
+						ID:        node.ID,
+						Name:      node.Name,
+						Owner:     node.Owner,
+						Jql:       node.Jql,
+						ViewURL:   node.ViewURL,
+						SearchURL: node.SearchURL,
+						Favourite: node.Favourite,
+					})
+				}
+			}
+
+			if filters == nil {
+				filters = []*jira.FilterScheme{}
+			}
+
+			return a.print(filters, filterTable(filters...))
+		},
+	}
+}
+
+func (a *app) filterGetCommand() *command {
+
+	return &command{
+		name:        "get",
+		usage:       "<filter-id>",
+		description: "Print a filter.",
+		run: func(ctx context.Context, args []string) error {
+
+			filterID, err := filterIDArgument(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			filter, response, err := client.Filter.Get(ctx, filterID, nil)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			return a.print(filter, filterDetails(filter))
+		},
+	}
+}
+
+func (a *app) filterCreateCommand() *command {
+
+	var payload jira.FilterBodyScheme
+
+	return &command{
+		name:        "create",
+		usage:       "--name <name> --jql <jql> [flags]",
+		description: "Create a filter.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&payload.Name, "name", "", "the name of the filter")
+			fs.StringVar(&payload.JQL, "jql", "", "the JQL query of the filter")
+			fs.StringVar(&payload.Description, "description", "", "the description of the filter")
+			fs.BoolVar(&payload.Favorite, "favorite", false, "add the filter to the favorite filters of the user")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			if len(payload.Name) == 0 || len(payload.JQL) == 0 {
+				return fmt.Errorf("the --name and --jql flags are required")
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			filter, response, err := client.Filter.Create(ctx, &payload)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			return a.print(filter, filterDetails(filter))
+		},
+	}
+}
+
+func (a *app) filterUpdateCommand() *command {
+
+	var name, jql, description string
+
+	return &command{
+		name:        "update",
+		usage:       "<filter-id> [flags]",
+		description: "Update the name, the JQL query or the description of a filter, only the provided flags are changed.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&name, "name", "", "the new name of the filter")
+			fs.StringVar(&jql, "jql", "", "the new JQL query of the filter")
+			fs.StringVar(&description, "description", "", "the new description of the filter")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			filterID, err := filterIDArgument(args)
+			if err != nil {
+				return err
+			}
+
+			if len(name) == 0 && len(jql) == 0 && len(description) == 0 {
+				return fmt.Errorf("nothing to update, please provide the --name, --jql or --description flags")
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			// Jira requires the name of the filter, the current values are kept when the flags aren't provided
+			current, response, err := client.Filter.Get(ctx, filterID, nil)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			payload := &jira.FilterBodyScheme{Name: current.Name, JQL: current.Jql}
+
+			if len(name) != 0 {
+				payload.Name = name
+			}
+
+			if len(jql) != 0 {
+				payload.JQL = jql
+			}
+
+			if len(description) != 0 {
+				payload.Description = description
+			}
+
+			filter, response, err := client.Filter.Update(ctx, filterID, payload)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			return a.print(filter, filterDetails(filter))
+		},
+	}
+}
+
+func (a *app) filterDeleteCommand() *command {
+
+	return &command{
+		name:        "delete",
+		usage:       "<filter-id>",
+		description: "Delete a filter.",
+		run: func(ctx context.Context, args []string) error {
+
+			filterID, err := filterIDArgument(args)
+			if err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			if response, err := client.Filter.Delete(ctx, filterID); err != nil {
+				return apiError(err, response)
+			}
+
+			return a.message("The filter %v was deleted", filterID)
+		},
+	}
+}
+
+func filterIDArgument(args []string) (int, error) {
+
+	if err := requireArgs(args, "filter-id"); err != nil {
+		return 0, err
+	}
+
+	filterID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, fmt.Errorf("the filter ID %q is not a number", args[0])
+	}
+
+	return filterID, nil
+}
+
+func filterTable(filters ...*jira.FilterScheme) *table {
+
+	t := &table{header: []string{"id", "name", "owner", "favourite", "jql"}}
+	for _, filter := range filters {
+		t.add(filter.ID, filter.Name, userName(filter.Owner), strconv.FormatBool(filter.Favourite), filter.Jql)
+	}
+
+	return t
+}
+
+func filterDetails(filter *jira.FilterScheme) *table {
+
+	return details(
+		"id", filter.ID,
+		"name", filter.Name,
+		"owner", userName(filter.Owner),
+		"favourite", strconv.FormatBool(filter.Favourite),
+		"jql", filter.Jql,
+		"view", filter.ViewURL,
+	)
+}
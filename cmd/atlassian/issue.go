@@ -0,0 +1,456 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"io/ioutil"
+	"strings"
+)
+
+// defaultIssueFields are the fields printed by the issue get command when the fields are not provided
+var defaultIssueFields = []string{"summary", "status", "issuetype", "priority", "assignee", "reporter", "created", "updated"}
+
+func (a *app) issueCommand() *command {
+
+	return &command{
+		name:        "issue",
+		description: "Get, create, edit, transition and comment the Jira issues.",
+		commands: []*command{
+			a.issueGetCommand(),
+			a.issueCreateCommand(),
+			a.issueEditCommand(),
+			a.issueTransitionCommand(),
+			a.issueCommentCommand(),
+		},
+	}
+}
+
+func (a *app) issueGetCommand() *command {
+
+	var fields, expand string
+
+	return &command{
+		name:        "get",
+		usage:       "<issue-key> [flags]",
+		description: "Print an issue, the JSON output contains the issue returned by Jira.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&fields, "fields", "", "comma-separated fields to print, e.g: summary,status,customfield_10010")
+			fs.StringVar(&expand, "expand", "", "comma-separated expand values, e.g: renderedFields,changelog")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "issue-key"); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			_, response, err := client.Issue.Get(ctx, args[0], splitList(fields), splitList(expand))
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			issue := make(map[string]interface{})
+			if err = json.Unmarshal(response.BodyAsBytes, &issue); err != nil {
+				return err
+			}
+
+			names := splitList(fields)
+			if len(names) == 0 {
+				names = defaultIssueFields
+			}
+
+			pairs := []string{"key", displayValue(issue["key"])}
+			for _, name := range names {
+				pairs = append(pairs, name, issueField(issue, name))
+			}
+
+			return a.print(issue, details(pairs...))
+		},
+	}
+}
+
+// issueFieldsFlags contains the flags used to set the fields of the created and edited issues
+type issueFieldsFlags struct {
+	summary, description string
+	priority, labels     string
+	assignee, parent     string
+	fields               stringsFlag
+}
+
+func (i *issueFieldsFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&i.summary, "summary", "", "the summary of the issue")
+	fs.StringVar(&i.description, "description", "", "the description of the issue, the lines are converted to paragraphs")
+	fs.StringVar(&i.priority, "priority", "", "the priority name, e.g: High")
+	fs.StringVar(&i.labels, "labels", "", "comma-separated labels")
+	fs.StringVar(&i.assignee, "assignee", "", "the account ID of the assignee")
+	fs.StringVar(&i.parent, "parent", "", "the key of the parent issue")
+	fs.Var(&i.fields, "field", "a field as name=value, the value is decoded as JSON when it's valid JSON, it can be repeated")
+}
+
+// apply sets the flags on the issue fields and returns the fields that aren't mapped by the library schemes
+func (i *issueFieldsFlags) apply(fields *jira.IssueFieldsScheme) (extra map[string]interface{}, err error) {
+
+	fields.Summary = i.summary
+	fields.Labels = splitList(i.labels)
+
+	if len(i.priority) != 0 {
+		fields.Priority = &jira.PriorityScheme{Name: i.priority}
+	}
+
+	extra = make(map[string]interface{})
+
+	if len(i.description) != 0 {
+		extra["description"] = textDocument(i.description)
+	}
+
+	if len(i.assignee) != 0 {
+		extra["assignee"] = map[string]string{"id": i.assignee}
+	}
+
+	if len(i.parent) != 0 {
+		extra["parent"] = map[string]string{"key": i.parent}
+	}
+
+	custom, err := parseFieldValues(i.fields)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range custom {
+		extra[name] = value
+	}
+
+	return extra, nil
+}
+
+func (a *app) issueCreateCommand() *command {
+
+	var (
+		project, issueType string
+		values             issueFieldsFlags
+	)
+
+	return &command{
+		name:        "create",
+		usage:       "--project <key> --summary <text> [flags]",
+		description: "Create an issue and print its key.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&project, "project", "", "the project key")
+			fs.StringVar(&issueType, "type", "Task", "the issue type name")
+			values.register(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			if len(project) == 0 || len(values.summary) == 0 {
+				return fmt.Errorf("the --project and --summary flags are required")
+			}
+
+			payload := &jira.IssueScheme{
+				Fields: &jira.IssueFieldsScheme{
+					Project:   &jira.ProjectScheme{Key: project},
+					IssueType: &jira.IssueTypeScheme{Name: issueType},
+				},
+			}
+
+			extra, err := values.apply(payload.Fields)
+			if err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			result, response, err := client.Issue.Create(ctx, payload, customFields(extra))
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			t := &table{header: []string{"key", "id", "self"}}
+			t.add(result.Key, result.ID, result.Self)
+
+			return a.print(result, t)
+		},
+	}
+}
+
+func (a *app) issueEditCommand() *command {
+
+	var (
+		notify bool
+		values issueFieldsFlags
+	)
+
+	return &command{
+		name:        "edit",
+		usage:       "<issue-key> [flags]",
+		description: "Edit the fields of an issue, only the provided flags are changed.",
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&notify, "notify", true, "send the notification emails of the change")
+			values.register(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "issue-key"); err != nil {
+				return err
+			}
+
+			payload := &jira.IssueScheme{Fields: &jira.IssueFieldsScheme{}}
+
+			extra, err := values.apply(payload.Fields)
+			if err != nil {
+				return err
+			}
+
+			if len(extra) == 0 && len(payload.Fields.Summary) == 0 && len(payload.Fields.Labels) == 0 && payload.Fields.Priority == nil {
+				return fmt.Errorf("nothing to edit, please provide the fields to change")
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			response, err := client.Issue.Update(ctx, args[0], notify, payload, customFields(extra), nil)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			return a.message("The issue %v was updated", args[0])
+		},
+	}
+}
+
+func (a *app) issueTransitionCommand() *command {
+
+	return &command{
+		name:        "transition",
+		usage:       "<issue-key> [transition]",
+		description: "Move an issue with the transition name or ID, the available transitions are printed when it's not provided.",
+		run: func(ctx context.Context, args []string) error {
+
+			if len(args) == 0 || len(args) > 2 {
+				return requireArgs(args, "issue-key", "transition")
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			transitions, response, err := client.Issue.Transitions(ctx, args[0])
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			if len(args) == 1 {
+
+				t := &table{header: []string{"id", "name", "to"}}
+				for _, transition := range transitions.Transitions {
+
+					var to string
+					if transition.To != nil {
+						to = transition.To.Name
+					}
+
+					t.add(transition.ID, transition.Name, to)
+				}
+
+				return a.print(transitions.Transitions, t)
+			}
+
+			var names []string
+			for _, transition := range transitions.Transitions {
+
+				if transition.ID == args[1] || strings.EqualFold(transition.Name, args[1]) {
+
+					if response, err = client.Issue.Move(ctx, args[0], transition.ID); err != nil {
+						return apiError(err, response)
+					}
+
+					return a.message("The issue %v was moved with the %v transition", args[0], transition.Name)
+				}
+
+				names = append(names, transition.Name)
+			}
+
+			return fmt.Errorf("the transition %q is not available for %v, the available transitions are: %v", args[1], args[0], strings.Join(names, ", "))
+		},
+	}
+}
+
+func (a *app) issueCommentCommand() *command {
+
+	var maxResults int
+
+	return &command{
+		name:        "comment",
+		usage:       "<issue-key> [text|-]",
+		description: "Add a comment to an issue, the \"-\" text is read from the standard input. The comments are printed when the text is not provided.",
+		flags: func(fs *flag.FlagSet) {
+			fs.IntVar(&maxResults, "max", 50, "the maximum number of comments printed")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if len(args) == 0 || len(args) > 2 {
+				return requireArgs(args, "issue-key", "text")
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+
+				page, response, err := client.Issue.Comment.Gets(ctx, args[0], "created", nil, 0, maxResults)
+				if err != nil {
+					return apiError(err, response)
+				}
+
+				t := &table{header: []string{"id", "author", "created", "body"}}
+				for _, comment := range page.Comments {
+					t.add(comment.ID, userName(comment.Author), comment.Created, commentText(comment))
+				}
+
+				return a.print(page.Comments, t)
+			}
+
+			text := args[1]
+			if text == "-" {
+
+				content, err := ioutil.ReadAll(a.stdin)
+				if err != nil {
+					return err
+				}
+
+				text = string(content)
+			}
+
+			if len(strings.TrimSpace(text)) == 0 {
+				return fmt.Errorf("the comment text is empty")
+			}
+
+			payload := &jira.CommentPayloadScheme{Body: textDocument(text)}
+
+			comment, response, err := client.Issue.Comment.Add(ctx, args[0], payload, nil)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			t := &table{header: []string{"id", "author", "created"}}
+			t.add(comment.ID, userName(comment.Author), comment.Created)
+
+			return a.print(comment, t)
+		},
+	}
+}
+
+// issueField returns the readable value of an issue field, the key field is read from the issue
+func issueField(issue map[string]interface{}, name string) string {
+
+	if name == "key" || name == "id" {
+		return displayValue(issue[name])
+	}
+
+	fields, _ := issue["fields"].(map[string]interface{})
+	return displayValue(fields[name])
+}
+
+// textDocument converts a plain text into an Atlassian Document Format document, a paragraph per line
+func textDocument(text string) *jira.CommentNodeScheme {
+
+	document := &jira.CommentNodeScheme{Version: 1, Type: "doc"}
+
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+
+		paragraph := &jira.CommentNodeScheme{Type: "paragraph"}
+
+		if line = strings.TrimRight(line, "\r"); len(line) != 0 {
+			paragraph.Content = []*jira.CommentNodeScheme{{Type: "text", Text: line}}
+		}
+
+		document.Content = append(document.Content, paragraph)
+	}
+
+	return document
+}
+
+// parseFieldValues parses the name=value flags, the values are decoded as JSON when they're valid JSON
+func parseFieldValues(values []string) (map[string]interface{}, error) {
+
+	fields := make(map[string]interface{})
+
+	for _, value := range values {
+
+		index := strings.Index(value, "=")
+		if index <= 0 {
+			return nil, fmt.Errorf("the field %q is not valid, use the name=value format", value)
+		}
+
+		name, raw := value[:index], value[index+1:]
+
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+			decoded = raw
+		}
+
+		fields[name] = decoded
+	}
+
+	return fields, nil
+}
+
+// customFields wraps the extra fields for the issue create and edit methods
+func customFields(extra map[string]interface{}) *jira.CustomFields {
+
+	if len(extra) == 0 {
+		return nil
+	}
+
+	return &jira.CustomFields{Fields: []map[string]interface{}{{"fields": extra}}}
+}
+
+func userName(user *jira.UserScheme) string {
+
+	if user == nil {
+		return ""
+	}
+
+	if len(user.DisplayName) != 0 {
+		return user.DisplayName
+	}
+
+	return user.AccountID
+}
+
+func commentText(comment *jira.IssueCommentScheme) string {
+
+	var blocks []string
+
+	for _, block := range comment.Body.Content {
+
+		var builder strings.Builder
+		for _, node := range block.Content {
+			builder.WriteString(node.Text)
+		}
+
+		if builder.Len() != 0 {
+			blocks = append(blocks, builder.String())
+		}
+	}
+
+	return strings.Join(blocks, " ")
+}
@@ -0,0 +1,336 @@
+// Command atlassian is a command-line tool built on the go-atlassian library, it manages the Jira issues,
+// projects, filters and dashboards, the Jira Service Management requests and the organization users and events.
+//
+// The credentials are read from the profiles of the config file and the ATLASSIAN_* environment variables,
+// run "atlassian --help" to list the commands.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/admin"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+const userAgent = "go-atlassian-cli"
+
+func main() {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	a := &app{
+		stdin:  os.Stdin,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		getenv: os.Getenv,
+		http:   http.DefaultClient,
+	}
+
+	os.Exit(a.run(ctx, os.Args[1:]))
+}
+
+// app contains the state of a CLI execution
+type app struct {
+	stdin          io.Reader
+	stdout, stderr io.Writer
+	getenv         func(string) string
+	http           *http.Client
+
+	// adminSite replaces the Atlassian Admin API endpoint, it's used by the tests
+	adminSite string
+
+	configFile  string
+	profileName string
+	output      string
+	profile     *profile
+}
+
+func (a *app) globalFlags(fs *flag.FlagSet) {
+	fs.StringVar(&a.configFile, "config", "", "the config file, defaults to ATLASSIAN_CONFIG or the user config directory")
+	fs.StringVar(&a.profileName, "profile", "", "the profile of the config file, defaults to ATLASSIAN_PROFILE")
+	fs.StringVar(&a.output, "output", tableOutput, "the output format: "+strings.Join(outputFormats, ", "))
+	fs.StringVar(&a.output, "o", tableOutput, "shorthand for --output")
+}
+
+func (a *app) root() *command {
+
+	return &command{
+		name:        "atlassian",
+		description: "Manage the Atlassian Cloud products from the command line.",
+		commands: []*command{
+			a.issueCommand(),
+			a.searchCommand(),
+			a.projectCommand(),
+			a.filterCommand(),
+			a.dashboardCommand(),
+			a.requestCommand(),
+			a.adminCommand(),
+			a.completionCommand(),
+		},
+	}
+}
+
+// run executes the command of the arguments and returns the exit code
+func (a *app) run(ctx context.Context, args []string) int {
+
+	var (
+		current = a.root()
+		path    = current.name
+		leading []string
+	)
+
+	// The global flags can be placed before the command names, e.g: atlassian -o json issue get KP-1
+	for current.run == nil {
+
+		for len(args) != 0 && strings.HasPrefix(args[0], "-") && !isHelp(args[0]) {
+
+			leading = append(leading, args[0])
+			args = args[1:]
+
+			if len(args) != 0 && !strings.Contains(leading[len(leading)-1], "=") && !strings.HasPrefix(args[0], "-") {
+				leading = append(leading, args[0])
+				args = args[1:]
+			}
+		}
+
+		if len(args) == 0 || isHelp(args[0]) {
+
+			current.printUsage(a.stderr, path)
+
+			if len(args) == 0 {
+				return 2
+			}
+
+			return 0
+		}
+
+		next := current.find(args[0])
+		if next == nil {
+			_, _ = fmt.Fprintf(a.stderr, "error: unknown command %q for %q\n\n", args[0], path)
+			current.printUsage(a.stderr, path)
+			return 2
+		}
+
+		current, path, args = next, path+" "+next.name, args[1:]
+	}
+
+	fs := flag.NewFlagSet(path, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() {
+		current.printUsage(a.stderr, path)
+		_, _ = fmt.Fprintln(a.stderr, "\nFlags:")
+		fs.PrintDefaults()
+	}
+
+	a.globalFlags(fs)
+
+	if current.flags != nil {
+		current.flags(fs)
+	}
+
+	positional, err := parseFlags(fs, append(leading, args...))
+	if err != nil {
+
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return 2
+	}
+
+	if err = validOutput(a.output); err != nil {
+		_, _ = fmt.Fprintf(a.stderr, "error: %v\n", err)
+		return 2
+	}
+
+	if err = current.run(ctx, positional); err != nil {
+		_, _ = fmt.Fprintf(a.stderr, "error: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func isHelp(arg string) bool {
+	return arg == "-h" || arg == "-help" || arg == "--help" || arg == "help"
+}
+
+// loadProfile resolves the profile of the execution once
+func (a *app) loadProfile() (*profile, error) {
+
+	if a.profile != nil {
+		return a.profile, nil
+	}
+
+	path := a.configFile
+	if len(path) == 0 {
+
+		var err error
+		if path, err = configPath(a.getenv); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg, err := readConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.profile, err = resolveProfile(cfg, a.profileName, a.getenv); err != nil {
+		return nil, err
+	}
+
+	return a.profile, nil
+}
+
+// siteProfile returns the profile when it contains the site credentials
+func (a *app) siteProfile() (*profile, error) {
+
+	current, err := a.loadProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(current.Site) == 0 || len(current.Email) == 0 || len(current.Token) == 0 {
+		return nil, fmt.Errorf("the site, email and token are required, set them on the profile or use the ATLASSIAN_SITE, ATLASSIAN_EMAIL and ATLASSIAN_TOKEN variables")
+	}
+
+	return current, nil
+}
+
+func (a *app) jira() (*jira.Client, error) {
+
+	current, err := a.siteProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := jira.New(a.http, current.Site)
+	if err != nil {
+		return nil, err
+	}
+
+	client.Auth.SetBasicAuth(current.Email, current.Token)
+	client.Auth.SetUserAgent(userAgent)
+
+	return client, nil
+}
+
+func (a *app) sm() (*sm.Client, error) {
+
+	current, err := a.siteProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := sm.New(a.http, current.Site)
+	if err != nil {
+		return nil, err
+	}
+
+	client.Auth.SetBasicAuth(current.Email, current.Token)
+	client.Auth.SetUserAgent(userAgent)
+
+	return client, nil
+}
+
+// admin returns the Atlassian Admin client and the organization ID, the flag value overrides the profile value
+func (a *app) admin(organizationID string) (*admin.Client, string, error) {
+
+	current, err := a.loadProfile()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(current.AdminToken) == 0 {
+		return nil, "", fmt.Errorf("the admin token is required, set the admin_token of the profile or the ATLASSIAN_ADMIN_TOKEN variable")
+	}
+
+	if len(organizationID) == 0 {
+		organizationID = current.OrganizationID
+	}
+
+	if len(organizationID) == 0 {
+		return nil, "", fmt.Errorf("the organization ID is required, use the --org flag, the organization_id of the profile or the ATLASSIAN_ORGANIZATION_ID variable")
+	}
+
+	client, err := admin.New(a.http)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(a.adminSite) != 0 {
+
+		if client.Site, err = url.Parse(a.adminSite); err != nil {
+			return nil, "", err
+		}
+	}
+
+	client.Auth.SetBearerToken(current.AdminToken)
+	client.Auth.SetUserAgent(userAgent)
+
+	return client, organizationID, nil
+}
+
+// print renders the value and the table with the output format of the execution
+func (a *app) print(value interface{}, t *table) error {
+	return render(a.stdout, a.output, value, t)
+}
+
+// message prints the confirmation of a command, the JSON output wraps it in an object
+func (a *app) message(format string, args ...interface{}) error {
+
+	text := fmt.Sprintf(format, args...)
+
+	if a.output == jsonOutput {
+		return render(a.stdout, jsonOutput, map[string]string{"message": text}, nil)
+	}
+
+	_, err := fmt.Fprintln(a.stdout, text)
+	return err
+}
+
+// apiError adds the body of the failed response to the error, e.g: the Jira validation messages
+func apiError(err error, response interface{}) error {
+
+	var body []byte
+
+	switch typed := response.(type) {
+	case *jira.Response:
+		if typed != nil {
+			body = typed.BodyAsBytes
+		}
+	case *sm.Response:
+		if typed != nil {
+			body = typed.BodyAsBytes
+		}
+	case *admin.Response:
+		if typed != nil {
+			body = typed.BodyAsBytes
+		}
+	}
+
+	if len(body) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%v\n%v", err, strings.TrimSpace(string(body)))
+}
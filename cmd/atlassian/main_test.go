@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// startMockApp returns an app configured with the environment variables of the mock server
+func startMockApp(mux *http.ServeMux) (*app, *bytes.Buffer, *bytes.Buffer, func()) {
+
+	mockServer := httptest.NewServer(mux)
+
+	env := map[string]string{
+		"ATLASSIAN_CONFIG":          filepath.Join("testdata", "missing.json"),
+		"ATLASSIAN_SITE":            mockServer.URL,
+		"ATLASSIAN_EMAIL":           "example@example.com",
+		"ATLASSIAN_TOKEN":           "token",
+		"ATLASSIAN_ADMIN_TOKEN":     "admin-token",
+		"ATLASSIAN_ORGANIZATION_ID": "org-1",
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	mockApp := &app{
+		stdin:     strings.NewReader(""),
+		stdout:    &stdout,
+		stderr:    &stderr,
+		getenv:    func(key string) string { return env[key] },
+		http:      mockServer.Client(),
+		adminSite: mockServer.URL,
+	}
+
+	return mockApp, &stdout, &stderr, mockServer.Close
+}
+
+func TestApp_Run(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantErr  string
+	}{
+		{
+			name:     "RunWhenTheCommandIsNotProvided",
+			args:     nil,
+			wantCode: 2,
+			wantErr:  "Usage:",
+		},
+		{
+			name:     "RunWhenTheHelpIsRequested",
+			args:     []string{"issue", "--help"},
+			wantCode: 0,
+			wantErr:  "transition",
+		},
+		{
+			name:     "RunWhenTheCommandIsUnknown",
+			args:     []string{"sprint", "list"},
+			wantCode: 2,
+			wantErr:  `unknown command "sprint"`,
+		},
+		{
+			name:     "RunWhenTheOutputIsNotValid",
+			args:     []string{"-o", "yaml", "issue", "get", "KP-1"},
+			wantCode: 2,
+			wantErr:  "yaml",
+		},
+		{
+			name:     "RunWhenTheArgumentsAreMissing",
+			args:     []string{"issue", "get"},
+			wantCode: 1,
+			wantErr:  "error:",
+		},
+		{
+			name:     "RunWhenTheProfileDoesNotExist",
+			args:     []string{"--profile", "production", "issue", "get", "KP-1"},
+			wantCode: 1,
+			wantErr:  "production",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			mockApp, _, stderr, closeServer := startMockApp(http.NewServeMux())
+			defer closeServer()
+
+			assert.Equal(t, testCase.wantCode, mockApp.run(context.Background(), testCase.args))
+			assert.Contains(t, stderr.String(), testCase.wantErr)
+		})
+	}
+}
+
+func TestIssueCommands(t *testing.T) {
+
+	var (
+		created    map[string]interface{}
+		transition map[string]interface{}
+		comment    map[string]interface{}
+	)
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/api/3/issue/KP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "summary,status", r.URL.Query().Get("fields"))
+		_, _ = w.Write([]byte(`{"id": "10001", "key": "KP-1", "fields": {"summary": "Create the CLI", "status": {"name": "In Progress"}}}`))
+	})
+
+	mux.HandleFunc("/rest/api/3/issue", func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "10002", "key": "KP-2", "self": "https://ctreminiom.atlassian.net/rest/api/3/issue/10002"}`))
+	})
+
+	mux.HandleFunc("/rest/api/3/issue/KP-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method == http.MethodPost {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&transition))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"transitions": [{"id": "11", "name": "To Do", "to": {"name": "To Do"}}, {"id": "31", "name": "Done", "to": {"name": "Done"}}]}`))
+	})
+
+	mux.HandleFunc("/rest/api/3/issue/KP-1/comment", func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&comment))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "10100"}`))
+	})
+
+	mockApp, stdout, stderr, closeServer := startMockApp(mux)
+	defer closeServer()
+
+	code := mockApp.run(context.Background(), []string{"issue", "get", "KP-1", "--fields", "summary,status"})
+	if assert.Equal(t, 0, code, stderr.String()) {
+		assert.Contains(t, stdout.String(), "Create the CLI")
+		assert.Contains(t, stdout.String(), "In Progress")
+	}
+
+	stdout.Reset()
+
+	code = mockApp.run(context.Background(), []string{"issue", "create", "--project", "KP", "--summary", "Add the CLI tests",
+		"--labels", "cli,go", "--field", "customfield_10042=5", "--field", `customfield_10043={"value": "Blue"}`})
+
+	if assert.Equal(t, 0, code, stderr.String()) {
+
+		assert.Contains(t, stdout.String(), "KP-2")
+
+		// The typed fields and the custom fields are sent on the same fields object
+		fields, _ := created["fields"].(map[string]interface{})
+		assert.Equal(t, "Add the CLI tests", fields["summary"])
+		assert.Equal(t, map[string]interface{}{"key": "KP"}, fields["project"])
+		assert.Equal(t, map[string]interface{}{"name": "Task"}, fields["issuetype"])
+		assert.Equal(t, []interface{}{"cli", "go"}, fields["labels"])
+		assert.Equal(t, float64(5), fields["customfield_10042"])
+		assert.Equal(t, map[string]interface{}{"value": "Blue"}, fields["customfield_10043"])
+	}
+
+	code = mockApp.run(context.Background(), []string{"issue", "transition", "KP-1", "done"})
+	if assert.Equal(t, 0, code, stderr.String()) {
+		assert.Equal(t, map[string]interface{}{"id": "31"}, transition["transition"])
+	}
+
+	assert.Equal(t, 1, mockApp.run(context.Background(), []string{"issue", "transition", "KP-1", "Blocked"}))
+
+	mockApp.stdin = strings.NewReader("First line\nSecond line")
+
+	code = mockApp.run(context.Background(), []string{"issue", "comment", "KP-1", "-"})
+	if assert.Equal(t, 0, code, stderr.String()) {
+		body, _ := comment["body"].(map[string]interface{})
+		assert.Equal(t, "doc", body["type"])
+		assert.Len(t, body["content"], 2)
+	}
+}
+
+func TestSearchCommand(t *testing.T) {
+
+	var pages []float64
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/api/3/search", func(w http.ResponseWriter, r *http.Request) {
+
+		var payload map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "project = KP", payload["jql"])
+
+		startAt, _ := payload["startAt"].(float64)
+		pages = append(pages, startAt)
+
+		if startAt == 0 {
+			_, _ = w.Write([]byte(`{"startAt": 0, "maxResults": 1, "total": 2, "issues": [{"key": "KP-1", "fields": {"summary": "Create the CLI", "status": {"name": "Done"}}}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"startAt": 1, "maxResults": 1, "total": 2, "issues": [{"key": "KP-2", "fields": {"summary": "Add the tests, docs", "status": {"name": "To Do"}}}]}`))
+	})
+
+	mockApp, stdout, stderr, closeServer := startMockApp(mux)
+	defer closeServer()
+
+	code := mockApp.run(context.Background(), []string{"--output", "csv", "search", "project = KP", "--fields", "summary,status", "--max", "1", "--all"})
+	if assert.Equal(t, 0, code, stderr.String()) {
+		assert.Equal(t, "key,summary,status\nKP-1,Create the CLI,Done\nKP-2,\"Add the tests, docs\",To Do\n", stdout.String())
+		assert.Equal(t, []float64{0, 1}, pages)
+	}
+}
+
+func TestRequestCreateCommand(t *testing.T) {
+
+	var payload map[string]interface{}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/servicedeskapi/request", func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"issueId": "10010", "issueKey": "DESK-1", "serviceDeskId": "1", "currentStatus": {"status": "Waiting for support"}}`))
+	})
+
+	mockApp, stdout, stderr, closeServer := startMockApp(mux)
+	defer closeServer()
+
+	code := mockApp.run(context.Background(), []string{"-o", "json", "request", "create", "--service-desk", "1", "--request-type", "25",
+		"--summary", "The VPN doesn't work", "--participant", "5b86be50b8e3cb5895860d6d"})
+
+	if assert.Equal(t, 0, code, stderr.String()) {
+
+		assert.Contains(t, stdout.String(), `"issueKey": "DESK-1"`)
+
+		assert.Equal(t, "1", payload["serviceDeskId"])
+		assert.Equal(t, "25", payload["requestTypeId"])
+		assert.Equal(t, []interface{}{"5b86be50b8e3cb5895860d6d"}, payload["requestParticipants"])
+
+		values, _ := payload["requestFieldValues"].(map[string]interface{})
+		assert.Equal(t, "The VPN doesn't work", values["summary"])
+	}
+}
+
+func TestAdminUsersCommand(t *testing.T) {
+
+	var cursors []string
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/admin/v1/orgs/org-1/users", func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, "Bearer admin-token", r.Header.Get("Authorization"))
+
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		if len(cursor) == 0 {
+			_, _ = w.Write([]byte(`{"data": [{"account_id": "1", "name": "Carlos", "email": "carlos@example.com", "account_status": "active"}],
+				"links": {"next": "https://api.atlassian.com/admin/v1/orgs/org-1/users?cursor=page-2"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data": [{"account_id": "2", "name": "Ana", "email": "ana@example.com", "account_status": "inactive"}], "links": {}}`))
+	})
+
+	mockApp, stdout, stderr, closeServer := startMockApp(mux)
+	defer closeServer()
+
+	code := mockApp.run(context.Background(), []string{"admin", "users", "--all"})
+	if assert.Equal(t, 0, code, stderr.String()) {
+		assert.Equal(t, []string{"", "page-2"}, cursors)
+		assert.Contains(t, stdout.String(), "carlos@example.com")
+		assert.Contains(t, stdout.String(), "ana@example.com")
+	}
+}
+
+func TestCompletionCommand(t *testing.T) {
+
+	for shell, flag := range map[string]string{"bash": "--summary", "zsh": "--summary", "fish": "-l summary"} {
+
+		shell, flag := shell, flag
+
+		t.Run(shell, func(t *testing.T) {
+
+			mockApp, stdout, stderr, closeServer := startMockApp(http.NewServeMux())
+			defer closeServer()
+
+			if assert.Equal(t, 0, mockApp.run(context.Background(), []string{"completion", shell}), stderr.String()) {
+				assert.Contains(t, stdout.String(), "transition")
+				assert.Contains(t, stdout.String(), flag)
+			}
+		})
+	}
+
+	mockApp, _, _, closeServer := startMockApp(http.NewServeMux())
+	defer closeServer()
+
+	assert.Equal(t, 1, mockApp.run(context.Background(), []string{"completion", "powershell"}))
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	tableOutput = "table"
+	jsonOutput  = "json"
+	csvOutput   = "csv"
+)
+
+var outputFormats = []string{tableOutput, jsonOutput, csvOutput}
+
+// table is the tabular representation of a result, the JSON output uses the result value instead
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(values ...string) {
+	t.rows = append(t.rows, values)
+}
+
+// details returns a two columns table, it's used to print a single resource
+func details(pairs ...string) *table {
+
+	t := &table{header: []string{"field", "value"}}
+
+	for index := 0; index+1 < len(pairs); index += 2 {
+		t.add(pairs[index], pairs[index+1])
+	}
+
+	return t
+}
+
+func validOutput(format string) error {
+
+	for _, known := range outputFormats {
+		if format == known {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the output format %q is not supported, use one of %v", format, strings.Join(outputFormats, ", "))
+}
+
+// render writes the value as JSON or the table as an aligned table or CSV
+func render(w io.Writer, format string, value interface{}, t *table) error {
+
+	switch format {
+
+	case jsonOutput:
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+
+	case csvOutput:
+
+		writer := csv.NewWriter(w)
+
+		if err := writer.Write(t.header); err != nil {
+			return err
+		}
+
+		if err := writer.WriteAll(t.rows); err != nil {
+			return err
+		}
+
+		return writer.Error()
+
+	default:
+
+		writer := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+		header := make([]string, len(t.header))
+		for index, name := range t.header {
+			header[index] = strings.ToUpper(name)
+		}
+
+		_, _ = fmt.Fprintln(writer, strings.Join(header, "\t"))
+
+		for _, row := range t.rows {
+
+			cells := make([]string, len(row))
+			for index, cell := range row {
+				cells[index] = strings.NewReplacer("\t", " ", "\n", " ").Replace(cell)
+			}
+
+			_, _ = fmt.Fprintln(writer, strings.Join(cells, "\t"))
+		}
+
+		return writer.Flush()
+	}
+}
+
+// displayValue returns a readable value of a decoded JSON field, e.g: the display name of a user,
+// the name of a status or the text of an Atlassian Document Format document.
+func displayValue(value interface{}) string {
+
+	switch typed := value.(type) {
+
+	case nil:
+		return ""
+
+	case string:
+		return typed
+
+	case bool:
+		return strconv.FormatBool(typed)
+
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+
+	case []interface{}:
+
+		values := make([]string, 0, len(typed))
+		for _, item := range typed {
+			values = append(values, displayValue(item))
+		}
+
+		return strings.Join(values, ", ")
+
+	case map[string]interface{}:
+
+		if typed["type"] == "doc" {
+			return strings.Join(documentText(typed), " ")
+		}
+
+		for _, key := range []string{"displayName", "name", "value", "key", "emailAddress", "id"} {
+			if nested, ok := typed[key]; ok {
+				return displayValue(nested)
+			}
+		}
+
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		pairs := make([]string, 0, len(keys))
+		for _, key := range keys {
+			pairs = append(pairs, key+"="+displayValue(typed[key]))
+		}
+
+		return strings.Join(pairs, " ")
+	}
+
+	return fmt.Sprint(value)
+}
+
+// documentText returns the text of the blocks of an Atlassian Document Format node
+func documentText(node map[string]interface{}) (blocks []string) {
+
+	var text func(node map[string]interface{}) string
+	text = func(node map[string]interface{}) string {
+
+		if value, ok := node["text"].(string); ok {
+			return value
+		}
+
+		var builder strings.Builder
+
+		children, _ := node["content"].([]interface{})
+		for _, child := range children {
+			if childNode, ok := child.(map[string]interface{}); ok {
+				builder.WriteString(text(childNode))
+			}
+		}
+
+		return builder.String()
+	}
+
+	children, _ := node["content"].([]interface{})
+	for _, child := range children {
+
+		if childNode, ok := child.(map[string]interface{}); ok {
+			if block := text(childNode); len(block) != 0 {
+				blocks = append(blocks, block)
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+
+	value := []map[string]string{{"key": "KP-1", "summary": "Create the CLI"}}
+
+	t1 := &table{header: []string{"key", "summary"}}
+	t1.add("KP-1", "Create the CLI")
+	t1.add("KP-20", "Add the \"csv\", output\nformat")
+
+	testCases := []struct {
+		name    string
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "RenderWhenTheFormatIsTable",
+			format: tableOutput,
+			want:   "KEY    SUMMARY\nKP-1   Create the CLI\nKP-20  Add the \"csv\", output format\n",
+		},
+		{
+			name:   "RenderWhenTheFormatIsCSV",
+			format: csvOutput,
+			want:   "key,summary\nKP-1,Create the CLI\nKP-20,\"Add the \"\"csv\"\", output\nformat\"\n",
+		},
+		{
+			name:   "RenderWhenTheFormatIsJSON",
+			format: jsonOutput,
+			want:   "[\n  {\n    \"key\": \"KP-1\",\n    \"summary\": \"Create the CLI\"\n  }\n]\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var buffer bytes.Buffer
+
+			assert.NoError(t, validOutput(testCase.format))
+			assert.NoError(t, render(&buffer, testCase.format, value, t1))
+			assert.Equal(t, testCase.want, buffer.String())
+		})
+	}
+
+	assert.Error(t, validOutput("yaml"))
+}
+
+func TestDisplayValue(t *testing.T) {
+
+	var fields map[string]interface{}
+
+	content := `{
+		"status": {"name": "In Progress", "id": "3"},
+		"assignee": {"displayName": "Carlos Treminio", "accountId": "5b86be50b8e3cb5895860d6d"},
+		"labels": ["cli", "go"],
+		"components": [{"name": "CLI"}, {"name": "Library"}],
+		"storyPoints": 5,
+		"flagged": false,
+		"resolution": null,
+		"description": {"type": "doc", "version": 1, "content": [
+			{"type": "paragraph", "content": [{"type": "text", "text": "The CLI uses "}, {"type": "text", "text": "the library"}]},
+			{"type": "paragraph"},
+			{"type": "paragraph", "content": [{"type": "text", "text": "Second paragraph"}]}
+		]},
+		"timetracking": {"remainingEstimate": "2h", "originalEstimate": "1d"}
+	}`
+
+	if err := json.Unmarshal([]byte(content), &fields); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"status":       "In Progress",
+		"assignee":     "Carlos Treminio",
+		"labels":       "cli, go",
+		"components":   "CLI, Library",
+		"storyPoints":  "5",
+		"flagged":      "false",
+		"resolution":   "",
+		"description":  "The CLI uses the library Second paragraph",
+		"timetracking": "originalEstimate=1d remainingEstimate=2h",
+	}
+
+	for name, value := range want {
+		assert.Equal(t, value, displayValue(fields[name]), name)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/ctreminiom/go-atlassian/jira"
+)
+
+func (a *app) projectCommand() *command {
+
+	return &command{
+		name:        "project",
+		description: "List and get the Jira projects.",
+		commands: []*command{
+			a.projectListCommand(),
+			a.projectGetCommand(),
+		},
+	}
+}
+
+func (a *app) projectListCommand() *command {
+
+	var (
+		query, orderBy      string
+		startAt, maxResults int
+	)
+
+	return &command{
+		name:        "list",
+		usage:       "[flags]",
+		description: "List the projects visible to the user.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&query, "query", "", "filter the projects by the key or the name")
+			fs.StringVar(&orderBy, "order-by", "key", "the order of the projects, e.g: name, -lastIssueUpdatedTime")
+			fs.IntVar(&startAt, "start", 0, "the index of the first project")
+			fs.IntVar(&maxResults, "max", 50, "the maximum number of projects")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			options := &jira.ProjectSearchOptionsScheme{
+				Query:   query,
+				OrderBy: orderBy,
+				Expand:  []string{"lead"},
+			}
+
+			page, response, err := client.Project.Search(ctx, options, startAt, maxResults)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			t := &table{header: []string{"key", "id", "name", "type", "lead"}}
+			for _, project := range page.Values {
+				t.add(project.Key, project.ID, project.Name, project.ProjectTypeKey, userName(project.Lead))
+			}
+
+			return a.print(page.Values, t)
+		},
+	}
+}
+
+func (a *app) projectGetCommand() *command {
+
+	return &command{
+		name:        "get",
+		usage:       "<project-key>",
+		description: "Print a project.",
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "project-key"); err != nil {
+				return err
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			project, response, err := client.Project.Get(ctx, args[0], []string{"description", "lead", "issueTypes"})
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			var issueTypes []interface{}
+			for _, issueType := range project.IssueTypes {
+				issueTypes = append(issueTypes, issueType.Name)
+			}
+
+			var category string
+			if project.Category != nil {
+				category = project.Category.Name
+			}
+
+			t := details(
+				"key", project.Key,
+				"id", project.ID,
+				"name", project.Name,
+				"type", project.ProjectTypeKey,
+				"lead", userName(project.Lead),
+				"category", category,
+				"issue types", displayValue(issueTypes),
+				"description", project.Description,
+			)
+
+			return a.print(project, t)
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+)
+
+func (a *app) requestCommand() *command {
+
+	return &command{
+		name:        "request",
+		description: "List and create the Jira Service Management customer requests.",
+		commands: []*command{
+			a.requestListCommand(),
+			a.requestCreateCommand(),
+		},
+	}
+}
+
+func (a *app) requestListCommand() *command {
+
+	var (
+		options    sm.RequestGetOptionsScheme
+		ownerships stringsFlag
+		start      int
+		limit      int
+	)
+
+	return &command{
+		name:        "list",
+		usage:       "[flags]",
+		description: "List the customer requests of the user, the JSON output contains the requests returned by Jira.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&options.SearchTerm, "search", "", "filter the requests by the summary and the description")
+			fs.StringVar(&options.RequestStatus, "status", "", "OPEN_REQUESTS, CLOSED_REQUESTS or ALL_REQUESTS")
+			fs.IntVar(&options.ServiceDeskID, "service-desk", 0, "the ID of the service desk")
+			fs.IntVar(&options.RequestTypeID, "request-type", 0, "the ID of the request type, it requires the --service-desk flag")
+			fs.Var(&ownerships, "ownership", "the ownership of the requests, e.g: OWNED_REQUESTS or ALL_REQUESTS, it can be repeated")
+			fs.IntVar(&start, "start", 0, "the index of the first request")
+			fs.IntVar(&limit, "max", 50, "the maximum number of requests")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			client, err := a.sm()
+			if err != nil {
+				return err
+			}
+
+			options.RequestOwnerships = ownerships
+			options.Expand = []string{"requestType"}
+
+			_, response, err := client.Request.Gets(ctx, &options, start, limit)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			var page struct {
+				Values []map[string]interface{} `json:"values"`
+			}
+
+			if err = json.Unmarshal(response.BodyAsBytes, &page); err != nil {
+				return err
+			}
+
+			t := &table{header: []string{"key", "summary", "type", "status", "reporter", "created"}}
+			for _, request := range page.Values {
+
+				t.add(
+					displayValue(request["issueKey"]),
+					requestFieldValue(request, "summary"),
+					displayValue(lookup(request, "requestType", "name")),
+					displayValue(lookup(request, "currentStatus", "status")),
+					displayValue(lookup(request, "reporter", "displayName")),
+					displayValue(lookup(request, "createdDate", "friendly")),
+				)
+			}
+
+			if page.Values == nil {
+				page.Values = []map[string]interface{}{}
+			}
+
+			return a.print(page.Values, t)
+		},
+	}
+}
+
+func (a *app) requestCreateCommand() *command {
+
+	var (
+		payload              sm.CreateCustomerRequestPayloadScheme
+		summary, description string
+		participants, fields stringsFlag
+	)
+
+	return &command{
+		name:        "create",
+		usage:       "--service-desk <id> --request-type <id> --summary <text> [flags]",
+		description: "Create a customer request.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&payload.ServiceDeskID, "service-desk", "", "the ID of the service desk")
+			fs.StringVar(&payload.RequestTypeID, "request-type", "", "the ID of the request type")
+			fs.StringVar(&summary, "summary", "", "the summary of the request")
+			fs.StringVar(&description, "description", "", "the description of the request")
+			fs.StringVar(&payload.RaiseOnBehalfOf, "on-behalf-of", "", "the account ID of the customer that raises the request")
+			fs.Var(&participants, "participant", "the account ID of a request participant, it can be repeated")
+			fs.Var(&fields, "field", "a request field as name=value, the value is decoded as JSON when it's valid JSON, it can be repeated")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args); err != nil {
+				return err
+			}
+
+			if len(payload.ServiceDeskID) == 0 || len(payload.RequestTypeID) == 0 || len(summary) == 0 {
+				return fmt.Errorf("the --service-desk, --request-type and --summary flags are required")
+			}
+
+			values, err := parseFieldValues(fields)
+			if err != nil {
+				return err
+			}
+
+			values["summary"] = summary
+
+			if len(description) != 0 {
+				values["description"] = description
+			}
+
+			payload.RequestFieldValues = values
+			payload.RequestParticipants = participants
+
+			client, err := a.sm()
+			if err != nil {
+				return err
+			}
+
+			request, response, err := client.Request.Create(ctx, &payload)
+			if err != nil {
+				return apiError(err, response)
+			}
+
+			t := &table{header: []string{"key", "id", "service desk", "status"}}
+			t.add(request.IssueKey, request.IssueID, request.ServiceDeskID, request.CurrentStatus.Status)
+
+			return a.print(request, t)
+		},
+	}
+}
+
+// requestFieldValue returns the value of a request field, e.g: the summary
+func requestFieldValue(request map[string]interface{}, fieldID string) string {
+
+	values, _ := request["requestFieldValues"].([]interface{})
+
+	for _, value := range values {
+
+		field, _ := value.(map[string]interface{})
+		if field["fieldId"] == fieldID {
+			return displayValue(field["value"])
+		}
+	}
+
+	return ""
+}
+
+// lookup returns the value of a decoded JSON object path, e.g: currentStatus.status
+func lookup(value interface{}, path ...string) interface{} {
+
+	for _, key := range path {
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		value = object[key]
+	}
+
+	return value
+}
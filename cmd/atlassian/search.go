@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// defaultSearchFields are the columns printed by the search command when the fields are not provided
+var defaultSearchFields = []string{"summary", "status", "assignee", "priority"}
+
+func (a *app) searchCommand() *command {
+
+	var (
+		fields      string
+		startAt     int
+		maxResults  int
+		all         bool
+		validateJQL string
+	)
+
+	return &command{
+		name:        "search",
+		usage:       "<jql> [flags]",
+		description: "Search the issues with a JQL query, the JSON output contains the issues returned by Jira.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&fields, "fields", strings.Join(defaultSearchFields, ","), "comma-separated fields printed as columns")
+			fs.IntVar(&startAt, "start", 0, "the index of the first issue")
+			fs.IntVar(&maxResults, "max", 50, "the maximum number of issues per page")
+			fs.BoolVar(&all, "all", false, "fetch all the pages of the search")
+			fs.StringVar(&validateJQL, "validate", "strict", "the JQL validation: strict, warn or none")
+		},
+		run: func(ctx context.Context, args []string) error {
+
+			if err := requireArgs(args, "jql"); err != nil {
+				return err
+			}
+
+			names := splitList(fields)
+			if len(names) == 0 {
+				return fmt.Errorf("the --fields flag is empty")
+			}
+
+			client, err := a.jira()
+			if err != nil {
+				return err
+			}
+
+			var issues []map[string]interface{}
+
+			for {
+
+				_, response, err := client.Issue.Search.Post(ctx, args[0], names, nil, startAt, maxResults, validateJQL)
+				if err != nil {
+					return apiError(err, response)
+				}
+
+				var page struct {
+					Total  int                      `json:"total"`
+					Issues []map[string]interface{} `json:"issues"`
+				}
+
+				if err = json.Unmarshal(response.BodyAsBytes, &page); err != nil {
+					return err
+				}
+
+				issues = append(issues, page.Issues...)
+				startAt += len(page.Issues)
+
+				if !all || len(page.Issues) == 0 || startAt >= page.Total {
+					break
+				}
+			}
+
+			t := &table{header: append([]string{"key"}, names...)}
+			for _, issue := range issues {
+
+				row := []string{issueField(issue, "key")}
+				for _, name := range names {
+					row = append(row, issueField(issue, name))
+				}
+
+				t.add(row...)
+			}
+
+			if issues == nil {
+				issues = []map[string]interface{}{}
+			}
+
+			return a.print(issues, t)
+		},
+	}
+}
@@ -0,0 +1,384 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ContentService struct {
+	client      *Client
+	Attachment  *ContentAttachmentService
+	Children    *ContentChildrenDescendantService
+	Label       *ContentLabelService
+	Restriction *ContentRestrictionService
+	Version     *ContentVersionService
+}
+
+const (
+	PageContentType     = "page"
+	BlogPostContentType = "blogpost"
+
+	StorageRepresentation        = "storage"
+	AtlasDocFormatRepresentation = "atlas_doc_format"
+)
+
+type ContentScheme struct {
+	ID         string                `json:"id,omitempty"`
+	Type       string                `json:"type,omitempty"`
+	Status     string                `json:"status,omitempty"`
+	Title      string                `json:"title,omitempty"`
+	Space      *SpaceScheme          `json:"space,omitempty"`
+	History    *ContentHistoryScheme `json:"history,omitempty"`
+	Version    *ContentVersionScheme `json:"version,omitempty"`
+	Ancestors  []*ContentScheme      `json:"ancestors,omitempty"`
+	Body       *BodyScheme           `json:"body,omitempty"`
+	Metadata   *MetadataScheme       `json:"metadata,omitempty"`
+	Expandable *ExpandableScheme     `json:"_expandable,omitempty"`
+	Links      *LinkScheme           `json:"_links,omitempty"`
+}
+
+type ContentPageScheme struct {
+	Results []*ContentScheme `json:"results,omitempty"`
+	Start   int              `json:"start,omitempty"`
+	Limit   int              `json:"limit,omitempty"`
+	Size    int              `json:"size,omitempty"`
+	Links   *LinkScheme      `json:"_links,omitempty"`
+}
+
+// BodyScheme contains the representations of the content body, the storage and atlas_doc_format
+// representations are used to create and update the content.
+type BodyScheme struct {
+	View                *BodyNodeScheme `json:"view,omitempty"`
+	ExportView          *BodyNodeScheme `json:"export_view,omitempty"`
+	StyledView          *BodyNodeScheme `json:"styled_view,omitempty"`
+	Storage             *BodyNodeScheme `json:"storage,omitempty"`
+	Editor2             *BodyNodeScheme `json:"editor2,omitempty"`
+	AnonymousExportView *BodyNodeScheme `json:"anonymous_export_view,omitempty"`
+	AtlasDocFormat      *BodyNodeScheme `json:"atlas_doc_format,omitempty"`
+}
+
+type BodyNodeScheme struct {
+	Value          string `json:"value,omitempty"`
+	Representation string `json:"representation,omitempty"`
+}
+
+type ContentHistoryScheme struct {
+	Latest      bool               `json:"latest,omitempty"`
+	CreatedBy   *ContentUserScheme `json:"createdBy,omitempty"`
+	CreatedDate string             `json:"createdDate,omitempty"`
+}
+
+type ContentUserScheme struct {
+	Type        string `json:"type,omitempty"`
+	Username    string `json:"username,omitempty"`
+	UserKey     string `json:"userKey,omitempty"`
+	AccountID   string `json:"accountId,omitempty"`
+	AccountType string `json:"accountType,omitempty"`
+	Email       string `json:"email,omitempty"`
+	PublicName  string `json:"publicName,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+type MetadataScheme struct {
+	Labels    *ContentLabelPageScheme `json:"labels,omitempty"`
+	MediaType string                  `json:"mediaType,omitempty"`
+	Comment   string                  `json:"comment,omitempty"`
+}
+
+type ExpandableScheme struct {
+	Container    string `json:"container,omitempty"`
+	Space        string `json:"space,omitempty"`
+	History      string `json:"history,omitempty"`
+	Version      string `json:"version,omitempty"`
+	Ancestors    string `json:"ancestors,omitempty"`
+	Children     string `json:"children,omitempty"`
+	Descendants  string `json:"descendants,omitempty"`
+	Body         string `json:"body,omitempty"`
+	Restrictions string `json:"restrictions,omitempty"`
+}
+
+type LinkScheme struct {
+	Self     string `json:"self,omitempty"`
+	Tinyui   string `json:"tinyui,omitempty"`
+	Editui   string `json:"editui,omitempty"`
+	Webui    string `json:"webui,omitempty"`
+	Download string `json:"download,omitempty"`
+	Base     string `json:"base,omitempty"`
+	Context  string `json:"context,omitempty"`
+	Next     string `json:"next,omitempty"`
+	Prev     string `json:"prev,omitempty"`
+}
+
+type GetContentOptionsScheme struct {
+	ContentType, SpaceKey string
+	Title                 string
+	Trigger               string
+	OrderBy               string
+	Status                []string
+	Expand                []string
+	PostingDay            string
+}
+
+// Returns all content in a Confluence instance.
+// Docs: N/A
+func (c *ContentService) Gets(ctx context.Context, options *GetContentOptionsScheme, startAt, maxResults int) (result *ContentPageScheme, response *Response, err error) {
+
+	params := url.Values{}
+	params.Add("start", strconv.Itoa(startAt))
+	params.Add("limit", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		if len(options.ContentType) != 0 {
+			params.Add("type", options.ContentType)
+		}
+
+		if len(options.SpaceKey) != 0 {
+			params.Add("spaceKey", options.SpaceKey)
+		}
+
+		if len(options.Title) != 0 {
+			params.Add("title", options.Title)
+		}
+
+		if len(options.Trigger) != 0 {
+			params.Add("trigger", options.Trigger)
+		}
+
+		if len(options.OrderBy) != 0 {
+			params.Add("orderby", options.OrderBy)
+		}
+
+		if len(options.Status) != 0 {
+			params.Add("status", strings.Join(options.Status, ","))
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+
+		if len(options.PostingDay) != 0 {
+			params.Add("postingDay", options.PostingDay)
+		}
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content?%v", params.Encode())
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Creates a new piece of content or publishes an existing draft, e.g: a page or a blog post.
+// The body can use the storage or the atlas_doc_format representation.
+// Docs: N/A
+func (c *ContentService) Create(ctx context.Context, payload *ContentScheme) (result *ContentScheme, response *Response, err error) {
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid ContentScheme pointer")
+	}
+
+	var endpoint = "wiki/rest/api/content"
+
+	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a single piece of content, like a page or a blog post, the version 0 returns the latest version.
+// Docs: N/A
+func (c *ContentService) Get(ctx context.Context, contentID string, expand []string, version int) (result *ContentScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	if version != 0 {
+		params.Add("version", strconv.Itoa(version))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v", contentID))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Updates a piece of content, the version number of the payload has to be the current version plus one.
+// Docs: N/A
+func (c *ContentService) Update(ctx context.Context, contentID string, payload *ContentScheme) (result *ContentScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	if payload == nil || payload.Version == nil || payload.Version.Number == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid ContentScheme pointer with the version number")
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v", contentID)
+
+	request, err := c.client.newRequest(ctx, http.MethodPut, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Moves a piece of content to the space's trash or purges it from the trash,
+// the status "trashed" purges the content that's already trashed.
+// Docs: N/A
+func (c *ContentService) Delete(ctx context.Context, contentID, status string) (response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+
+	if len(status) != 0 {
+		params.Add("status", status)
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v", contentID))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodDelete, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns the list of content that matches a Confluence Query Language (CQL) query,
+// the next pages are requested with the cursor of the next link.
+// Docs: N/A
+func (c *ContentService) Search(ctx context.Context, cql, cqlContext string, expand []string, cursor string, maxResults int) (result *ContentPageScheme, response *Response, err error) {
+
+	if len(cql) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid cql value")
+	}
+
+	params := url.Values{}
+	params.Add("cql", cql)
+	params.Add("limit", strconv.Itoa(maxResults))
+
+	if len(cqlContext) != 0 {
+		params.Add("cqlcontext", cqlContext)
+	}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	if len(cursor) != 0 {
+		params.Add("cursor", cursor)
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/search?%v", params.Encode())
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,145 @@
+package confluence
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ContentAttachmentService struct{ client *Client }
+
+type GetContentAttachmentsOptionsScheme struct {
+	Expand    []string
+	FileName  string
+	MediaType string
+}
+
+// Returns the attachments for a piece of content, the attachments can be filtered by the file name and the media type.
+// Docs: N/A
+func (c *ContentAttachmentService) Gets(ctx context.Context, contentID string, startAt, maxResults int, options *GetContentAttachmentsOptionsScheme) (result *ContentPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+	params.Add("start", strconv.Itoa(startAt))
+	params.Add("limit", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+
+		if len(options.FileName) != 0 {
+			params.Add("filename", options.FileName)
+		}
+
+		if len(options.MediaType) != 0 {
+			params.Add("mediaType", options.MediaType)
+		}
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v/child/attachment?%v", contentID, params.Encode())
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Adds an attachment to a piece of content, the request fails if an attachment with the same name already exists.
+// Docs: N/A
+func (c *ContentAttachmentService) Create(ctx context.Context, contentID, fileName string, file io.Reader, comment string, minorEdit bool) (result *ContentPageScheme, response *Response, err error) {
+	return c.upload(ctx, http.MethodPost, contentID, fileName, file, comment, minorEdit)
+}
+
+// Adds an attachment to a piece of content or, when an attachment with the same name exists, adds a new version of it.
+// Docs: N/A
+func (c *ContentAttachmentService) CreateOrUpdate(ctx context.Context, contentID, fileName string, file io.Reader, comment string, minorEdit bool) (result *ContentPageScheme, response *Response, err error) {
+	return c.upload(ctx, http.MethodPut, contentID, fileName, file, comment, minorEdit)
+}
+
+func (c *ContentAttachmentService) upload(ctx context.Context, method, contentID, fileName string, file io.Reader, comment string, minorEdit bool) (result *ContentPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	if len(fileName) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid fileName value")
+	}
+
+	if file == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid file io.Reader")
+	}
+
+	payload := &bytes.Buffer{}
+	writer := multipart.NewWriter(payload)
+
+	filePart, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return
+	}
+
+	if _, err = io.Copy(filePart, file); err != nil {
+		return
+	}
+
+	if len(comment) != 0 {
+		if err = writer.WriteField("comment", comment); err != nil {
+			return
+		}
+	}
+
+	if err = writer.WriteField("minorEdit", strconv.FormatBool(minorEdit)); err != nil {
+		return
+	}
+
+	if err = writer.Close(); err != nil {
+		return
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v/child/attachment", contentID)
+
+	request, err := c.client.newFormRequest(ctx, method, endpoint, writer.FormDataContentType(), payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
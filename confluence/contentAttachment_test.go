@@ -0,0 +1,583 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestContentAttachmentService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		contentID           string
+		startAt, maxResults int
+		options             *GetContentAttachmentsOptionsScheme
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:       "GetsWhenTheParametersAreCorrect",
+			contentID:  "65798",
+			startAt:    0,
+			maxResults: 50,
+			options: &GetContentAttachmentsOptionsScheme{
+				Expand:    []string{"version"},
+				MediaType: "image/png",
+			},
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment?expand=version&limit=50&mediaType=image%2Fpng&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:       "GetsWhenTheContentIDIsNotProvided",
+			contentID:  "",
+			startAt:    0,
+			maxResults: 50,
+			options: &GetContentAttachmentsOptionsScheme{
+				Expand:    []string{"version"},
+				MediaType: "image/png",
+			},
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment?expand=version&limit=50&mediaType=image%2Fpng&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:       "GetsWhenTheRequestMethodIsIncorrect",
+			contentID:  "65798",
+			startAt:    0,
+			maxResults: 50,
+			options: &GetContentAttachmentsOptionsScheme{
+				Expand:    []string{"version"},
+				MediaType: "image/png",
+			},
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment?expand=version&limit=50&mediaType=image%2Fpng&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:       "GetsWhenTheStatusCodeIsIncorrect",
+			contentID:  "65798",
+			startAt:    0,
+			maxResults: 50,
+			options: &GetContentAttachmentsOptionsScheme{
+				Expand:    []string{"version"},
+				MediaType: "image/png",
+			},
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment?expand=version&limit=50&mediaType=image%2Fpng&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:       "GetsWhenTheContextIsNil",
+			contentID:  "65798",
+			startAt:    0,
+			maxResults: 50,
+			options: &GetContentAttachmentsOptionsScheme{
+				Expand:    []string{"version"},
+				MediaType: "image/png",
+			},
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment?expand=version&limit=50&mediaType=image%2Fpng&start=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:       "GetsWhenTheResponseBodyHasADifferentFormat",
+			contentID:  "65798",
+			startAt:    0,
+			maxResults: 50,
+			options: &GetContentAttachmentsOptionsScheme{
+				Expand:    []string{"version"},
+				MediaType: "image/png",
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment?expand=version&limit=50&mediaType=image%2Fpng&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheOptionsAreNil",
+			contentID:          "65798",
+			startAt:            0,
+			maxResults:         50,
+			options:            nil,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment?limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentAttachmentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.contentID, testCase.startAt, testCase.maxResults, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, attachment := range gotResult.Results {
+					t.Log(attachment.ID, attachment.Title, attachment.Metadata.MediaType)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentAttachmentService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		contentID, fileName string
+		file                string
+		comment             string
+		minorEdit           bool
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "CreateWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheFileNameIsNotProvided",
+			contentID:          "65798",
+			fileName:           "",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheContextIsNil",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentAttachmentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.contentID, testCase.fileName, strings.NewReader(testCase.file), testCase.comment, testCase.minorEdit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentAttachmentService_CreateOrUpdate(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		contentID, fileName string
+		file                string
+		comment             string
+		minorEdit           bool
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "CreateOrUpdateWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateOrUpdateWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateOrUpdateWhenTheFileNameIsNotProvided",
+			contentID:          "65798",
+			fileName:           "",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateOrUpdateWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateOrUpdateWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateOrUpdateWhenTheContextIsNil",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/get-content-attachments.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateOrUpdateWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			fileName:           "architecture.png",
+			file:               "the file content",
+			comment:            "The architecture diagram",
+			minorEdit:          true,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/child/attachment",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentAttachmentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.CreateOrUpdate(testCase.context, testCase.contentID, testCase.fileName, strings.NewReader(testCase.file), testCase.comment, testCase.minorEdit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
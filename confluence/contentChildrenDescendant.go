@@ -0,0 +1,132 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ContentChildrenDescendantService struct{ client *Client }
+
+type ContentChildrenScheme struct {
+	Attachment *ContentPageScheme `json:"attachment,omitempty"`
+	Comments   *ContentPageScheme `json:"comment,omitempty"`
+	Page       *ContentPageScheme `json:"page,omitempty"`
+	Links      *LinkScheme        `json:"_links,omitempty"`
+}
+
+// Returns a map of the direct children of a piece of content, grouped by the content type.
+// By default only the links are returned, expand the types to get the children, e.g: page, comment or attachment.
+// Docs: N/A
+func (c *ContentChildrenDescendantService) Children(ctx context.Context, contentID string, expand []string, parentVersion int) (result *ContentChildrenScheme, response *Response, err error) {
+	return c.tree(ctx, "child", contentID, expand, parentVersion)
+}
+
+// Returns all children of a given type, for a piece of content.
+// Docs: N/A
+func (c *ContentChildrenDescendantService) ChildrenByType(ctx context.Context, contentID, contentType string, parentVersion int, expand []string, startAt, maxResults int) (result *ContentPageScheme, response *Response, err error) {
+	return c.treeByType(ctx, "child", contentID, contentType, parentVersion, expand, startAt, maxResults)
+}
+
+// Returns a map of the descendants of a piece of content, the descendants are the children and the children of the children.
+// Docs: N/A
+func (c *ContentChildrenDescendantService) Descendants(ctx context.Context, contentID string, expand []string) (result *ContentChildrenScheme, response *Response, err error) {
+	return c.tree(ctx, "descendant", contentID, expand, 0)
+}
+
+// Returns all descendants of a given type, for a piece of content.
+// Docs: N/A
+func (c *ContentChildrenDescendantService) DescendantsByType(ctx context.Context, contentID, contentType string, expand []string, startAt, maxResults int) (result *ContentPageScheme, response *Response, err error) {
+	return c.treeByType(ctx, "descendant", contentID, contentType, 0, expand, startAt, maxResults)
+}
+
+func (c *ContentChildrenDescendantService) tree(ctx context.Context, relation, contentID string, expand []string, parentVersion int) (result *ContentChildrenScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	if parentVersion != 0 {
+		params.Add("parentVersion", strconv.Itoa(parentVersion))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/%v", contentID, relation))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentChildrenScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c *ContentChildrenDescendantService) treeByType(ctx context.Context, relation, contentID, contentType string, parentVersion int, expand []string, startAt, maxResults int) (result *ContentPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	if len(contentType) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentType value")
+	}
+
+	params := url.Values{}
+	params.Add("start", strconv.Itoa(startAt))
+	params.Add("limit", strconv.Itoa(maxResults))
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	if parentVersion != 0 {
+		params.Add("parentVersion", strconv.Itoa(parentVersion))
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v/%v/%v?%v", contentID, relation, contentType, params.Encode())
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
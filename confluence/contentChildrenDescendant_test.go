@@ -0,0 +1,714 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestContentChildrenDescendantService_Children(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID          string
+		expand             []string
+		parentVersion      int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "ChildrenWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			expand:             []string{"page", "attachment"},
+			parentVersion:      3,
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child?expand=page%2Cattachment&parentVersion=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ChildrenWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			expand:             []string{"page", "attachment"},
+			parentVersion:      3,
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child?expand=page%2Cattachment&parentVersion=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			expand:             []string{"page", "attachment"},
+			parentVersion:      3,
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child?expand=page%2Cattachment&parentVersion=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			expand:             []string{"page", "attachment"},
+			parentVersion:      3,
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child?expand=page%2Cattachment&parentVersion=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenWhenTheContextIsNil",
+			contentID:          "65798",
+			expand:             []string{"page", "attachment"},
+			parentVersion:      3,
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child?expand=page%2Cattachment&parentVersion=3",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			expand:             []string{"page", "attachment"},
+			parentVersion:      3,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child?expand=page%2Cattachment&parentVersion=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenWhenTheOptionalParametersAreNotProvided",
+			contentID:          "65798",
+			expand:             nil,
+			parentVersion:      0,
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentChildrenDescendantService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Children(testCase.context, testCase.contentID, testCase.expand, testCase.parentVersion)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, page := range gotResult.Page.Results {
+					t.Log(page.ID, page.Title)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentChildrenDescendantService_ChildrenByType(t *testing.T) {
+
+	testCases := []struct {
+		name                   string
+		contentID, contentType string
+		parentVersion          int
+		expand                 []string
+		startAt, maxResults    int
+		mockFile               string
+		wantHTTPMethod         string
+		endpoint               string
+		context                context.Context
+		wantHTTPCodeReturn     int
+		wantErr                bool
+	}{
+		{
+			name:               "ChildrenByTypeWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			parentVersion:      0,
+			expand:             []string{"version"},
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/page?expand=version&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ChildrenByTypeWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			contentType:        PageContentType,
+			parentVersion:      0,
+			expand:             []string{"version"},
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/page?expand=version&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenByTypeWhenTheContentTypeIsNotProvided",
+			contentID:          "65798",
+			contentType:        "",
+			parentVersion:      0,
+			expand:             []string{"version"},
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/page?expand=version&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenByTypeWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			parentVersion:      0,
+			expand:             []string{"version"},
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/child/page?expand=version&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenByTypeWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			parentVersion:      0,
+			expand:             []string{"version"},
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/page?expand=version&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenByTypeWhenTheContextIsNil",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			parentVersion:      0,
+			expand:             []string{"version"},
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/page?expand=version&limit=25&start=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ChildrenByTypeWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			parentVersion:      0,
+			expand:             []string{"version"},
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/child/page?expand=version&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentChildrenDescendantService{client: mockClient}
+
+			gotResult, gotResponse, err := service.ChildrenByType(testCase.context, testCase.contentID, testCase.contentType, testCase.parentVersion, testCase.expand, testCase.startAt, testCase.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentChildrenDescendantService_Descendants(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID          string
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DescendantsWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			expand:             []string{"page"},
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant?expand=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DescendantsWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			expand:             []string{"page"},
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant?expand=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			expand:             []string{"page"},
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/descendant?expand=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			expand:             []string{"page"},
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant?expand=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsWhenTheContextIsNil",
+			contentID:          "65798",
+			expand:             []string{"page"},
+			mockFile:           "./mocks/get-content-children.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant?expand=page",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			expand:             []string{"page"},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant?expand=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentChildrenDescendantService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Descendants(testCase.context, testCase.contentID, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentChildrenDescendantService_DescendantsByType(t *testing.T) {
+
+	testCases := []struct {
+		name                   string
+		contentID, contentType string
+		expand                 []string
+		startAt, maxResults    int
+		mockFile               string
+		wantHTTPMethod         string
+		endpoint               string
+		context                context.Context
+		wantHTTPCodeReturn     int
+		wantErr                bool
+	}{
+		{
+			name:               "DescendantsByTypeWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			expand:             nil,
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant/page?limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DescendantsByTypeWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			contentType:        PageContentType,
+			expand:             nil,
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant/page?limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsByTypeWhenTheContentTypeIsNotProvided",
+			contentID:          "65798",
+			contentType:        "",
+			expand:             nil,
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant/page?limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsByTypeWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			expand:             nil,
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/descendant/page?limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsByTypeWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			expand:             nil,
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant/page?limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsByTypeWhenTheContextIsNil",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			expand:             nil,
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant/page?limit=25&start=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DescendantsByTypeWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			contentType:        PageContentType,
+			expand:             nil,
+			startAt:            0,
+			maxResults:         25,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/descendant/page?limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentChildrenDescendantService{client: mockClient}
+
+			gotResult, gotResponse, err := service.DescendantsByType(testCase.context, testCase.contentID, testCase.contentType, testCase.expand, testCase.startAt, testCase.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
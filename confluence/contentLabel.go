@@ -0,0 +1,135 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type ContentLabelService struct{ client *Client }
+
+type ContentLabelPageScheme struct {
+	Results []*ContentLabelScheme `json:"results,omitempty"`
+	Start   int                   `json:"start,omitempty"`
+	Limit   int                   `json:"limit,omitempty"`
+	Size    int                   `json:"size,omitempty"`
+	Links   *LinkScheme           `json:"_links,omitempty"`
+}
+
+type ContentLabelScheme struct {
+	Prefix string `json:"prefix,omitempty"`
+	Name   string `json:"name,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Label  string `json:"label,omitempty"`
+}
+
+type ContentLabelPayloadScheme struct {
+	Prefix string `json:"prefix,omitempty"`
+	Name   string `json:"name,omitempty"`
+}
+
+// Returns the labels on a piece of content, the prefix filters the labels, e.g: global, my or team.
+// Docs: N/A
+func (c *ContentLabelService) Gets(ctx context.Context, contentID, prefix string, startAt, maxResults int) (result *ContentLabelPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+	params.Add("start", strconv.Itoa(startAt))
+	params.Add("limit", strconv.Itoa(maxResults))
+
+	if len(prefix) != 0 {
+		params.Add("prefix", prefix)
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v/label?%v", contentID, params.Encode())
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentLabelPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Adds labels to a piece of content, the labels that already exist are ignored.
+// Docs: N/A
+func (c *ContentLabelService) Add(ctx context.Context, contentID string, payload []*ContentLabelPayloadScheme) (result *ContentLabelPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	if len(payload) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid ContentLabelPayloadScheme slice")
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v/label", contentID)
+
+	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentLabelPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Removes a label from a piece of content.
+// Docs: N/A
+func (c *ContentLabelService) Remove(ctx context.Context, contentID, labelName string) (response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	if len(labelName) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid labelName value")
+	}
+
+	params := url.Values{}
+	params.Add("name", labelName)
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v/label?%v", contentID, params.Encode())
+
+	request, err := c.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
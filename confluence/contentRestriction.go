@@ -0,0 +1,215 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type ContentRestrictionService struct{ client *Client }
+
+const (
+	ReadRestrictionOperation   = "read"
+	UpdateRestrictionOperation = "update"
+)
+
+type ContentRestrictionPageScheme struct {
+	Results []*ContentRestrictionScheme `json:"results,omitempty"`
+	Start   int                         `json:"start,omitempty"`
+	Limit   int                         `json:"limit,omitempty"`
+	Size    int                         `json:"size,omitempty"`
+	Links   *LinkScheme                 `json:"_links,omitempty"`
+}
+
+type ContentRestrictionScheme struct {
+	Operation    string                           `json:"operation,omitempty"`
+	Restrictions *ContentRestrictionDetailsScheme `json:"restrictions,omitempty"`
+	Content      *ContentScheme                   `json:"content,omitempty"`
+	Links        *LinkScheme                      `json:"_links,omitempty"`
+}
+
+type ContentRestrictionDetailsScheme struct {
+	User  *ContentRestrictionUserPageScheme  `json:"user,omitempty"`
+	Group *ContentRestrictionGroupPageScheme `json:"group,omitempty"`
+}
+
+type ContentRestrictionUserPageScheme struct {
+	Results []*ContentUserScheme `json:"results,omitempty"`
+	Start   int                  `json:"start,omitempty"`
+	Limit   int                  `json:"limit,omitempty"`
+	Size    int                  `json:"size,omitempty"`
+}
+
+type ContentRestrictionGroupPageScheme struct {
+	Results []*ContentGroupScheme `json:"results,omitempty"`
+	Start   int                   `json:"start,omitempty"`
+	Limit   int                   `json:"limit,omitempty"`
+	Size    int                   `json:"size,omitempty"`
+}
+
+type ContentGroupScheme struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+	ID   string `json:"id,omitempty"`
+}
+
+type ContentRestrictionUpdateScheme struct {
+	Operation    string                                       `json:"operation,omitempty"`
+	Restrictions *ContentRestrictionRestrictionsPayloadScheme `json:"restrictions,omitempty"`
+}
+
+type ContentRestrictionRestrictionsPayloadScheme struct {
+	User  []*ContentRestrictionUserPayloadScheme  `json:"user,omitempty"`
+	Group []*ContentRestrictionGroupPayloadScheme `json:"group,omitempty"`
+}
+
+type ContentRestrictionUserPayloadScheme struct {
+	Type      string `json:"type,omitempty"`
+	AccountID string `json:"accountId,omitempty"`
+}
+
+type ContentRestrictionGroupPayloadScheme struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// Returns the restrictions on a piece of content, grouped by the read and update operations.
+// Docs: N/A
+func (c *ContentRestrictionService) Gets(ctx context.Context, contentID string, expand []string) (result *ContentRestrictionPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/restriction", contentID))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentRestrictionPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Adds restrictions to a piece of content, the existing restrictions are kept.
+// Docs: N/A
+func (c *ContentRestrictionService) Add(ctx context.Context, contentID string, payload []*ContentRestrictionUpdateScheme, expand []string) (result *ContentRestrictionPageScheme, response *Response, err error) {
+	return c.write(ctx, http.MethodPost, contentID, payload, expand)
+}
+
+// Updates the restrictions of a piece of content, the existing restrictions are overwritten.
+// Docs: N/A
+func (c *ContentRestrictionService) Update(ctx context.Context, contentID string, payload []*ContentRestrictionUpdateScheme, expand []string) (result *ContentRestrictionPageScheme, response *Response, err error) {
+	return c.write(ctx, http.MethodPut, contentID, payload, expand)
+}
+
+// Removes all restrictions (read and update) on a piece of content.
+// Docs: N/A
+func (c *ContentRestrictionService) Delete(ctx context.Context, contentID string, expand []string) (result *ContentRestrictionPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/restriction", contentID))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodDelete, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentRestrictionPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c *ContentRestrictionService) write(ctx context.Context, method, contentID string, payload []*ContentRestrictionUpdateScheme, expand []string) (result *ContentRestrictionPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	if len(payload) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid ContentRestrictionUpdateScheme slice")
+	}
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/restriction", contentID))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, method, endpoint.String(), payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentRestrictionPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,754 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestContentRestrictionService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID          string
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			expand:             []string{"restrictions.user", "restrictions.group"},
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/restriction?expand=restrictions.user%2Crestrictions.group",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			expand:             []string{"restrictions.user", "restrictions.group"},
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/restriction?expand=restrictions.user%2Crestrictions.group",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			expand:             []string{"restrictions.user", "restrictions.group"},
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/restriction?expand=restrictions.user%2Crestrictions.group",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			expand:             []string{"restrictions.user", "restrictions.group"},
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/restriction?expand=restrictions.user%2Crestrictions.group",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			contentID:          "65798",
+			expand:             []string{"restrictions.user", "restrictions.group"},
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/restriction?expand=restrictions.user%2Crestrictions.group",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			expand:             []string{"restrictions.user", "restrictions.group"},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/restriction?expand=restrictions.user%2Crestrictions.group",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentRestrictionService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.contentID, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, restriction := range gotResult.Results {
+					t.Log(restriction.Operation, restriction.Restrictions.User.Size, restriction.Restrictions.Group.Size)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentRestrictionService_Add(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID          string
+		payload            []*ContentRestrictionUpdateScheme
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:      "AddWhenTheParametersAreCorrect",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:      "AddWhenTheContentIDIsNotProvided",
+			contentID: "",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddWhenTheRestrictionsAreNotProvided",
+			contentID:          "65798",
+			payload:            nil,
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "AddWhenTheRequestMethodIsIncorrect",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "AddWhenTheStatusCodeIsIncorrect",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:      "AddWhenTheContextIsNil",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "AddWhenTheResponseBodyHasADifferentFormat",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentRestrictionService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Add(testCase.context, testCase.contentID, testCase.payload, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentRestrictionService_Update(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID          string
+		payload            []*ContentRestrictionUpdateScheme
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:      "UpdateWhenTheParametersAreCorrect",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:      "UpdateWhenTheContentIDIsNotProvided",
+			contentID: "",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenTheRestrictionsAreNotProvided",
+			contentID:          "65798",
+			payload:            nil,
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "UpdateWhenTheRequestMethodIsIncorrect",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "UpdateWhenTheStatusCodeIsIncorrect",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:      "UpdateWhenTheContextIsNil",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "UpdateWhenTheResponseBodyHasADifferentFormat",
+			contentID: "65798",
+			payload: []*ContentRestrictionUpdateScheme{
+				{
+					Operation: ReadRestrictionOperation,
+					Restrictions: &ContentRestrictionRestrictionsPayloadScheme{
+						User:  []*ContentRestrictionUserPayloadScheme{{Type: "known", AccountID: "5b86be50b8e3cb5895860d6d"}},
+						Group: []*ContentRestrictionGroupPayloadScheme{{Type: "group", Name: "confluence-users"}},
+					},
+				},
+			},
+			expand:             nil,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentRestrictionService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Update(testCase.context, testCase.contentID, testCase.payload, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentRestrictionService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID          string
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheContextIsNil",
+			contentID:          "65798",
+			expand:             nil,
+			mockFile:           "./mocks/get-content-restrictions.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			expand:             nil,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798/restriction",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentRestrictionService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Delete(testCase.context, testCase.contentID, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
@@ -0,0 +1,195 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ContentVersionService struct{ client *Client }
+
+type ContentVersionPageScheme struct {
+	Results []*ContentVersionScheme `json:"results,omitempty"`
+	Start   int                     `json:"start,omitempty"`
+	Limit   int                     `json:"limit,omitempty"`
+	Size    int                     `json:"size,omitempty"`
+	Links   *LinkScheme             `json:"_links,omitempty"`
+}
+
+type ContentVersionScheme struct {
+	By                  *ContentUserScheme `json:"by,omitempty"`
+	Number              int                `json:"number,omitempty"`
+	When                string             `json:"when,omitempty"`
+	FriendlyWhen        string             `json:"friendlyWhen,omitempty"`
+	Message             string             `json:"message,omitempty"`
+	MinorEdit           bool               `json:"minorEdit,omitempty"`
+	Content             *ContentScheme     `json:"content,omitempty"`
+	ContentTypeModified bool               `json:"contentTypeModified,omitempty"`
+}
+
+type ContentRestorePayloadScheme struct {
+	OperationKey string                             `json:"operationKey,omitempty"`
+	Params       *ContentRestoreParamsPayloadScheme `json:"params,omitempty"`
+}
+
+type ContentRestoreParamsPayloadScheme struct {
+	VersionNumber int    `json:"versionNumber,omitempty"`
+	Message       string `json:"message,omitempty"`
+	RestoreTitle  bool   `json:"restoreTitle,omitempty"`
+}
+
+// Returns the versions for a piece of content in descending order.
+// Docs: N/A
+func (c *ContentVersionService) Gets(ctx context.Context, contentID string, expand []string, start, limit int) (result *ContentVersionPageScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+	params.Add("start", strconv.Itoa(start))
+	params.Add("limit", strconv.Itoa(limit))
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v/version?%v", contentID, params.Encode())
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentVersionPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a version for a piece of content.
+// Docs: N/A
+func (c *ContentVersionService) Get(ctx context.Context, contentID string, versionNumber int, expand []string) (result *ContentVersionScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/version/%v", contentID, versionNumber))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentVersionScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Restores a historical version to be the latest version, a new version is created with the content of the historical version.
+// Docs: N/A
+func (c *ContentVersionService) Restore(ctx context.Context, contentID string, payload *ContentRestorePayloadScheme, expand []string) (result *ContentVersionScheme, response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	if payload == nil || payload.Params == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid ContentRestorePayloadScheme pointer")
+	}
+
+	if len(payload.OperationKey) == 0 {
+		payload.OperationKey = "restore"
+	}
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/version", contentID))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint.String(), payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(ContentVersionScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes a historical version, the versions after the deleted one are renumbered.
+// Docs: N/A
+func (c *ContentVersionService) Delete(ctx context.Context, contentID string, versionNumber int) (response *Response, err error) {
+
+	if len(contentID) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid contentID value")
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/content/%v/version/%v", contentID, versionNumber)
+
+	request, err := c.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	response, err = c.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,1133 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestContentService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		options             *GetContentOptionsScheme
+		startAt, maxResults int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name: "GetsWhenTheParametersAreCorrect",
+			options: &GetContentOptionsScheme{
+				ContentType: PageContentType,
+				SpaceKey:    "DUMMY",
+				Status:      []string{"current"},
+				Expand:      []string{"version", "space"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content?expand=version%2Cspace&limit=50&spaceKey=DUMMY&start=0&status=current&type=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name: "GetsWhenTheRequestMethodIsIncorrect",
+			options: &GetContentOptionsScheme{
+				ContentType: PageContentType,
+				SpaceKey:    "DUMMY",
+				Status:      []string{"current"},
+				Expand:      []string{"version", "space"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content?expand=version%2Cspace&limit=50&spaceKey=DUMMY&start=0&status=current&type=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheStatusCodeIsIncorrect",
+			options: &GetContentOptionsScheme{
+				ContentType: PageContentType,
+				SpaceKey:    "DUMMY",
+				Status:      []string{"current"},
+				Expand:      []string{"version", "space"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content?expand=version%2Cspace&limit=50&spaceKey=DUMMY&start=0&status=current&type=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheContextIsNil",
+			options: &GetContentOptionsScheme{
+				ContentType: PageContentType,
+				SpaceKey:    "DUMMY",
+				Status:      []string{"current"},
+				Expand:      []string{"version", "space"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content?expand=version%2Cspace&limit=50&spaceKey=DUMMY&start=0&status=current&type=page",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheResponseBodyHasADifferentFormat",
+			options: &GetContentOptionsScheme{
+				ContentType: PageContentType,
+				SpaceKey:    "DUMMY",
+				Status:      []string{"current"},
+				Expand:      []string{"version", "space"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content?expand=version%2Cspace&limit=50&spaceKey=DUMMY&start=0&status=current&type=page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheOptionsAreNil",
+			options:            nil,
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content?limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.options, testCase.startAt, testCase.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, content := range gotResult.Results {
+					t.Log(content.ID, content.Title)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		payload            *ContentScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "CreateWhenTheParametersAreCorrect",
+			payload: &ContentScheme{
+				Type:  PageContentType,
+				Title: "Release notes 1.2.0",
+				Space: &SpaceScheme{Key: "DUMMY"},
+				Body: &BodyScheme{
+					Storage: &BodyNodeScheme{
+						Value:          "<p>The release contains the Confluence module</p>",
+						Representation: StorageRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateWhenThePayloadIsNil",
+			payload:            nil,
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheRequestMethodIsIncorrect",
+			payload: &ContentScheme{
+				Type:  PageContentType,
+				Title: "Release notes 1.2.0",
+				Space: &SpaceScheme{Key: "DUMMY"},
+				Body: &BodyScheme{
+					Storage: &BodyNodeScheme{
+						Value:          "<p>The release contains the Confluence module</p>",
+						Representation: StorageRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheStatusCodeIsIncorrect",
+			payload: &ContentScheme{
+				Type:  PageContentType,
+				Title: "Release notes 1.2.0",
+				Space: &SpaceScheme{Key: "DUMMY"},
+				Body: &BodyScheme{
+					Storage: &BodyNodeScheme{
+						Value:          "<p>The release contains the Confluence module</p>",
+						Representation: StorageRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheContextIsNil",
+			payload: &ContentScheme{
+				Type:  PageContentType,
+				Title: "Release notes 1.2.0",
+				Space: &SpaceScheme{Key: "DUMMY"},
+				Body: &BodyScheme{
+					Storage: &BodyNodeScheme{
+						Value:          "<p>The release contains the Confluence module</p>",
+						Representation: StorageRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheResponseBodyHasADifferentFormat",
+			payload: &ContentScheme{
+				Type:  PageContentType,
+				Title: "Release notes 1.2.0",
+				Space: &SpaceScheme{Key: "DUMMY"},
+				Body: &BodyScheme{
+					Storage: &BodyNodeScheme{
+						Value:          "<p>The release contains the Confluence module</p>",
+						Representation: StorageRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID          string
+		expand             []string
+		version            int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			expand:             []string{"body.storage", "version"},
+			version:            3,
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798?expand=body.storage%2Cversion&version=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			expand:             []string{"body.storage", "version"},
+			version:            3,
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798?expand=body.storage%2Cversion&version=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			expand:             []string{"body.storage", "version"},
+			version:            3,
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798?expand=body.storage%2Cversion&version=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			expand:             []string{"body.storage", "version"},
+			version:            3,
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798?expand=body.storage%2Cversion&version=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			contentID:          "65798",
+			expand:             []string{"body.storage", "version"},
+			version:            3,
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798?expand=body.storage%2Cversion&version=3",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			contentID:          "65798",
+			expand:             []string{"body.storage", "version"},
+			version:            3,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798?expand=body.storage%2Cversion&version=3",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheOptionalParametersAreNotProvided",
+			contentID:          "65798",
+			expand:             nil,
+			version:            0,
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.contentID, testCase.expand, testCase.version)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "storage", gotResult.Body.Storage.Representation)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentService_Update(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID          string
+		payload            *ContentScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:      "UpdateWhenTheParametersAreCorrect",
+			contentID: "65798",
+			payload: &ContentScheme{
+				Type:    PageContentType,
+				Title:   "Release notes 1.2.0",
+				Version: &ContentVersionScheme{Number: 4},
+				Body: &BodyScheme{
+					AtlasDocFormat: &BodyNodeScheme{
+						Value:          `{"type":"doc","version":1,"content":[]}`,
+						Representation: AtlasDocFormatRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:      "UpdateWhenTheContentIDIsNotProvided",
+			contentID: "",
+			payload: &ContentScheme{
+				Type:    PageContentType,
+				Title:   "Release notes 1.2.0",
+				Version: &ContentVersionScheme{Number: 4},
+				Body: &BodyScheme{
+					AtlasDocFormat: &BodyNodeScheme{
+						Value:          `{"type":"doc","version":1,"content":[]}`,
+						Representation: AtlasDocFormatRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenThePayloadIsNil",
+			contentID:          "65798",
+			payload:            nil,
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenTheVersionIsNotProvided",
+			contentID:          "65798",
+			payload:            &ContentScheme{Type: PageContentType, Title: "Release notes 1.2.0"},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "UpdateWhenTheRequestMethodIsIncorrect",
+			contentID: "65798",
+			payload: &ContentScheme{
+				Type:    PageContentType,
+				Title:   "Release notes 1.2.0",
+				Version: &ContentVersionScheme{Number: 4},
+				Body: &BodyScheme{
+					AtlasDocFormat: &BodyNodeScheme{
+						Value:          `{"type":"doc","version":1,"content":[]}`,
+						Representation: AtlasDocFormatRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "UpdateWhenTheStatusCodeIsIncorrect",
+			contentID: "65798",
+			payload: &ContentScheme{
+				Type:    PageContentType,
+				Title:   "Release notes 1.2.0",
+				Version: &ContentVersionScheme{Number: 4},
+				Body: &BodyScheme{
+					AtlasDocFormat: &BodyNodeScheme{
+						Value:          `{"type":"doc","version":1,"content":[]}`,
+						Representation: AtlasDocFormatRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:      "UpdateWhenTheContextIsNil",
+			contentID: "65798",
+			payload: &ContentScheme{
+				Type:    PageContentType,
+				Title:   "Release notes 1.2.0",
+				Version: &ContentVersionScheme{Number: 4},
+				Body: &BodyScheme{
+					AtlasDocFormat: &BodyNodeScheme{
+						Value:          `{"type":"doc","version":1,"content":[]}`,
+						Representation: AtlasDocFormatRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/get-content.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:      "UpdateWhenTheResponseBodyHasADifferentFormat",
+			contentID: "65798",
+			payload: &ContentScheme{
+				Type:    PageContentType,
+				Title:   "Release notes 1.2.0",
+				Version: &ContentVersionScheme{Number: 4},
+				Body: &BodyScheme{
+					AtlasDocFormat: &BodyNodeScheme{
+						Value:          `{"type":"doc","version":1,"content":[]}`,
+						Representation: AtlasDocFormatRepresentation,
+					},
+				},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Update(testCase.context, testCase.contentID, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		contentID, status  string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteWhenTheParametersAreCorrect",
+			contentID:          "65798",
+			status:             "trashed",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798?status=trashed",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteWhenTheContentIDIsNotProvided",
+			contentID:          "",
+			status:             "trashed",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798?status=trashed",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRequestMethodIsIncorrect",
+			contentID:          "65798",
+			status:             "trashed",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/65798?status=trashed",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheStatusCodeIsIncorrect",
+			contentID:          "65798",
+			status:             "trashed",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798?status=trashed",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheContextIsNil",
+			contentID:          "65798",
+			status:             "trashed",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798?status=trashed",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheStatusIsNotProvided",
+			contentID:          "65798",
+			status:             "",
+			mockFile:           "",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/content/65798",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentService{client: mockClient}
+
+			gotResponse, err := service.Delete(testCase.context, testCase.contentID, testCase.status)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestContentService_Search(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		cql, cqlContext    string
+		expand             []string
+		cursor             string
+		maxResults         int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "SearchWhenTheParametersAreCorrect",
+			cql:                "type = page AND space = DUMMY",
+			cqlContext:         "",
+			expand:             []string{"space"},
+			cursor:             "",
+			maxResults:         25,
+			mockFile:           "./mocks/search-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/search?cql=type+%3D+page+AND+space+%3D+DUMMY&expand=space&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "SearchWhenTheCQLIsNotProvided",
+			cql:                "",
+			cqlContext:         "",
+			expand:             []string{"space"},
+			cursor:             "",
+			maxResults:         25,
+			mockFile:           "./mocks/search-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/search?cql=type+%3D+page+AND+space+%3D+DUMMY&expand=space&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SearchWhenTheRequestMethodIsIncorrect",
+			cql:                "type = page AND space = DUMMY",
+			cqlContext:         "",
+			expand:             []string{"space"},
+			cursor:             "",
+			maxResults:         25,
+			mockFile:           "./mocks/search-contents.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/content/search?cql=type+%3D+page+AND+space+%3D+DUMMY&expand=space&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SearchWhenTheStatusCodeIsIncorrect",
+			cql:                "type = page AND space = DUMMY",
+			cqlContext:         "",
+			expand:             []string{"space"},
+			cursor:             "",
+			maxResults:         25,
+			mockFile:           "./mocks/search-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/search?cql=type+%3D+page+AND+space+%3D+DUMMY&expand=space&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SearchWhenTheContextIsNil",
+			cql:                "type = page AND space = DUMMY",
+			cqlContext:         "",
+			expand:             []string{"space"},
+			cursor:             "",
+			maxResults:         25,
+			mockFile:           "./mocks/search-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/search?cql=type+%3D+page+AND+space+%3D+DUMMY&expand=space&limit=25",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SearchWhenTheResponseBodyHasADifferentFormat",
+			cql:                "type = page AND space = DUMMY",
+			cqlContext:         "",
+			expand:             []string{"space"},
+			cursor:             "",
+			maxResults:         25,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/search?cql=type+%3D+page+AND+space+%3D+DUMMY&expand=space&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SearchWhenTheCursorIsProvided",
+			cql:                "type = page AND space = DUMMY",
+			cqlContext:         "",
+			expand:             nil,
+			cursor:             "_f_MjU=_sa_WyJcdDY1Nzk5IjBd",
+			maxResults:         25,
+			mockFile:           "./mocks/search-contents.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/content/search?cql=type+%3D+page+AND+space+%3D+DUMMY&cursor=_f_MjU%3D_sa_WyJcdDY1Nzk5IjBd&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ContentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Search(testCase.context, testCase.cql, testCase.cqlContext, testCase.expand, testCase.cursor, testCase.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.NotEmpty(t, gotResult.Links.Next)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/confluence"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	instance, err := confluence.New(nil, host)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetBasicAuth(mail, token)
+	instance.Auth.SetUserAgent("curl/7.54.0")
+
+	file, err := os.Open("architecture.png")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer file.Close()
+
+	attachments, response, err := instance.Content.Attachment.CreateOrUpdate(context.Background(), "65798", "architecture.png", file, "The architecture diagram", true)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+
+	for _, attachment := range attachments.Results {
+		log.Println(attachment.ID, attachment.Title, attachment.Links.Download)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/confluence"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	instance, err := confluence.New(nil, host)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetBasicAuth(mail, token)
+	instance.Auth.SetUserAgent("curl/7.54.0")
+
+	payload := []*confluence.ContentRestrictionUpdateScheme{
+		{
+			Operation: confluence.UpdateRestrictionOperation,
+			Restrictions: &confluence.ContentRestrictionRestrictionsPayloadScheme{
+				Group: []*confluence.ContentRestrictionGroupPayloadScheme{
+					{Type: "group", Name: "site-admins"},
+				},
+			},
+		},
+	}
+
+	restrictions, response, err := instance.Content.Restriction.Update(context.Background(), "65798", payload, []string{"restrictions.group"})
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+
+	for _, restriction := range restrictions.Results {
+		log.Println(restriction.Operation, restriction.Restrictions.Group.Size)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/confluence"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	instance, err := confluence.New(nil, host)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetBasicAuth(mail, token)
+	instance.Auth.SetUserAgent("curl/7.54.0")
+
+	payload := &confluence.ContentScheme{
+		Type:  confluence.PageContentType,
+		Title: "Release notes 1.2.0",
+		Space: &confluence.SpaceScheme{Key: "DUMMY"},
+		Ancestors: []*confluence.ContentScheme{
+			{ID: "65537"},
+		},
+		Body: &confluence.BodyScheme{
+			Storage: &confluence.BodyNodeScheme{
+				Value:          "<p>The release contains the Confluence module</p>",
+				Representation: confluence.StorageRepresentation,
+			},
+		},
+	}
+
+	content, response, err := instance.Content.Create(context.Background(), payload)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+
+	log.Println(content.ID, content.Title, content.Version.Number)
+
+	labels := []*confluence.ContentLabelPayloadScheme{
+		{Prefix: "global", Name: "release-notes"},
+	}
+
+	if _, _, err = instance.Content.Label.Add(context.Background(), content.ID, labels); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/confluence"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	instance, err := confluence.New(nil, host)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetBasicAuth(mail, token)
+	instance.Auth.SetUserAgent("curl/7.54.0")
+
+	var (
+		cql     = "type = page AND label = runbook"
+		options = &confluence.SearchContentOptions{Limit: 25, Excerpt: "highlight"}
+	)
+
+	err = instance.Search.Walk(context.Background(), cql, options, func(result *confluence.SearchResultScheme) (bool, error) {
+		log.Println(result.Content.ID, result.Title, result.URL)
+		return true, nil
+	})
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
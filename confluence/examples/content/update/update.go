@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/confluence"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	instance, err := confluence.New(nil, host)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetBasicAuth(mail, token)
+	instance.Auth.SetUserAgent("curl/7.54.0")
+
+	var contentID = "65798"
+
+	// The update requires the next version number
+	current, _, err := instance.Content.Get(context.Background(), contentID, []string{"version"}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	payload := &confluence.ContentScheme{
+		Type:    confluence.PageContentType,
+		Title:   current.Title,
+		Version: &confluence.ContentVersionScheme{Number: current.Version.Number + 1, Message: "Add the rollback steps"},
+		Body: &confluence.BodyScheme{
+			AtlasDocFormat: &confluence.BodyNodeScheme{
+				Value:          `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Rollback: redeploy the 1.1.0 version"}]}]}`,
+				Representation: confluence.AtlasDocFormatRepresentation,
+			},
+		},
+	}
+
+	content, response, err := instance.Content.Update(context.Background(), contentID, payload)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+
+	log.Println(content.ID, content.Title, content.Version.Number)
+}
@@ -0,0 +1,235 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type SearchService struct{ client *Client }
+
+type SearchContentOptions struct {
+	Context                  string
+	Cursor                   string
+	Next                     bool
+	Prev                     bool
+	Limit                    int
+	Start                    int
+	IncludeArchivedSpaces    bool
+	ExcludeCurrentSpaces     bool
+	SitePermissionTypeFilter string
+	Excerpt                  string
+	Expand                   []string
+}
+
+type SearchPageScheme struct {
+	Results        []*SearchResultScheme `json:"results,omitempty"`
+	Start          int                   `json:"start,omitempty"`
+	Limit          int                   `json:"limit,omitempty"`
+	Size           int                   `json:"size,omitempty"`
+	TotalSize      int                   `json:"totalSize,omitempty"`
+	CqlQuery       string                `json:"cqlQuery,omitempty"`
+	SearchDuration int                   `json:"searchDuration,omitempty"`
+	Links          *LinkScheme           `json:"_links,omitempty"`
+}
+
+type SearchResultScheme struct {
+	Content               *ContentScheme          `json:"content,omitempty"`
+	User                  *ContentUserScheme      `json:"user,omitempty"`
+	Space                 *SpaceScheme            `json:"space,omitempty"`
+	Title                 string                  `json:"title,omitempty"`
+	Excerpt               string                  `json:"excerpt,omitempty"`
+	URL                   string                  `json:"url,omitempty"`
+	ResultGlobalContainer *ContainerSummaryScheme `json:"resultGlobalContainer,omitempty"`
+	EntityType            string                  `json:"entityType,omitempty"`
+	IconCSSClass          string                  `json:"iconCssClass,omitempty"`
+	LastModified          string                  `json:"lastModified,omitempty"`
+	FriendlyLastModified  string                  `json:"friendlyLastModified,omitempty"`
+	Score                 float64                 `json:"score,omitempty"`
+}
+
+type ContainerSummaryScheme struct {
+	Title      string `json:"title,omitempty"`
+	DisplayURL string `json:"displayUrl,omitempty"`
+}
+
+// Cursor returns the cursor of the next page, it's empty on the last page
+func (s *SearchPageScheme) Cursor() string {
+
+	if s == nil || s.Links == nil || len(s.Links.Next) == 0 {
+		return ""
+	}
+
+	next, err := url.Parse(s.Links.Next)
+	if err != nil {
+		return ""
+	}
+
+	return next.Query().Get("cursor")
+}
+
+// Searches for content using the Confluence Query Language (CQL), the next pages are requested with the cursor option.
+// Docs: N/A
+func (s *SearchService) Content(ctx context.Context, cql string, options *SearchContentOptions) (result *SearchPageScheme, response *Response, err error) {
+
+	if len(cql) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid cql value")
+	}
+
+	params := url.Values{}
+	params.Add("cql", cql)
+
+	if options != nil {
+
+		if len(options.Context) != 0 {
+			params.Add("cqlcontext", options.Context)
+		}
+
+		if len(options.Cursor) != 0 {
+			params.Add("cursor", options.Cursor)
+		}
+
+		if options.Next {
+			params.Add("next", "true")
+		}
+
+		if options.Prev {
+			params.Add("prev", "true")
+		}
+
+		if options.Limit != 0 {
+			params.Add("limit", strconv.Itoa(options.Limit))
+		}
+
+		if options.Start != 0 {
+			params.Add("start", strconv.Itoa(options.Start))
+		}
+
+		if options.IncludeArchivedSpaces {
+			params.Add("includeArchivedSpaces", "true")
+		}
+
+		if options.ExcludeCurrentSpaces {
+			params.Add("excludeCurrentSpaces", "true")
+		}
+
+		if len(options.SitePermissionTypeFilter) != 0 {
+			params.Add("sitePermissionTypeFilter", options.SitePermissionTypeFilter)
+		}
+
+		if len(options.Excerpt) != 0 {
+			params.Add("excerpt", options.Excerpt)
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/search?%v", params.Encode())
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SearchPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Searches for users using the user-specific queries of the Confluence Query Language (CQL), e.g: user.fullname ~ "Carlos".
+// Docs: N/A
+func (s *SearchService) Users(ctx context.Context, cql string, start, limit int, expand []string) (result *SearchPageScheme, response *Response, err error) {
+
+	if len(cql) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid cql value")
+	}
+
+	params := url.Values{}
+	params.Add("cql", cql)
+	params.Add("start", strconv.Itoa(start))
+	params.Add("limit", strconv.Itoa(limit))
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/search/user?%v", params.Encode())
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SearchPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Walk searches for content using CQL and calls the callback with every result, following the cursor of the next pages.
+// The walk stops when the callback returns false or an error.
+func (s *SearchService) Walk(ctx context.Context, cql string, options *SearchContentOptions, callback func(result *SearchResultScheme) (bool, error)) (err error) {
+
+	if callback == nil {
+		return fmt.Errorf("error, please provide a valid callback function")
+	}
+
+	pageOptions := SearchContentOptions{}
+	if options != nil {
+		pageOptions = *options
+	}
+
+	for {
+
+		page, _, err := s.Content(ctx, cql, &pageOptions)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range page.Results {
+
+			next, err := callback(result)
+			if err != nil {
+				return err
+			}
+
+			if !next {
+				return nil
+			}
+		}
+
+		cursor := page.Cursor()
+		if len(cursor) == 0 || len(page.Results) == 0 {
+			return nil
+		}
+
+		pageOptions.Cursor = cursor
+		pageOptions.Next = true
+		pageOptions.Start = 0
+	}
+}
@@ -0,0 +1,444 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestSearchService_Content(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		cql                string
+		options            *SearchContentOptions
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "ContentWhenTheParametersAreCorrect",
+			cql:  "type = page",
+			options: &SearchContentOptions{
+				Limit:                 25,
+				IncludeArchivedSpaces: true,
+				Excerpt:               "highlight",
+			},
+			mockFile:           "./mocks/search.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search?cql=type+%3D+page&excerpt=highlight&includeArchivedSpaces=true&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name: "ContentWhenTheCQLIsNotProvided",
+			cql:  "",
+			options: &SearchContentOptions{
+				Limit:                 25,
+				IncludeArchivedSpaces: true,
+				Excerpt:               "highlight",
+			},
+			mockFile:           "./mocks/search.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search?cql=type+%3D+page&excerpt=highlight&includeArchivedSpaces=true&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "ContentWhenTheRequestMethodIsIncorrect",
+			cql:  "type = page",
+			options: &SearchContentOptions{
+				Limit:                 25,
+				IncludeArchivedSpaces: true,
+				Excerpt:               "highlight",
+			},
+			mockFile:           "./mocks/search.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/search?cql=type+%3D+page&excerpt=highlight&includeArchivedSpaces=true&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "ContentWhenTheStatusCodeIsIncorrect",
+			cql:  "type = page",
+			options: &SearchContentOptions{
+				Limit:                 25,
+				IncludeArchivedSpaces: true,
+				Excerpt:               "highlight",
+			},
+			mockFile:           "./mocks/search.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search?cql=type+%3D+page&excerpt=highlight&includeArchivedSpaces=true&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "ContentWhenTheContextIsNil",
+			cql:  "type = page",
+			options: &SearchContentOptions{
+				Limit:                 25,
+				IncludeArchivedSpaces: true,
+				Excerpt:               "highlight",
+			},
+			mockFile:           "./mocks/search.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search?cql=type+%3D+page&excerpt=highlight&includeArchivedSpaces=true&limit=25",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "ContentWhenTheResponseBodyHasADifferentFormat",
+			cql:  "type = page",
+			options: &SearchContentOptions{
+				Limit:                 25,
+				IncludeArchivedSpaces: true,
+				Excerpt:               "highlight",
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search?cql=type+%3D+page&excerpt=highlight&includeArchivedSpaces=true&limit=25",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ContentWhenTheCursorIsProvided",
+			cql:                "type = page",
+			options:            &SearchContentOptions{Cursor: "_f_MjU=", Next: true, Limit: 25},
+			mockFile:           "./mocks/search.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search?cql=type+%3D+page&cursor=_f_MjU%3D&limit=25&next=true",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ContentWhenTheOptionsAreNil",
+			cql:                "type = page",
+			options:            nil,
+			mockFile:           "./mocks/search.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search?cql=type+%3D+page",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SearchService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Content(testCase.context, testCase.cql, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, result := range gotResult.Results {
+					t.Log(result.EntityType, result.Title, result.Excerpt)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestSearchService_Users(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		cql                string
+		start, limit       int
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "UsersWhenTheParametersAreCorrect",
+			cql:                "type = user",
+			start:              0,
+			limit:              25,
+			expand:             nil,
+			mockFile:           "./mocks/search-users.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search/user?cql=type+%3D+user&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "UsersWhenTheCQLIsNotProvided",
+			cql:                "",
+			start:              0,
+			limit:              25,
+			expand:             nil,
+			mockFile:           "./mocks/search-users.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search/user?cql=type+%3D+user&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UsersWhenTheRequestMethodIsIncorrect",
+			cql:                "type = user",
+			start:              0,
+			limit:              25,
+			expand:             nil,
+			mockFile:           "./mocks/search-users.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/search/user?cql=type+%3D+user&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UsersWhenTheStatusCodeIsIncorrect",
+			cql:                "type = user",
+			start:              0,
+			limit:              25,
+			expand:             nil,
+			mockFile:           "./mocks/search-users.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search/user?cql=type+%3D+user&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UsersWhenTheContextIsNil",
+			cql:                "type = user",
+			start:              0,
+			limit:              25,
+			expand:             nil,
+			mockFile:           "./mocks/search-users.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search/user?cql=type+%3D+user&limit=25&start=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UsersWhenTheResponseBodyHasADifferentFormat",
+			cql:                "type = user",
+			start:              0,
+			limit:              25,
+			expand:             nil,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/search/user?cql=type+%3D+user&limit=25&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SearchService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Users(testCase.context, testCase.cql, testCase.start, testCase.limit, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "5b86be50b8e3cb5895860d6d", gotResult.Results[0].User.AccountID)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestSearchService_Walk(t *testing.T) {
+
+	var cursors []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/wiki/rest/api/search", func(w http.ResponseWriter, r *http.Request) {
+
+		cursors = append(cursors, r.URL.Query().Get("cursor"))
+
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			_, _ = w.Write([]byte(`{"results": [{"title": "Release notes 1.1.0"}, {"title": "Release notes 1.2.0"}],
+				"_links": {"next": "/rest/api/search?next=true&cursor=second-page&limit=2&cql=type%3Dpage"}}`))
+		case "second-page":
+			_, _ = w.Write([]byte(`{"results": [{"title": "Runbook - Database failover"}], "_links": {}}`))
+		default:
+			http.Error(w, "unexpected cursor", http.StatusBadRequest)
+		}
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var titles []string
+	err = mockClient.Search.Walk(context.Background(), "type = page", &SearchContentOptions{Limit: 2}, func(result *SearchResultScheme) (bool, error) {
+		titles = append(titles, result.Title)
+		return true, nil
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"Release notes 1.1.0", "Release notes 1.2.0", "Runbook - Database failover"}, titles)
+		assert.Equal(t, []string{"", "second-page"}, cursors)
+	}
+
+	// The walk stops when the callback returns false
+	titles, cursors = nil, nil
+	err = mockClient.Search.Walk(context.Background(), "type = page", nil, func(result *SearchResultScheme) (bool, error) {
+		titles = append(titles, result.Title)
+		return false, nil
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"Release notes 1.1.0"}, titles)
+		assert.Len(t, cursors, 1)
+	}
+
+	err = mockClient.Search.Walk(context.Background(), "type = page", nil, func(result *SearchResultScheme) (bool, error) {
+		return false, fmt.Errorf("the page can't be exported")
+	})
+	assert.Error(t, err)
+
+	err = mockClient.Search.Walk(context.Background(), "", nil, func(result *SearchResultScheme) (bool, error) {
+		return true, nil
+	})
+	assert.Error(t, err)
+
+	err = mockClient.Search.Walk(context.Background(), "type = page", nil, nil)
+	assert.Error(t, err)
+}
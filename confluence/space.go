@@ -0,0 +1,333 @@
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type SpaceService struct{ client *Client }
+
+type SpacePageScheme struct {
+	Results []*SpaceScheme `json:"results,omitempty"`
+	Start   int            `json:"start,omitempty"`
+	Limit   int            `json:"limit,omitempty"`
+	Size    int            `json:"size,omitempty"`
+	Links   *LinkScheme    `json:"_links,omitempty"`
+}
+
+type SpaceScheme struct {
+	ID          int                     `json:"id,omitempty"`
+	Key         string                  `json:"key,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Type        string                  `json:"type,omitempty"`
+	Status      string                  `json:"status,omitempty"`
+	HomePage    *ContentScheme          `json:"homepage,omitempty"`
+	Description *SpaceDescriptionScheme `json:"description,omitempty"`
+	Expandable  *ExpandableScheme       `json:"_expandable,omitempty"`
+	Links       *LinkScheme             `json:"_links,omitempty"`
+}
+
+type SpaceDescriptionScheme struct {
+	Plain *BodyNodeScheme `json:"plain,omitempty"`
+	View  *BodyNodeScheme `json:"view,omitempty"`
+}
+
+type GetSpacesOptionScheme struct {
+	SpaceKeys        []string
+	SpaceIDs         []int
+	SpaceType        string
+	Status           string
+	Labels           []string
+	Favourite        bool
+	FavouriteUserKey string
+	Expand           []string
+}
+
+type CreateSpaceScheme struct {
+	Key         string                  `json:"key,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Description *SpaceDescriptionScheme `json:"description,omitempty"`
+}
+
+type UpdateSpaceScheme struct {
+	Name        string                     `json:"name,omitempty"`
+	Description *SpaceDescriptionScheme    `json:"description,omitempty"`
+	HomePage    *UpdateSpaceHomePageScheme `json:"homepage,omitempty"`
+	Type        string                     `json:"type,omitempty"`
+	Status      string                     `json:"status,omitempty"`
+}
+
+type UpdateSpaceHomePageScheme struct {
+	ID string `json:"id"`
+}
+
+type SpaceContentScheme struct {
+	Page     *ContentPageScheme `json:"page,omitempty"`
+	BlogPost *ContentPageScheme `json:"blogpost,omitempty"`
+	Links    *LinkScheme        `json:"_links,omitempty"`
+}
+
+type SpaceLongTaskScheme struct {
+	ID    string      `json:"id,omitempty"`
+	Links *LinkScheme `json:"links,omitempty"`
+}
+
+// Returns all spaces, the results can be filtered by the space keys, the type, the status or the labels.
+// Docs: N/A
+func (s *SpaceService) Gets(ctx context.Context, options *GetSpacesOptionScheme, startAt, maxResults int) (result *SpacePageScheme, response *Response, err error) {
+
+	params := url.Values{}
+	params.Add("start", strconv.Itoa(startAt))
+	params.Add("limit", strconv.Itoa(maxResults))
+
+	if options != nil {
+
+		for _, key := range options.SpaceKeys {
+			params.Add("spaceKey", key)
+		}
+
+		for _, id := range options.SpaceIDs {
+			params.Add("spaceId", strconv.Itoa(id))
+		}
+
+		if len(options.SpaceType) != 0 {
+			params.Add("type", options.SpaceType)
+		}
+
+		if len(options.Status) != 0 {
+			params.Add("status", options.Status)
+		}
+
+		if len(options.Labels) != 0 {
+			params.Add("label", strings.Join(options.Labels, ","))
+		}
+
+		if options.Favourite {
+			params.Add("favourite", "true")
+		}
+
+		if len(options.FavouriteUserKey) != 0 {
+			params.Add("favouriteUserKey", options.FavouriteUserKey)
+		}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/space?%v", params.Encode())
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SpacePageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Creates a new space, the private spaces are only visible to the creator.
+// Docs: N/A
+func (s *SpaceService) Create(ctx context.Context, payload *CreateSpaceScheme, private bool) (result *SpaceScheme, response *Response, err error) {
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid CreateSpaceScheme pointer")
+	}
+
+	if len(payload.Key) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid space key value")
+	}
+
+	if len(payload.Name) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid space name value")
+	}
+
+	var endpoint = "wiki/rest/api/space"
+	if private {
+		endpoint = "wiki/rest/api/space/_private"
+	}
+
+	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SpaceScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a space.
+// Docs: N/A
+func (s *SpaceService) Get(ctx context.Context, spaceKey string, expand []string) (result *SpaceScheme, response *Response, err error) {
+
+	if len(spaceKey) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid spaceKey value")
+	}
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/space/%v", spaceKey))
+
+	if params.Encode() != "" {
+		endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+	}
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SpaceScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Updates the name, description or homepage of a space.
+// Docs: N/A
+func (s *SpaceService) Update(ctx context.Context, spaceKey string, payload *UpdateSpaceScheme) (result *SpaceScheme, response *Response, err error) {
+
+	if len(spaceKey) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid spaceKey value")
+	}
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid UpdateSpaceScheme pointer")
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/space/%v", spaceKey)
+
+	request, err := s.client.newRequest(ctx, http.MethodPut, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SpaceScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes a space, the space is deleted in a long running task, so it's not deleted when the method returns.
+// Docs: N/A
+func (s *SpaceService) Delete(ctx context.Context, spaceKey string) (result *SpaceLongTaskScheme, response *Response, err error) {
+
+	if len(spaceKey) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid spaceKey value")
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/space/%v", spaceKey)
+
+	request, err := s.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SpaceLongTaskScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns all content in a space, grouped by the page and blogpost types.
+// Docs: N/A
+func (s *SpaceService) Content(ctx context.Context, spaceKey, depth string, expand []string, startAt, maxResults int) (result *SpaceContentScheme, response *Response, err error) {
+
+	if len(spaceKey) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid spaceKey value")
+	}
+
+	params := url.Values{}
+	params.Add("start", strconv.Itoa(startAt))
+	params.Add("limit", strconv.Itoa(maxResults))
+
+	if len(depth) != 0 {
+		params.Add("depth", depth)
+	}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	var endpoint = fmt.Sprintf("wiki/rest/api/space/%v/content?%v", spaceKey, params.Encode())
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SpaceContentScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,1071 @@
+package confluence
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestSpaceService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		options             *GetSpacesOptionScheme
+		startAt, maxResults int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name: "GetsWhenTheParametersAreCorrect",
+			options: &GetSpacesOptionScheme{
+				SpaceKeys: []string{"DUMMY", "OPS"},
+				SpaceType: "global",
+				Status:    "current",
+				Expand:    []string{"homepage"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-spaces.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space?expand=homepage&limit=50&spaceKey=DUMMY&spaceKey=OPS&start=0&status=current&type=global",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name: "GetsWhenTheRequestMethodIsIncorrect",
+			options: &GetSpacesOptionScheme{
+				SpaceKeys: []string{"DUMMY", "OPS"},
+				SpaceType: "global",
+				Status:    "current",
+				Expand:    []string{"homepage"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-spaces.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space?expand=homepage&limit=50&spaceKey=DUMMY&spaceKey=OPS&start=0&status=current&type=global",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheStatusCodeIsIncorrect",
+			options: &GetSpacesOptionScheme{
+				SpaceKeys: []string{"DUMMY", "OPS"},
+				SpaceType: "global",
+				Status:    "current",
+				Expand:    []string{"homepage"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-spaces.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space?expand=homepage&limit=50&spaceKey=DUMMY&spaceKey=OPS&start=0&status=current&type=global",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheContextIsNil",
+			options: &GetSpacesOptionScheme{
+				SpaceKeys: []string{"DUMMY", "OPS"},
+				SpaceType: "global",
+				Status:    "current",
+				Expand:    []string{"homepage"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-spaces.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space?expand=homepage&limit=50&spaceKey=DUMMY&spaceKey=OPS&start=0&status=current&type=global",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheResponseBodyHasADifferentFormat",
+			options: &GetSpacesOptionScheme{
+				SpaceKeys: []string{"DUMMY", "OPS"},
+				SpaceType: "global",
+				Status:    "current",
+				Expand:    []string{"homepage"},
+			},
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space?expand=homepage&limit=50&spaceKey=DUMMY&spaceKey=OPS&start=0&status=current&type=global",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheOptionsAreNil",
+			options:            nil,
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-spaces.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space?limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SpaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.options, testCase.startAt, testCase.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, space := range gotResult.Results {
+					t.Log(space.ID, space.Key, space.Name)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestSpaceService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		payload            *CreateSpaceScheme
+		private            bool
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "CreateWhenTheParametersAreCorrect",
+			payload: &CreateSpaceScheme{
+				Key:  "DUMMY",
+				Name: "Dummy Space",
+				Description: &SpaceDescriptionScheme{
+					Plain: &BodyNodeScheme{Value: "The release notes and runbooks", Representation: "plain"},
+				},
+			},
+			private:            false,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateWhenThePayloadIsNil",
+			payload:            nil,
+			private:            false,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheKeyIsNotProvided",
+			payload:            &CreateSpaceScheme{Name: "Dummy Space"},
+			private:            false,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheNameIsNotProvided",
+			payload:            &CreateSpaceScheme{Key: "DUMMY"},
+			private:            false,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheRequestMethodIsIncorrect",
+			payload: &CreateSpaceScheme{
+				Key:  "DUMMY",
+				Name: "Dummy Space",
+				Description: &SpaceDescriptionScheme{
+					Plain: &BodyNodeScheme{Value: "The release notes and runbooks", Representation: "plain"},
+				},
+			},
+			private:            false,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheStatusCodeIsIncorrect",
+			payload: &CreateSpaceScheme{
+				Key:  "DUMMY",
+				Name: "Dummy Space",
+				Description: &SpaceDescriptionScheme{
+					Plain: &BodyNodeScheme{Value: "The release notes and runbooks", Representation: "plain"},
+				},
+			},
+			private:            false,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheContextIsNil",
+			payload: &CreateSpaceScheme{
+				Key:  "DUMMY",
+				Name: "Dummy Space",
+				Description: &SpaceDescriptionScheme{
+					Plain: &BodyNodeScheme{Value: "The release notes and runbooks", Representation: "plain"},
+				},
+			},
+			private:            false,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheResponseBodyHasADifferentFormat",
+			payload: &CreateSpaceScheme{
+				Key:  "DUMMY",
+				Name: "Dummy Space",
+				Description: &SpaceDescriptionScheme{
+					Plain: &BodyNodeScheme{Value: "The release notes and runbooks", Representation: "plain"},
+				},
+			},
+			private:            false,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheSpaceIsPrivate",
+			payload: &CreateSpaceScheme{
+				Key:  "DUMMY",
+				Name: "Dummy Space",
+				Description: &SpaceDescriptionScheme{
+					Plain: &BodyNodeScheme{Value: "The release notes and runbooks", Representation: "plain"},
+				},
+			},
+			private:            true,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space/_private",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SpaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.payload, testCase.private)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestSpaceService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		spaceKey           string
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			spaceKey:           "DUMMY",
+			expand:             []string{"homepage", "description.plain"},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY?expand=homepage%2Cdescription.plain",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheSpaceKeyIsNotProvided",
+			spaceKey:           "",
+			expand:             []string{"homepage", "description.plain"},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY?expand=homepage%2Cdescription.plain",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			spaceKey:           "DUMMY",
+			expand:             []string{"homepage", "description.plain"},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space/DUMMY?expand=homepage%2Cdescription.plain",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			spaceKey:           "DUMMY",
+			expand:             []string{"homepage", "description.plain"},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY?expand=homepage%2Cdescription.plain",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			spaceKey:           "DUMMY",
+			expand:             []string{"homepage", "description.plain"},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY?expand=homepage%2Cdescription.plain",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			spaceKey:           "DUMMY",
+			expand:             []string{"homepage", "description.plain"},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY?expand=homepage%2Cdescription.plain",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SpaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.spaceKey, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "65537", gotResult.HomePage.ID)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestSpaceService_Update(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		spaceKey           string
+		payload            *UpdateSpaceScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:     "UpdateWhenTheParametersAreCorrect",
+			spaceKey: "DUMMY",
+			payload: &UpdateSpaceScheme{
+				Name:     "Dummy Space",
+				HomePage: &UpdateSpaceHomePageScheme{ID: "65798"},
+			},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:     "UpdateWhenTheSpaceKeyIsNotProvided",
+			spaceKey: "",
+			payload: &UpdateSpaceScheme{
+				Name:     "Dummy Space",
+				HomePage: &UpdateSpaceHomePageScheme{ID: "65798"},
+			},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "UpdateWhenThePayloadIsNil",
+			spaceKey:           "DUMMY",
+			payload:            nil,
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:     "UpdateWhenTheRequestMethodIsIncorrect",
+			spaceKey: "DUMMY",
+			payload: &UpdateSpaceScheme{
+				Name:     "Dummy Space",
+				HomePage: &UpdateSpaceHomePageScheme{ID: "65798"},
+			},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:     "UpdateWhenTheStatusCodeIsIncorrect",
+			spaceKey: "DUMMY",
+			payload: &UpdateSpaceScheme{
+				Name:     "Dummy Space",
+				HomePage: &UpdateSpaceHomePageScheme{ID: "65798"},
+			},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:     "UpdateWhenTheContextIsNil",
+			spaceKey: "DUMMY",
+			payload: &UpdateSpaceScheme{
+				Name:     "Dummy Space",
+				HomePage: &UpdateSpaceHomePageScheme{ID: "65798"},
+			},
+			mockFile:           "./mocks/get-space.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:     "UpdateWhenTheResponseBodyHasADifferentFormat",
+			spaceKey: "DUMMY",
+			payload: &UpdateSpaceScheme{
+				Name:     "Dummy Space",
+				HomePage: &UpdateSpaceHomePageScheme{ID: "65798"},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SpaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Update(testCase.context, testCase.spaceKey, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestSpaceService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		spaceKey           string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteWhenTheParametersAreCorrect",
+			spaceKey:           "DUMMY",
+			mockFile:           "./mocks/delete-space.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteWhenTheSpaceKeyIsNotProvided",
+			spaceKey:           "",
+			mockFile:           "./mocks/delete-space.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRequestMethodIsIncorrect",
+			spaceKey:           "DUMMY",
+			mockFile:           "./mocks/delete-space.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheStatusCodeIsIncorrect",
+			spaceKey:           "DUMMY",
+			mockFile:           "./mocks/delete-space.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheContextIsNil",
+			spaceKey:           "DUMMY",
+			mockFile:           "./mocks/delete-space.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheResponseBodyHasADifferentFormat",
+			spaceKey:           "DUMMY",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/wiki/rest/api/space/DUMMY",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SpaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Delete(testCase.context, testCase.spaceKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "1279043", gotResult.ID)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestSpaceService_Content(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		spaceKey, depth     string
+		expand              []string
+		startAt, maxResults int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "ContentWhenTheParametersAreCorrect",
+			spaceKey:           "DUMMY",
+			depth:              "root",
+			expand:             nil,
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-space-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY/content?depth=root&limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ContentWhenTheSpaceKeyIsNotProvided",
+			spaceKey:           "",
+			depth:              "root",
+			expand:             nil,
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-space-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY/content?depth=root&limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ContentWhenTheRequestMethodIsIncorrect",
+			spaceKey:           "DUMMY",
+			depth:              "root",
+			expand:             nil,
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-space-content.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/wiki/rest/api/space/DUMMY/content?depth=root&limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ContentWhenTheStatusCodeIsIncorrect",
+			spaceKey:           "DUMMY",
+			depth:              "root",
+			expand:             nil,
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-space-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY/content?depth=root&limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ContentWhenTheContextIsNil",
+			spaceKey:           "DUMMY",
+			depth:              "root",
+			expand:             nil,
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-space-content.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY/content?depth=root&limit=50&start=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ContentWhenTheResponseBodyHasADifferentFormat",
+			spaceKey:           "DUMMY",
+			depth:              "root",
+			expand:             nil,
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/wiki/rest/api/space/DUMMY/content?depth=root&limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &SpaceService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Content(testCase.context, testCase.spaceKey, testCase.depth, testCase.expand, testCase.startAt, testCase.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, post := range gotResult.BlogPost.Results {
+					t.Log(post.ID, post.Title)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
@@ -0,0 +1,376 @@
+// Package httplog logs the requests sent by the go-atlassian clients, the credentials of the
+// headers, the query parameters and the JSON bodies are redacted before they're logged.
+package httplog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Logger is satisfied by *slog.Logger and the loggers with the same key/value methods
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// Options configures the request logs, the zero value logs the requests without the bodies
+type Options struct {
+	DumpBody      bool     // Logs the headers and the bodies of the requests and the responses
+	MaxBodySize   int      // The maximum number of bytes of the body logged, defaults to DefaultMaxBodySize
+	RedactHeaders []string // Headers redacted besides the Authorization and the cookies
+	RedactPaths   []string // JSON paths redacted besides the tokens and passwords, e.g: "data.email" or "values.*.secret"
+}
+
+const (
+	DefaultMaxBodySize = 16 * 1024
+	Redacted           = "[REDACTED]"
+)
+
+var (
+	redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+	// redactedKeys are compared in lower case and without the "_" and "-" separators
+	redactedKeys = map[string]bool{
+		"token": true, "accesstoken": true, "refreshtoken": true, "apitoken": true, "idtoken": true,
+		"password": true, "secret": true, "clientsecret": true, "sharedsecret": true, "apikey": true,
+	}
+)
+
+// Hook logs the requests of a client, the nil hook doesn't log anything
+type Hook struct {
+	logger  Logger
+	options Options
+	headers map[string]bool
+	paths   [][]string
+}
+
+// New returns the hook of the logger, it returns nil when the logger is nil
+func New(logger Logger, options *Options) *Hook {
+
+	if logger == nil {
+		return nil
+	}
+
+	hook := &Hook{logger: logger, headers: make(map[string]bool)}
+
+	if options != nil {
+		hook.options = *options
+	}
+
+	if hook.options.MaxBodySize <= 0 {
+		hook.options.MaxBodySize = DefaultMaxBodySize
+	}
+
+	for _, header := range append(redactedHeaders, hook.options.RedactHeaders...) {
+		hook.headers[http.CanonicalHeaderKey(header)] = true
+	}
+
+	for _, path := range hook.options.RedactPaths {
+		if len(path) != 0 {
+			hook.paths = append(hook.paths, strings.Split(path, "."))
+		}
+	}
+
+	return hook
+}
+
+// Exchange is a request in flight, it's finished once the response or the error is received
+type Exchange struct {
+	hook    *Hook
+	request *http.Request
+	started time.Time
+	body    string
+}
+
+// Start is called before the request is sent, the request body is captured when the bodies are dumped
+func (h *Hook) Start(request *http.Request) *Exchange {
+
+	if h == nil {
+		return nil
+	}
+
+	exchange := &Exchange{hook: h, request: request, started: time.Now()}
+
+	if h.options.DumpBody && request.GetBody != nil {
+
+		if body, err := request.GetBody(); err == nil {
+
+			content, _ := ioutil.ReadAll(body)
+			_ = body.Close()
+
+			exchange.body = h.dumpBody(request.Header.Get("Content-Type"), content)
+		}
+	}
+
+	return exchange
+}
+
+// Finish logs the response of the request, the response body is buffered and restored when the bodies are dumped
+func (e *Exchange) Finish(response *http.Response, err error) {
+
+	if e == nil {
+		return
+	}
+
+	h := e.hook
+
+	args := []interface{}{
+		"method", e.request.Method,
+		"endpoint", h.endpoint(e.request.URL),
+		"latency", time.Since(e.started),
+	}
+
+	if err != nil {
+		h.logger.Error("atlassian request failed", append(args, "error", err.Error())...)
+		return
+	}
+
+	args = append(args, "status", response.StatusCode)
+	args = append(args, rateLimitArgs(response.Header)...)
+
+	if h.options.DumpBody {
+
+		args = append(args, "request.headers", h.dumpHeaders(e.request.Header))
+
+		if len(e.body) != 0 {
+			args = append(args, "request.body", e.body)
+		}
+
+		args = append(args, "response.headers", h.dumpHeaders(response.Header))
+
+		if response.Body != nil {
+
+			content, readErr := ioutil.ReadAll(response.Body)
+			_ = response.Body.Close()
+
+			response.Body = ioutil.NopCloser(bytes.NewReader(content))
+			response.ContentLength = int64(len(content))
+
+			if readErr != nil {
+				args = append(args, "response.error", readErr.Error())
+			}
+
+			if len(content) != 0 {
+				args = append(args, "response.body", h.dumpBody(response.Header.Get("Content-Type"), content))
+			}
+		}
+	}
+
+	if response.StatusCode >= http.StatusInternalServerError {
+		h.logger.Error("atlassian request", args...)
+		return
+	}
+
+	h.logger.Debug("atlassian request", args...)
+}
+
+// Retry logs a request that's sent again, e.g: when the API returned the 429 status code
+func (h *Hook) Retry(method, endpoint string, attempt int, wait time.Duration, status int) {
+
+	if h == nil {
+		return
+	}
+
+	if parsed, err := url.Parse(endpoint); err == nil {
+		endpoint = h.endpoint(parsed)
+	}
+
+	h.logger.Debug("atlassian request retry",
+		"method", method,
+		"endpoint", endpoint,
+		"status", status,
+		"attempt", attempt,
+		"wait", wait,
+	)
+}
+
+// endpoint returns the URL with the credentials of the query parameters redacted
+func (h *Hook) endpoint(endpoint *url.URL) string {
+
+	redacted := *endpoint
+	redacted.User = nil
+
+	if len(redacted.RawQuery) != 0 {
+
+		query := redacted.Query()
+
+		var changed bool
+		for key := range query {
+			if isRedactedKey(key) {
+				query.Set(key, Redacted)
+				changed = true
+			}
+		}
+
+		if changed {
+			redacted.RawQuery = query.Encode()
+		}
+	}
+
+	return redacted.String()
+}
+
+func (h *Hook) dumpHeaders(headers http.Header) string {
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var lines []string
+	for _, name := range names {
+
+		value := strings.Join(headers[name], ", ")
+		if h.headers[http.CanonicalHeaderKey(name)] || isRedactedKey(name) {
+			value = Redacted
+		}
+
+		lines = append(lines, fmt.Sprintf("%v: %v", name, value))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (h *Hook) dumpBody(contentType string, content []byte) string {
+
+	if len(content) == 0 {
+		return ""
+	}
+
+	// The numbers are decoded as json.Number to keep the IDs and the other large numbers intact
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+
+		// The attachments and the other binary bodies aren't logged
+		if len(contentType) != 0 && !strings.HasPrefix(contentType, "text/") && !strings.Contains(contentType, "json") &&
+			!strings.Contains(contentType, "xml") {
+			return fmt.Sprintf("[%d bytes of %v]", len(content), contentType)
+		}
+
+		return h.truncate(string(content))
+	}
+
+	value = redactKeys(value)
+	for _, path := range h.paths {
+		value = redactPath(value, path)
+	}
+
+	var redacted bytes.Buffer
+
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprintf("[%d bytes of %v]", len(content), contentType)
+	}
+
+	return h.truncate(strings.TrimSuffix(redacted.String(), "\n"))
+}
+
+func (h *Hook) truncate(body string) string {
+
+	if len(body) <= h.options.MaxBodySize {
+		return body
+	}
+
+	return fmt.Sprintf("%v...[truncated %d bytes]", body[:h.options.MaxBodySize], len(body)-h.options.MaxBodySize)
+}
+
+// rateLimitArgs returns the rate limit headers of Jira, Confluence, Bitbucket and Opsgenie
+func rateLimitArgs(headers http.Header) (args []interface{}) {
+
+	var names []string
+	for name := range headers {
+
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, "x-ratelimit") || strings.HasPrefix(lower, "ratelimit") || lower == "retry-after" {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		args = append(args, strings.ToLower(name), headers.Get(name))
+	}
+
+	return
+}
+
+func isRedactedKey(key string) bool {
+	key = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	return redactedKeys[key]
+}
+
+func redactKeys(value interface{}) interface{} {
+
+	switch typed := value.(type) {
+
+	case map[string]interface{}:
+		for key, nested := range typed {
+			if isRedactedKey(key) {
+				typed[key] = Redacted
+				continue
+			}
+
+			typed[key] = redactKeys(nested)
+		}
+
+	case []interface{}:
+		for index, nested := range typed {
+			typed[index] = redactKeys(nested)
+		}
+	}
+
+	return value
+}
+
+// redactPath redacts the values of the path, "*" matches any key or array element and the arrays
+// are also traversed without it, e.g: "issues.fields.summary" and "issues.*.fields.summary" are equal
+func redactPath(value interface{}, path []string) interface{} {
+
+	switch typed := value.(type) {
+
+	case []interface{}:
+		for index, nested := range typed {
+
+			switch {
+			case path[0] == "*" && len(path) == 1:
+				typed[index] = Redacted
+			case path[0] == "*":
+				typed[index] = redactPath(nested, path[1:])
+			default:
+				typed[index] = redactPath(nested, path)
+			}
+		}
+
+	case map[string]interface{}:
+		for key, nested := range typed {
+
+			if path[0] != "*" && path[0] != key {
+				continue
+			}
+
+			if len(path) == 1 {
+				typed[key] = Redacted
+				continue
+			}
+
+			typed[key] = redactPath(nested, path[1:])
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,182 @@
+package httplog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/ctreminiom/go-atlassian/internal/httplog/httplogtest"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newJSONRequest(t *testing.T, body string) *http.Request {
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+		"https://ctreminiom.atlassian.net/rest/api/3/issue?expand=names&access_token=abc", bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request.SetBasicAuth("example@example.com", "API_TOKEN")
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Custom-Secret", "s3cr3t")
+
+	return request
+}
+
+func newResponse(status int, contentType, body string, headers map[string]string) *http.Response {
+
+	response := &http.Response{
+		StatusCode:    status,
+		Header:        http.Header{},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: -1,
+	}
+
+	response.Header.Set("Content-Type", contentType)
+	for key, value := range headers {
+		response.Header.Set(key, value)
+	}
+
+	return response
+}
+
+func TestHook(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		options  *Options
+		body     string
+		response *http.Response
+		err      error
+		want     func(t *testing.T, entry *httplogtest.Entry)
+	}{
+		{
+			name: "LogTheRequestWhenTheBodiesAreNotDumped",
+			body: `{"fields": {"summary": "Create the logger"}}`,
+			response: newResponse(http.StatusCreated, "application/json", `{"key": "KP-1"}`,
+				map[string]string{"X-RateLimit-Remaining": "99", "Retry-After": "0", "X-Arequestid": "7a1f"}),
+			want: func(t *testing.T, entry *httplogtest.Entry) {
+
+				assert.Equal(t, "debug", entry.Level)
+				assert.Equal(t, http.MethodPost, entry.Args["method"])
+				assert.Equal(t, "https://ctreminiom.atlassian.net/rest/api/3/issue?access_token=%5BREDACTED%5D&expand=names", entry.Args["endpoint"])
+				assert.Equal(t, http.StatusCreated, entry.Args["status"])
+				assert.Equal(t, "99", entry.Args["x-ratelimit-remaining"])
+				assert.Equal(t, "0", entry.Args["retry-after"])
+				assert.IsType(t, time.Duration(0), entry.Args["latency"])
+
+				assert.NotContains(t, entry.Args, "x-arequestid")
+				assert.NotContains(t, entry.Args, "request.body")
+				assert.NotContains(t, entry.Args, "response.body")
+			},
+		},
+		{
+			name:    "LogTheRequestWhenTheBodiesAreDumped",
+			options: &Options{DumpBody: true, RedactHeaders: []string{"x-custom-secret"}, RedactPaths: []string{"fields.reporter.*", "issues.fields.email", "issues.*.names.*"}},
+			body:    `{"fields": {"summary": "<b>Logger</b>", "reporter": {"accountId": "5b86be50b8e3cb5895860d6d"}, "customfield_10042": 10000000000000001}}`,
+			response: newResponse(http.StatusOK, "application/json; charset=UTF-8",
+				`{"issues": [{"fields": {"email": "example@example.com", "apiToken": "abc"}, "names": ["a", "b"]}, {"fields": {"email": "other@example.com"}}], "password": "123"}`, nil),
+			want: func(t *testing.T, entry *httplogtest.Entry) {
+
+				assert.Equal(t, `{"fields":{"customfield_10042":10000000000000001,"reporter":{"accountId":"[REDACTED]"},"summary":"<b>Logger</b>"}}`, entry.Args["request.body"])
+				assert.Equal(t, `{"issues":[{"fields":{"apiToken":"[REDACTED]","email":"[REDACTED]"},"names":["[REDACTED]","[REDACTED]"]},{"fields":{"email":"[REDACTED]"}}],"password":"[REDACTED]"}`, entry.Args["response.body"])
+
+				headers := entry.Args["request.headers"].(string)
+				assert.Contains(t, headers, "Authorization: [REDACTED]")
+				assert.Contains(t, headers, "X-Custom-Secret: [REDACTED]")
+				assert.Contains(t, headers, "Content-Type: application/json")
+				assert.NotContains(t, headers, "API_TOKEN")
+			},
+		},
+		{
+			name:     "LogTheRequestWhenTheResponseIsNotJSON",
+			options:  &Options{DumpBody: true, MaxBodySize: 10},
+			response: newResponse(http.StatusBadGateway, "text/html", "<html>Bad gateway, try again</html>", nil),
+			want: func(t *testing.T, entry *httplogtest.Entry) {
+				assert.Equal(t, "error", entry.Level)
+				assert.Equal(t, "<html>Bad ...[truncated 25 bytes]", entry.Args["response.body"])
+			},
+		},
+		{
+			name:     "LogTheRequestWhenTheResponseIsBinary",
+			options:  &Options{DumpBody: true},
+			response: newResponse(http.StatusOK, "image/png", "\x89PNG\r\n", nil),
+			want: func(t *testing.T, entry *httplogtest.Entry) {
+				assert.Equal(t, "[6 bytes of image/png]", entry.Args["response.body"])
+			},
+		},
+		{
+			name: "LogTheRequestWhenTheRequestFailed",
+			err:  errors.New("dial tcp: connection refused"),
+			want: func(t *testing.T, entry *httplogtest.Entry) {
+				assert.Equal(t, "error", entry.Level)
+				assert.Equal(t, "atlassian request failed", entry.Msg)
+				assert.Equal(t, "dial tcp: connection refused", entry.Args["error"])
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			logger := &httplogtest.Recorder{}
+			hook := New(logger, testCase.options)
+
+			request := newJSONRequest(t, testCase.body)
+
+			var body string
+			if testCase.response != nil {
+				body = func() string {
+					content, _ := ioutil.ReadAll(testCase.response.Body)
+					testCase.response.Body = ioutil.NopCloser(bytes.NewReader(content))
+					return string(content)
+				}()
+			}
+
+			hook.Start(request).Finish(testCase.response, testCase.err)
+
+			entries := logger.Entries()
+			if !assert.Len(t, entries, 1) {
+				return
+			}
+
+			testCase.want(t, entries[0])
+
+			// The response body can be read again by the client
+			if testCase.response != nil {
+				content, err := ioutil.ReadAll(testCase.response.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, body, string(content))
+			}
+		})
+	}
+}
+
+func TestHook_Retry(t *testing.T) {
+
+	logger := &httplogtest.Recorder{}
+
+	New(logger, nil).Retry(http.MethodPost, "https://api.atlassian.com/users/1/manage/lifecycle/disable?token=abc", 1, 2*time.Second, http.StatusTooManyRequests)
+
+	if entries := logger.Entries(); assert.Len(t, entries, 1) {
+		assert.Equal(t, "atlassian request retry", entries[0].Msg)
+		assert.Equal(t, "https://api.atlassian.com/users/1/manage/lifecycle/disable?token=%5BREDACTED%5D", entries[0].Args["endpoint"])
+		assert.Equal(t, 1, entries[0].Args["attempt"])
+		assert.Equal(t, 2*time.Second, entries[0].Args["wait"])
+	}
+}
+
+func TestHook_Nil(t *testing.T) {
+
+	hook := New(nil, &Options{DumpBody: true})
+	assert.Nil(t, hook)
+
+	// The nil hook and exchange don't log anything
+	hook.Start(newJSONRequest(t, "")).Finish(nil, nil)
+	hook.Retry(http.MethodGet, "https://api.atlassian.com", 1, time.Second, http.StatusTooManyRequests)
+}
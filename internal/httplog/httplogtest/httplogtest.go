@@ -0,0 +1,54 @@
+// Package httplogtest records the entries logged by the httplog hooks, it's used by the tests of the clients.
+package httplogtest
+
+import "sync"
+
+// Entry is a log entry, the key/value arguments are stored by key
+type Entry struct {
+	Level string
+	Msg   string
+	Args  map[string]interface{}
+}
+
+// Recorder is a logger storing the entries in memory, it's safe for concurrent use
+type Recorder struct {
+	mu      sync.Mutex
+	entries []*Entry
+}
+
+func (r *Recorder) Debug(msg string, args ...interface{}) { r.record("debug", msg, args) }
+func (r *Recorder) Error(msg string, args ...interface{}) { r.record("error", msg, args) }
+
+func (r *Recorder) record(level, msg string, args []interface{}) {
+
+	entry := &Entry{Level: level, Msg: msg, Args: make(map[string]interface{})}
+	for index := 0; index+1 < len(args); index += 2 {
+		if key, ok := args[index].(string); ok {
+			entry.Args[key] = args[index+1]
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+}
+
+// Entries returns the entries logged so far
+func (r *Recorder) Entries() []*Entry {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*Entry(nil), r.entries...)
+}
+
+// Messages returns the messages of the entries logged so far
+func (r *Recorder) Messages() (messages []string) {
+
+	for _, entry := range r.Entries() {
+		messages = append(messages, entry.Msg)
+	}
+
+	return
+}
@@ -0,0 +1,68 @@
+// Package instrument calls the telemetry.Instrumentation of the go-atlassian clients for every request.
+package instrument
+
+import (
+	"github.com/ctreminiom/go-atlassian/telemetry"
+	"net/http"
+	"time"
+)
+
+// Hook instruments the requests of a client, the nil hook doesn't instrument anything
+type Hook struct {
+	instrumentation telemetry.Instrumentation
+	product         string
+}
+
+// New returns the hook of the instrumentation, it returns nil when the instrumentation is nil
+func New(instrumentation telemetry.Instrumentation, product string) *Hook {
+
+	if instrumentation == nil {
+		return nil
+	}
+
+	return &Hook{instrumentation: instrumentation, product: product}
+}
+
+// Call is a request in flight, it's ended once the response or the error is received
+type Call struct {
+	span    telemetry.Span
+	started time.Time
+}
+
+// Start starts the span of the request, the route is the endpoint template of the service method and the retries
+// are read from the request context. The request is returned with the context of the span.
+func (h *Hook) Start(request *http.Request, route string) (*http.Request, *Call) {
+
+	if h == nil {
+		return request, nil
+	}
+
+	ctx, span := h.instrumentation.Start(request.Context(), &telemetry.RequestScheme{
+		Product: h.product,
+		Method:  request.Method,
+		Route:   route,
+		URL:     request.URL,
+		Retries: telemetry.RetriesOf(request.Context()),
+	})
+
+	if ctx != nil && ctx != request.Context() {
+		request = request.WithContext(ctx)
+	}
+
+	return request, &Call{span: span, started: time.Now()}
+}
+
+// End ends the span with the status code of the response or the error
+func (c *Call) End(response *http.Response, err error) {
+
+	if c == nil || c.span == nil {
+		return
+	}
+
+	result := &telemetry.ResultScheme{Duration: time.Since(c.started), Err: err}
+	if err == nil && response != nil {
+		result.StatusCode = response.StatusCode
+	}
+
+	c.span.End(result)
+}
@@ -0,0 +1,35 @@
+// Package retry waits between the attempts of the requests rejected by the rate limits of the Atlassian APIs,
+// it's shared by the admin and ops clients.
+package retry
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// After returns the time to wait before the attempt is sent again, it's the seconds of the Retry-After header
+// or an exponential backoff of the attempt, e.g: 1s, 2s and 4s
+func After(header http.Header, attempt int) time.Duration {
+
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	return time.Duration(1<<uint(attempt)) * time.Second
+}
+
+// Sleep waits the duration, it returns the error of the context when it's done before
+func Sleep(ctx context.Context, duration time.Duration) error {
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,56 @@
+package retry
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAfter(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		header  http.Header
+		attempt int
+		want    time.Duration
+	}{
+		{
+			name:    "AfterWhenTheRetryAfterHeaderIsSet",
+			header:  http.Header{"Retry-After": []string{"3"}},
+			attempt: 2,
+			want:    3 * time.Second,
+		},
+		{
+			name:    "AfterWhenTheRetryAfterHeaderIsNotSet",
+			header:  http.Header{},
+			attempt: 2,
+			want:    4 * time.Second,
+		},
+		{
+			name:    "AfterWhenTheRetryAfterHeaderIsNotANumber",
+			header:  http.Header{"Retry-After": []string{"soon"}},
+			attempt: 0,
+			want:    time.Second,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testCase.want, After(testCase.header, testCase.attempt))
+		})
+	}
+}
+
+func TestSleep(t *testing.T) {
+
+	assert.NoError(t, Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Equal(t, context.Canceled, Sleep(ctx, time.Minute))
+}
@@ -0,0 +1,28 @@
+// Package route carries the endpoint template of the service methods on the context of their requests,
+// e.g: the GET request of the "rest/api/3/issue/KP-1" path carries the "rest/api/3/issue/{issueKeyOrID}" template.
+// The clients use the template as the route of the rate limiter, the cache and the instrumentation.
+package route
+
+import (
+	"context"
+	"strings"
+)
+
+type templateContextKey struct{}
+
+// With returns the context with the endpoint template, the parameters of the template are declared between braces.
+// The nil contexts are returned as they are, so the request constructors return their error.
+func With(ctx context.Context, template string) context.Context {
+
+	if ctx == nil || len(template) == 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, templateContextKey{}, strings.Trim(template, "/"))
+}
+
+// Of returns the endpoint template of the context, it returns an empty string when the request isn't a service method
+func Of(ctx context.Context) string {
+	template, _ := ctx.Value(templateContextKey{}).(string)
+	return template
+}
@@ -0,0 +1,23 @@
+package route
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWith(t *testing.T) {
+
+	ctx := With(context.Background(), "/rest/api/3/issue/{issueKeyOrID}/")
+	assert.Equal(t, "rest/api/3/issue/{issueKeyOrID}", Of(ctx))
+
+	// The template of the request replaces the template of the parent context
+	assert.Equal(t, "rest/api/3/field", Of(With(ctx, "rest/api/3/field")))
+
+	// The empty templates don't change the context
+	assert.Equal(t, ctx, With(ctx, ""))
+	assert.Empty(t, Of(context.Background()))
+
+	// The nil contexts are returned to the request constructors
+	assert.Nil(t, With(nil, "rest/api/3/field"))
+}
@@ -33,7 +33,7 @@ type ApplicationRoleScheme struct {
 func (a *ApplicationRoleService) Gets(ctx context.Context) (result *[]ApplicationRoleScheme, response *Response, err error) {
 
 	var endpoint = "rest/api/3/applicationrole"
-	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := a.client.newRequest(ctx, http.MethodGet, "rest/api/3/applicationrole", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -64,7 +64,7 @@ func (a *ApplicationRoleService) Get(ctx context.Context, key string) (result *A
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/applicationrole/%v", key)
-	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := a.client.newRequest(ctx, http.MethodGet, "rest/api/3/applicationrole/{key}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -24,6 +24,7 @@ type AuditRecordScheme struct {
 	Summary         string                             `json:"summary,omitempty"`
 	RemoteAddress   string                             `json:"remoteAddress,omitempty"`
 	AuthorKey       string                             `json:"authorKey,omitempty"`
+	AuthorAccountID string                             `json:"authorAccountId,omitempty"`
 	Created         string                             `json:"created,omitempty"`
 	Category        string                             `json:"category,omitempty"`
 	EventSource     string                             `json:"eventSource,omitempty"`
@@ -84,7 +85,7 @@ func (a *AuditService) Get(ctx context.Context, options *AuditRecordGetOptions,
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/auditing/record?%s", params.Encode())
-	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := a.client.newRequest(ctx, http.MethodGet, "rest/api/3/auditing/record", endpoint, nil)
 	if err != nil {
 		return
 	}
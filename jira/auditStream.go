@@ -0,0 +1,381 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AuditRecordCursorScheme is the position of the last audit record read, persist it to resume the stream.
+type AuditRecordCursorScheme struct {
+	ID      int       `json:"id"`
+	Created time.Time `json:"created"`
+}
+
+type AuditRecordStreamOptions struct {
+	Filter      string    // Text filter applied by Jira to the records
+	Categories  []string  // Returns the records of the categories, e.g: permissions, workflows
+	ObjectTypes []string  // Returns the records of the object types, e.g: PERMISSION_SCHEME, WORKFLOW
+	Authors     []string  // Returns the records created by the account IDs or author keys
+	Since       time.Time // The earliest date of the records, used when the cursor is nil
+
+	PageSize     int           // The number of records per page, the default value is 1000
+	PollInterval time.Duration // The time between the polls when the records are tailed, the default value is 1 minute
+}
+
+// Since returns the audit records created after the cursor in chronological order, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. cursor = the position of the last record read, a nil value reads the records created after the Since option
+// 3. options = the filters of the records
+// The next cursor points to the newest record read, the records excluded by the filters move the cursor too.
+// Docs: N/A
+func (a *AuditService) Since(ctx context.Context, cursor *AuditRecordCursorScheme, options *AuditRecordStreamOptions) (records []*AuditRecordScheme, next *AuditRecordCursorScheme, err error) {
+
+	if options == nil {
+		options = &AuditRecordStreamOptions{}
+	}
+
+	next = &AuditRecordCursorScheme{}
+	if cursor != nil {
+		*next = *cursor
+	}
+
+	pageSize := options.PageSize
+	if pageSize <= 0 {
+		pageSize = 1000
+	}
+
+	getOptions := &AuditRecordGetOptions{Filter: options.Filter, From: options.Since}
+	if !next.Created.IsZero() {
+		getOptions.From = next.Created
+	}
+
+	var (
+		fetched = make(map[int]bool)
+		created = make(map[int]time.Time)
+		newest  []*AuditRecordScheme
+	)
+
+	for offset := 0; ; {
+
+		page, _, err := a.Get(ctx, getOptions, offset, pageSize)
+		if err != nil {
+			return nil, cursor, err
+		}
+
+		var reachedCursor bool
+		for _, record := range page.Records {
+
+			// The records are returned newest first, the rest of the pages were already read
+			if record.ID <= next.ID {
+				reachedCursor = true
+				break
+			}
+
+			// The records created while paging shift the offset, the repeated records are skipped
+			if fetched[record.ID] {
+				continue
+			}
+
+			recordCreated, err := time.Parse(DateFormatJira, record.Created)
+			if err != nil {
+				return nil, cursor, fmt.Errorf("error, the audit record %v has an invalid created date %v", record.ID, record.Created)
+			}
+
+			fetched[record.ID] = true
+			created[record.ID] = recordCreated
+			newest = append(newest, record)
+		}
+
+		offset += len(page.Records)
+		if reachedCursor || len(page.Records) == 0 || offset >= page.Total {
+			break
+		}
+	}
+
+	sort.SliceStable(newest, func(i, j int) bool { return newest[i].ID < newest[j].ID })
+
+	for _, record := range newest {
+
+		if record.ID > next.ID {
+			next.ID, next.Created = record.ID, created[record.ID]
+		}
+
+		if options.match(record) {
+			records = append(records, record)
+		}
+	}
+
+	return records, next, nil
+}
+
+// Tail polls the audit records until the context is cancelled, this func needs the following parameters:
+// 1. ctx = it's the context.context value
+// 2. cursor = the position of the last record read, a nil value reads the records created after the Since option
+// 3. options = the filters of the records and the poll interval
+// 4. callback = func called with every new record and the cursor after it, the tail stops when it returns an error
+// Docs: N/A
+func (a *AuditService) Tail(ctx context.Context, cursor *AuditRecordCursorScheme, options *AuditRecordStreamOptions,
+	callback func(record *AuditRecordScheme, cursor *AuditRecordCursorScheme) error) (err error) {
+
+	if callback == nil {
+		return fmt.Errorf("error, please provide a valid callback value")
+	}
+
+	if options == nil {
+		options = &AuditRecordStreamOptions{}
+	}
+
+	interval := options.PollInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	for {
+
+		records, next, err := a.Since(ctx, cursor, options)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+
+			recordCursor := &AuditRecordCursorScheme{ID: record.ID}
+			recordCursor.Created, _ = time.Parse(DateFormatJira, record.Created)
+
+			if err = callback(record, recordCursor); err != nil {
+				return err
+			}
+		}
+
+		cursor = next
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (a *AuditRecordStreamOptions) match(record *AuditRecordScheme) bool {
+
+	if len(a.Categories) != 0 && !containsFold(a.Categories, record.Category) {
+		return false
+	}
+
+	if len(a.ObjectTypes) != 0 && (record.ObjectItem == nil || !containsFold(a.ObjectTypes, record.ObjectItem.TypeName)) {
+		return false
+	}
+
+	if len(a.Authors) != 0 && !containsFold(a.Authors, record.AuthorAccountID) && !containsFold(a.Authors, record.AuthorKey) {
+		return false
+	}
+
+	return true
+}
+
+func containsFold(values []string, value string) bool {
+
+	if len(value) == 0 {
+		return false
+	}
+
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AuditChangeEventScheme represents an audit record as a structured change:
+// who changed which object, the related items and the values changed.
+type AuditChangeEventScheme struct {
+	RecordID      int                                `json:"recordId"`
+	Created       time.Time                          `json:"created"`
+	Author        string                             `json:"author,omitempty"`
+	RemoteAddress string                             `json:"remoteAddress,omitempty"`
+	Category      string                             `json:"category,omitempty"`
+	Action        string                             `json:"action"`
+	Summary       string                             `json:"summary,omitempty"`
+	Object        *AuditRecordObjectItemScheme       `json:"object,omitempty"`
+	Associated    []*AuditRecordAssociatedItemScheme `json:"associated,omitempty"`
+	Changes       []*AuditChangeScheme               `json:"changes,omitempty"`
+}
+
+// AuditChangeScheme represents a changed value, the values with a list of items separated
+// by commas are compared item by item and the differences are stored in Added and Removed.
+type AuditChangeScheme struct {
+	Field   string   `json:"field"`
+	From    string   `json:"from,omitempty"`
+	To      string   `json:"to,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+const (
+	AuditCreatedAction = "created"
+	AuditUpdatedAction = "updated"
+	AuditDeletedAction = "deleted"
+	AuditAddedAction   = "added"
+	AuditRemovedAction = "removed"
+	AuditOtherAction   = "other"
+)
+
+var auditSummaryActions = []struct {
+	verbs  []string
+	action string
+}{
+	{[]string{"created", "copied"}, AuditCreatedAction},
+	{[]string{"updated", "changed", "edited", "modified", "renamed"}, AuditUpdatedAction},
+	{[]string{"deleted", "trashed"}, AuditDeletedAction},
+	{[]string{"added", "granted", "assigned"}, AuditAddedAction},
+	{[]string{"removed", "revoked", "unassigned"}, AuditRemovedAction},
+}
+
+// ChangeEvent returns the record as a structured change event.
+func (a *AuditRecordScheme) ChangeEvent() (event *AuditChangeEventScheme, err error) {
+
+	created, err := time.Parse(DateFormatJira, a.Created)
+	if err != nil {
+		return nil, fmt.Errorf("error, the audit record %v has an invalid created date %v", a.ID, a.Created)
+	}
+
+	event = &AuditChangeEventScheme{
+		RecordID:      a.ID,
+		Created:       created,
+		Author:        a.AuthorAccountID,
+		RemoteAddress: a.RemoteAddress,
+		Category:      a.Category,
+		Action:        AuditOtherAction,
+		Summary:       a.Summary,
+		Object:        a.ObjectItem,
+		Associated:    a.AssociatedItems,
+	}
+
+	if len(event.Author) == 0 {
+		event.Author = a.AuthorKey
+	}
+
+	// The summary describes the action with a verb, e.g: Workflow updated, User removed from group
+summary:
+	for _, word := range strings.Fields(strings.ToLower(a.Summary)) {
+		for _, candidate := range auditSummaryActions {
+			for _, verb := range candidate.verbs {
+				if word == verb {
+					event.Action = candidate.action
+					break summary
+				}
+			}
+		}
+	}
+
+	for _, value := range a.ChangedValues {
+
+		change := &AuditChangeScheme{Field: value.FieldName, From: value.ChangedFrom, To: value.ChangedTo}
+
+		fromItems, toItems := splitAuditValue(value.ChangedFrom), splitAuditValue(value.ChangedTo)
+		if len(fromItems) > 1 || len(toItems) > 1 {
+			change.Added = subtractAuditItems(toItems, fromItems)
+			change.Removed = subtractAuditItems(fromItems, toItems)
+		}
+
+		event.Changes = append(event.Changes, change)
+	}
+
+	return event, nil
+}
+
+// String renders the change event as a single line, e.g:
+// 5b86be50b8e3cb5895860d6d updated PERMISSION_SCHEME "Default Permission Scheme": Browse Projects: added [Group: jira-users]
+func (a *AuditChangeEventScheme) String() string {
+
+	var builder strings.Builder
+
+	author := a.Author
+	if len(author) == 0 {
+		author = "unknown author"
+	}
+
+	fmt.Fprintf(&builder, "%v %v", author, a.Action)
+
+	if a.Object != nil {
+		fmt.Fprintf(&builder, " %v %q", a.Object.TypeName, a.Object.Name)
+	} else if len(a.Summary) != 0 {
+		fmt.Fprintf(&builder, " %q", a.Summary)
+	}
+
+	var associated []string
+	for _, item := range a.Associated {
+		associated = append(associated, fmt.Sprintf("%v %q", item.TypeName, item.Name))
+	}
+
+	if len(associated) != 0 {
+		fmt.Fprintf(&builder, " (%v)", strings.Join(associated, ", "))
+	}
+
+	var changes []string
+	for _, change := range a.Changes {
+
+		switch {
+		case len(change.Added) != 0 || len(change.Removed) != 0:
+
+			var differences []string
+			if len(change.Added) != 0 {
+				differences = append(differences, fmt.Sprintf("added [%v]", strings.Join(change.Added, ", ")))
+			}
+
+			if len(change.Removed) != 0 {
+				differences = append(differences, fmt.Sprintf("removed [%v]", strings.Join(change.Removed, ", ")))
+			}
+
+			changes = append(changes, fmt.Sprintf("%v: %v", change.Field, strings.Join(differences, " ")))
+
+		case len(change.From) == 0:
+			changes = append(changes, fmt.Sprintf("%v: set to %q", change.Field, change.To))
+		case len(change.To) == 0:
+			changes = append(changes, fmt.Sprintf("%v: %q cleared", change.Field, change.From))
+		default:
+			changes = append(changes, fmt.Sprintf("%v: %q -> %q", change.Field, change.From, change.To))
+		}
+	}
+
+	if len(changes) != 0 {
+		fmt.Fprintf(&builder, ": %v", strings.Join(changes, "; "))
+	}
+
+	return builder.String()
+}
+
+func splitAuditValue(value string) (items []string) {
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); len(item) != 0 {
+			items = append(items, item)
+		}
+	}
+
+	return
+}
+
+func subtractAuditItems(values, remove []string) (result []string) {
+
+	removed := make(map[string]bool, len(remove))
+	for _, item := range remove {
+		removed[item] = true
+	}
+
+	for _, item := range values {
+		if !removed[item] {
+			result = append(result, item)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,298 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeAuditLog serves the audit records newest first with offset pagination
+type fakeAuditLog struct {
+	mu      sync.Mutex
+	records []*AuditRecordScheme
+}
+
+func (f *fakeAuditLog) add(record *AuditRecordScheme) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.records = append([]*AuditRecordScheme{record}, f.records...)
+}
+
+func (f *fakeAuditLog) handler(t *testing.T) http.Handler {
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if r.URL.Path != "/rest/api/3/auditing/record" {
+			t.Errorf("unexpected endpoint %v", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var matched []*AuditRecordScheme
+		for _, record := range f.records {
+
+			if from := r.URL.Query().Get("from"); len(from) != 0 {
+
+				fromAsTime, err := time.Parse(DateFormatJira, from)
+				if err != nil {
+					t.Errorf("invalid from value %v", from)
+				}
+
+				created, _ := time.Parse(DateFormatJira, record.Created)
+				if created.Before(fromAsTime) {
+					continue
+				}
+			}
+
+			matched = append(matched, record)
+		}
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		page := &AuditRecordPageScheme{Offset: offset, Limit: limit, Total: len(matched)}
+		for index := offset; index < len(matched) && index < offset+limit; index++ {
+			page.Records = append(page.Records, matched[index])
+		}
+
+		_ = json.NewEncoder(w).Encode(page)
+	})
+}
+
+func newFakeAuditRecord(id int, category, typeName, author string, created time.Time) *AuditRecordScheme {
+
+	return &AuditRecordScheme{
+		ID:              id,
+		Summary:         "Permission scheme updated",
+		AuthorAccountID: author,
+		Created:         created.Format(DateFormatJira),
+		Category:        category,
+		ObjectItem:      &AuditRecordObjectItemScheme{ID: strconv.Itoa(id), Name: "Default Permission Scheme", TypeName: typeName},
+	}
+}
+
+func TestAuditService_Since(t *testing.T) {
+
+	var (
+		base     = time.Date(2021, 2, 25, 5, 34, 36, 218000000, time.UTC)
+		auditLog = &fakeAuditLog{}
+	)
+
+	auditLog.add(newFakeAuditRecord(10281, "permissions", "PERMISSION_SCHEME", "5b86be50b8e3cb5895860d6d", base))
+	auditLog.add(newFakeAuditRecord(10282, "workflows", "WORKFLOW", "5b86be50b8e3cb5895860d6d", base.Add(time.Minute)))
+	auditLog.add(newFakeAuditRecord(10283, "permissions", "PERMISSION_SCHEME", "5e5f6a63157ed50cd2b9eaca", base.Add(time.Minute)))
+	auditLog.add(newFakeAuditRecord(10284, "Permissions", "permission_scheme", "5b86be50b8e3cb5895860d6d", base.Add(2*time.Minute)))
+	auditLog.add(newFakeAuditRecord(10285, "fields", "CUSTOM_FIELD", "5b86be50b8e3cb5895860d6d", base.Add(3*time.Minute)))
+
+	mockServer := httptest.NewServer(auditLog.handler(t))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name       string
+		cursor     *AuditRecordCursorScheme
+		options    *AuditRecordStreamOptions
+		wantIDs    []int
+		wantCursor *AuditRecordCursorScheme
+	}{
+		{
+			name:       "SinceWhenTheCursorIsNil",
+			cursor:     nil,
+			options:    &AuditRecordStreamOptions{PageSize: 2},
+			wantIDs:    []int{10281, 10282, 10283, 10284, 10285},
+			wantCursor: &AuditRecordCursorScheme{ID: 10285, Created: base.Add(3 * time.Minute)},
+		},
+		{
+			name:       "SinceWhenTheCursorIsSet",
+			cursor:     &AuditRecordCursorScheme{ID: 10282, Created: base.Add(time.Minute)},
+			options:    &AuditRecordStreamOptions{PageSize: 2},
+			wantIDs:    []int{10283, 10284, 10285},
+			wantCursor: &AuditRecordCursorScheme{ID: 10285, Created: base.Add(3 * time.Minute)},
+		},
+		{
+			name:   "SinceWhenTheRecordsAreFiltered",
+			cursor: nil,
+			options: &AuditRecordStreamOptions{
+				Categories:  []string{"permissions"},
+				ObjectTypes: []string{"PERMISSION_SCHEME"},
+				Authors:     []string{"5b86be50b8e3cb5895860d6d"},
+			},
+			wantIDs:    []int{10281, 10284},
+			wantCursor: &AuditRecordCursorScheme{ID: 10285, Created: base.Add(3 * time.Minute)},
+		},
+		{
+			name:       "SinceWhenThereAreNotNewRecords",
+			cursor:     &AuditRecordCursorScheme{ID: 10285, Created: base.Add(3 * time.Minute)},
+			options:    nil,
+			wantIDs:    nil,
+			wantCursor: &AuditRecordCursorScheme{ID: 10285, Created: base.Add(3 * time.Minute)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotRecords, gotCursor, err := mockClient.Audit.Since(context.Background(), testCase.cursor, testCase.options)
+			assert.NoError(t, err)
+
+			var gotIDs []int
+			for _, record := range gotRecords {
+				gotIDs = append(gotIDs, record.ID)
+			}
+
+			assert.Equal(t, testCase.wantIDs, gotIDs)
+			assert.Equal(t, testCase.wantCursor.ID, gotCursor.ID)
+			assert.True(t, testCase.wantCursor.Created.Equal(gotCursor.Created))
+		})
+	}
+}
+
+func TestAuditService_Tail(t *testing.T) {
+
+	var (
+		base     = time.Date(2021, 2, 25, 5, 34, 36, 218000000, time.UTC)
+		auditLog = &fakeAuditLog{}
+		stop     = errors.New("stop")
+	)
+
+	auditLog.add(newFakeAuditRecord(10281, "permissions", "PERMISSION_SCHEME", "5b86be50b8e3cb5895860d6d", base))
+
+	mockServer := httptest.NewServer(auditLog.handler(t))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		gotIDs  []int
+		options = &AuditRecordStreamOptions{PollInterval: time.Millisecond}
+	)
+
+	err = mockClient.Audit.Tail(context.Background(), nil, options, func(record *AuditRecordScheme, cursor *AuditRecordCursorScheme) error {
+
+		gotIDs = append(gotIDs, record.ID)
+		assert.Equal(t, record.ID, cursor.ID)
+
+		if record.ID == 10281 {
+			auditLog.add(newFakeAuditRecord(10282, "permissions", "PERMISSION_SCHEME", "5b86be50b8e3cb5895860d6d", base.Add(time.Second)))
+			return nil
+		}
+
+		return stop
+	})
+
+	assert.Equal(t, stop, err)
+	assert.Equal(t, []int{10281, 10282}, gotIDs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = mockClient.Audit.Tail(ctx, &AuditRecordCursorScheme{ID: 10282}, options, func(*AuditRecordScheme, *AuditRecordCursorScheme) error { return nil })
+	assert.Error(t, err)
+
+	err = mockClient.Audit.Tail(context.Background(), nil, options, nil)
+	assert.Error(t, err)
+}
+
+func TestAuditRecordScheme_ChangeEvent(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		record     *AuditRecordScheme
+		wantAction string
+		wantString string
+		wantErr    bool
+	}{
+		{
+			name: "ChangeEventWhenThePermissionSchemeIsUpdated",
+			record: &AuditRecordScheme{
+				ID:              10287,
+				Summary:         "Permission scheme updated",
+				AuthorAccountID: "5b86be50b8e3cb5895860d6d",
+				Created:         "2021-02-25T05:34:36.218+0000",
+				Category:        "permissions",
+				ObjectItem:      &AuditRecordObjectItemScheme{ID: "10000", Name: "Default Permission Scheme", TypeName: "PERMISSION_SCHEME"},
+				AssociatedItems: []*AuditRecordAssociatedItemScheme{{ID: "10000", Name: "KP", TypeName: "PROJECT"}},
+				ChangedValues: []*AuditRecordChangedValueScheme{
+					{FieldName: "Browse Projects", ChangedFrom: "Group: jira-users, Project Role: Developers", ChangedTo: "Project Role: Developers, Group: jira-admins"},
+					{FieldName: "Name", ChangedFrom: "Default", ChangedTo: "Default Permission Scheme"},
+				},
+			},
+			wantAction: AuditUpdatedAction,
+			wantString: `5b86be50b8e3cb5895860d6d updated PERMISSION_SCHEME "Default Permission Scheme" (PROJECT "KP"): ` +
+				`Browse Projects: added [Group: jira-admins] removed [Group: jira-users]; Name: "Default" -> "Default Permission Scheme"`,
+		},
+		{
+			name: "ChangeEventWhenTheCustomFieldIsCreated",
+			record: &AuditRecordScheme{
+				ID:            10286,
+				Summary:       "Custom field created",
+				AuthorKey:     "ug:24aa09ae-9ba7-4acc-92fd-d0d075d8d4aa",
+				Created:       "2021-02-23T02:37:34.360+0000",
+				ObjectItem:    &AuditRecordObjectItemScheme{ID: "customfield_10056", Name: "Team", TypeName: "CUSTOM_FIELD"},
+				ChangedValues: []*AuditRecordChangedValueScheme{{FieldName: "Type", ChangedTo: "Team"}},
+			},
+			wantAction: AuditCreatedAction,
+			wantString: `ug:24aa09ae-9ba7-4acc-92fd-d0d075d8d4aa created CUSTOM_FIELD "Team": Type: set to "Team"`,
+		},
+		{
+			name: "ChangeEventWhenTheUserIsRemovedFromAGroup",
+			record: &AuditRecordScheme{
+				ID:            10290,
+				Summary:       "User removed from group",
+				Created:       "2021-02-23T02:37:34.360+0000",
+				ChangedValues: []*AuditRecordChangedValueScheme{{FieldName: "Membership", ChangedFrom: "jira-administrators"}},
+			},
+			wantAction: AuditRemovedAction,
+			wantString: `unknown author removed "User removed from group": Membership: "jira-administrators" cleared`,
+		},
+		{
+			name:    "ChangeEventWhenTheCreatedDateIsNotValid",
+			record:  &AuditRecordScheme{ID: 10291, Created: "yesterday"},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotEvent, err := testCase.record.ChangeEvent()
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.record.ID, gotEvent.RecordID)
+			assert.Equal(t, testCase.wantAction, gotEvent.Action)
+			assert.Equal(t, testCase.wantString, gotEvent.String())
+		})
+	}
+}
@@ -6,6 +6,9 @@ type AuthenticationService struct {
 	basicAuthProvided bool
 	mail, token       string
 
+	bearerTokenProvided bool
+	bearerToken         string
+
 	userAgentProvided bool
 	agent             string
 }
@@ -23,6 +26,18 @@ func (a *AuthenticationService) SetBasicAuth(mail, token string) {
 	a.basicAuthProvided = true
 }
 
+// SetBearerToken authenticates the requests with a bearer token, e.g: a Personal Access Token of Jira Server and Data Center
+func (a *AuthenticationService) SetBearerToken(token string) {
+
+	if a.client.ServiceManagement != nil {
+		a.client.ServiceManagement.Auth.SetBearerToken(token)
+	}
+
+	a.bearerToken = token
+
+	a.bearerTokenProvided = true
+}
+
 func (a *AuthenticationService) SetUserAgent(agent string) {
 
 	if a.client.ServiceManagement != nil {
@@ -1,6 +1,9 @@
 package jira
 
-import "testing"
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
 
 func TestAuthenticationService_SetBasicAuth(t *testing.T) {
 
@@ -48,6 +51,19 @@ func TestAuthenticationService_SetBasicAuth(t *testing.T) {
 	}
 }
 
+func TestAuthenticationService_SetBearerToken(t *testing.T) {
+
+	mockedClient, err := startMockClient("")
+	if err != nil {
+		t.Log(err)
+	}
+
+	mockedClient.Auth.SetBearerToken("$PERSONAL_ACCESS_TOKEN")
+
+	assert.True(t, mockedClient.Auth.bearerTokenProvided)
+	assert.Equal(t, "$PERSONAL_ACCESS_TOKEN", mockedClient.Auth.bearerToken)
+}
+
 func TestAuthenticationService_SetUserAgent(t *testing.T) {
 
 	mockedClient, err := startMockClient("")
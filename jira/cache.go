@@ -0,0 +1,17 @@
+package jira
+
+import (
+	"github.com/ctreminiom/go-atlassian/cache"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+)
+
+// WithCache returns the cached responses of the GET requests of the client, the TTLs of the cache are matched with
+// the endpoint templates of the service methods, e.g: rest/api/3/field
+func WithCache(cache *cache.Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+
+		// The Service Management module shares the cache, so its writes invalidate the cached routes
+		sm.WithCache(cache)(c.ServiceManagement)
+	}
+}
@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/cache"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithCache(t *testing.T) {
+
+	requests := make(map[string]int)
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/api/3/field", func(w http.ResponseWriter, r *http.Request) {
+
+		requests[r.Method+" "+r.URL.Path]++
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": "customfield_10042", "name": "Team"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`[{"id": "summary", "name": "Summary"}]`))
+	})
+
+	mux.HandleFunc("/rest/api/3/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		_, _ = w.Write([]byte(`{"baseUrl": "https://ctreminiom.atlassian.net", "deploymentType": "Cloud"}`))
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	responses, err := cache.New(&cache.OptionsScheme{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient, err := New(nil, mockServer.URL, WithCache(responses))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient.Auth.SetBasicAuth("example@example.com", "API_TOKEN")
+
+	for index := 0; index < 3; index++ {
+
+		fields, response, err := mockClient.Issue.Field.Gets(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+
+		if assert.NotNil(t, fields) && assert.Len(t, *fields, 1) {
+			assert.Equal(t, "summary", (*fields)[0].ID)
+		}
+
+		info, _, err := mockClient.Server.Info(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Cloud", info.DeploymentType)
+	}
+
+	assert.Equal(t, 1, requests["GET /rest/api/3/field"])
+	assert.Equal(t, 1, requests["GET /rest/api/3/serverInfo"])
+
+	// The creation of a field invalidates the cached fields
+	_, _, err = mockClient.Issue.Field.Create(context.Background(), &CustomFieldScheme{Name: "Team", FieldType: "textfield", SearcherKey: "textsearcher"})
+	assert.NoError(t, err)
+
+	_, _, err = mockClient.Issue.Field.Gets(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, requests["GET /rest/api/3/field"])
+	assert.Equal(t, 1, requests["GET /rest/api/3/serverInfo"])
+
+	stats := responses.Stats()
+	assert.Equal(t, int64(4), stats.Hits)
+	assert.Equal(t, int64(3), stats.Misses)
+	assert.Equal(t, int64(1), stats.Invalidations)
+}
@@ -41,7 +41,7 @@ func (d *DashboardService) Gets(ctx context.Context, startAt, maxResults int, fi
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/dashboard?%v", params.Encode())
-	request, err := d.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := d.client.newRequest(ctx, http.MethodGet, "rest/api/3/dashboard", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -110,7 +110,7 @@ func (d *DashboardService) Create(ctx context.Context, name, description string,
 
 	var endpoint = "rest/api/3/dashboard"
 
-	request, err := d.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := d.client.newRequest(ctx, http.MethodPost, "rest/api/3/dashboard", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -203,7 +203,7 @@ func (d *DashboardService) Search(ctx context.Context, opts *DashboardSearchOpti
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/dashboard/search?%s", params.Encode())
-	request, err := d.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := d.client.newRequest(ctx, http.MethodGet, "rest/api/3/dashboard/search", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -232,7 +232,7 @@ func (d *DashboardService) Get(ctx context.Context, dashboardID string) (result
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/dashboard/%v", dashboardID)
-	request, err := d.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := d.client.newRequest(ctx, http.MethodGet, "rest/api/3/dashboard/{dashboardID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -261,7 +261,7 @@ func (d *DashboardService) Delete(ctx context.Context, dashboardID string) (resp
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/dashboard/%v", dashboardID)
-	request, err := d.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := d.client.newRequest(ctx, http.MethodDelete, "rest/api/3/dashboard/{dashboardID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -323,7 +323,7 @@ func (d *DashboardService) Copy(ctx context.Context, dashboardID, newDashboardNa
 
 	var endpoint = fmt.Sprintf("rest/api/3/dashboard/%v/copy", dashboardID)
 
-	request, err := d.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := d.client.newRequest(ctx, http.MethodPost, "rest/api/3/dashboard/{dashboardID}/copy", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -390,7 +390,7 @@ func (d *DashboardService) Update(ctx context.Context, dashboardID, newDashboard
 
 	var endpoint = fmt.Sprintf("rest/api/3/dashboard/%v", dashboardID)
 
-	request, err := d.client.newRequest(ctx, http.MethodPut, endpoint, payload)
+	request, err := d.client.newRequest(ctx, http.MethodPut, "rest/api/3/dashboard/{dashboardID}", endpoint, payload)
 	if err != nil {
 		return
 	}
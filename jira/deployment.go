@@ -0,0 +1,293 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// The deployment types of Jira, they're the deploymentType values returned by ServerService.Info
+const (
+	DeploymentCloud  = "Cloud"
+	DeploymentServer = "Server"
+)
+
+// ErrUnsupportedOperation is returned by the service methods of the Jira Cloud operations without an equivalent
+// in Jira Server and Data Center, the request isn't sent to the instance.
+var ErrUnsupportedOperation = errors.New("error, the operation isn't supported by Jira Server and Data Center")
+
+// cloudRoutes are the endpoint templates, and the templates below them, that only exist in Jira Cloud
+var cloudRoutes = []string{
+	"rest/api/3/dashboard/search",
+	"rest/api/3/dashboard/{dashboardID}/copy",
+	"rest/api/3/field/search",
+	"rest/api/3/field/{fieldID}/context",
+	"rest/api/3/field/{fieldID}/restore",
+	"rest/api/3/field/{fieldID}/trash",
+	"rest/api/3/fieldconfiguration",
+	"rest/api/3/fieldconfigurationscheme",
+	"rest/api/3/group/bulk",
+	"rest/api/3/issuetypescheme",
+	"rest/api/3/issuetypescreenscheme",
+	"rest/api/3/project/search",
+	"rest/api/3/project/{projectKeyOrID}/delete",
+	"rest/api/3/project/{projectKeyOrID}/hierarchy",
+	"rest/api/3/projectvalidate",
+	"rest/api/3/screenscheme",
+	"rest/api/3/task",
+	"rest/api/3/user/bulk",
+	"rest/api/3/users/search",
+	"rest/api/3/webhook",
+}
+
+// WithDeployment selects the deployment type of the Jira instance, the DeploymentServer type is used for Jira Server
+// and Data Center:
+//
+// 1. The requests are sent to the REST API v2, e.g: rest/api/2/issue/{issueKeyOrID}
+//
+// 2. The accountId query parameters are sent as username and the accountId keys of the payloads as name, the user
+// objects of the responses contain the name of the user as accountId, so the users are identified by their names.
+//
+// 3. The Atlassian Document Format documents of the payloads are sent as wiki markup, and the wiki markup of the
+// comments, descriptions and environments of the responses is returned as Atlassian Document Format.
+//
+// 4. The Cloud operations return ErrUnsupportedOperation instead of a 404 status code.
+func WithDeployment(deployment string) ClientOption {
+	return func(c *Client) {
+		c.deployment = deployment
+	}
+}
+
+// Detect selects the deployment type with the deploymentType of ServerService.Info, the server information is
+// requested to the REST API v2 because it's available in Jira Cloud, Server and Data Center.
+func (c *Client) Detect(ctx context.Context) (deployment string, response *Response, err error) {
+
+	info, response, err := c.Server.Info(context.WithValue(ctx, serverEndpointContextKey{}, true))
+	if err != nil {
+		return
+	}
+
+	// The old versions of Jira Server don't return the deploymentType
+	deployment = DeploymentServer
+	if info.DeploymentType == DeploymentCloud {
+		deployment = DeploymentCloud
+	}
+
+	c.deployment = deployment
+
+	return
+}
+
+// Deployment returns the deployment type of the client, the clients are created for Jira Cloud
+func (c *Client) Deployment() string {
+
+	if len(c.deployment) == 0 {
+		return DeploymentCloud
+	}
+
+	return c.deployment
+}
+
+func (c *Client) isServer() bool {
+	return c.deployment == DeploymentServer
+}
+
+// serverEndpointContextKey sends the request to the REST API v2 before the deployment type is known
+type serverEndpointContextKey struct{}
+
+func isServerEndpoint(ctx context.Context) bool {
+	serverEndpoint, _ := ctx.Value(serverEndpointContextKey{}).(bool)
+	return serverEndpoint
+}
+
+// unsupported returns ErrUnsupportedOperation when the route only exists in Jira Cloud
+func (c *Client) unsupported(method, route string) error {
+
+	if !c.isServer() {
+		return nil
+	}
+
+	for _, cloudRoute := range cloudRoutes {
+		if route == cloudRoute || strings.HasPrefix(route, cloudRoute+"/") {
+			return fmt.Errorf("%w: %v %v", ErrUnsupportedOperation, method, route)
+		}
+	}
+
+	return nil
+}
+
+// serverEndpoint moves the endpoint to the REST API v2 and sends the accountId query parameters as username
+func serverEndpoint(endpoint *url.URL) {
+
+	if strings.HasPrefix(endpoint.Path, "rest/api/3/") {
+		endpoint.Path = "rest/api/2/" + strings.TrimPrefix(endpoint.Path, "rest/api/3/")
+	}
+
+	// The escaped path is kept, e.g: rest/api/3/issue/KP%2F1
+	if strings.HasPrefix(endpoint.RawPath, "rest/api/3/") {
+		endpoint.RawPath = "rest/api/2/" + strings.TrimPrefix(endpoint.RawPath, "rest/api/3/")
+	}
+
+	query := endpoint.Query()
+	if accountIDs, ok := query["accountId"]; ok {
+
+		query.Del("accountId")
+		query["username"] = append(query["username"], accountIDs...)
+
+		endpoint.RawQuery = query.Encode()
+	}
+}
+
+// serverPayload converts the Atlassian Document Format documents to wiki markup and the accountId keys to name
+func serverPayload(payload []byte) ([]byte, error) {
+	return transformJSON(payload, func(value interface{}) interface{} {
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+
+		if isDocument(object) {
+
+			document := new(CommentNodeScheme)
+			if err := remarshal(object, document); err != nil {
+				return value
+			}
+
+			return ADFToWiki(document)
+		}
+
+		if accountID, ok := object["accountId"]; ok {
+
+			if _, hasName := object["name"]; !hasName {
+				object["name"] = accountID
+			}
+
+			delete(object, "accountId")
+		}
+
+		return object
+	})
+}
+
+// cloudBody converts the responses of Jira Server and Data Center to the format of the Cloud responses, the user
+// objects receive their name as accountId, and the wiki markup of the comments, issue descriptions, issue environments
+// and worklog comments is converted to Atlassian Document Format.
+func cloudBody(body []byte) []byte {
+
+	transformed, err := transformJSON(body, func(value interface{}) interface{} {
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return value
+		}
+
+		if self, ok := object["self"].(string); ok && strings.Contains(self, "/rest/api/2/user?") {
+			if _, hasAccountID := object["accountId"]; !hasAccountID && object["name"] != nil {
+				object["accountId"] = object["name"]
+			}
+		}
+
+		if _, isComment := object["author"]; isComment {
+			convertWiki(object, "body")
+		}
+
+		if _, isWorklog := object["timeSpent"]; isWorklog {
+			convertWiki(object, "comment")
+		}
+
+		if fields, ok := object["fields"].(map[string]interface{}); ok {
+			convertWiki(fields, "description")
+			convertWiki(fields, "environment")
+		}
+
+		return object
+	})
+
+	if err != nil {
+		return body
+	}
+
+	return transformed
+}
+
+// convertWiki replaces the wiki markup of the key with an Atlassian Document Format document
+func convertWiki(object map[string]interface{}, key string) {
+
+	markup, ok := object[key].(string)
+	if !ok {
+		return
+	}
+
+	var document interface{}
+	if err := remarshal(WikiToADF(markup), &document); err == nil {
+		object[key] = document
+	}
+}
+
+func isDocument(object map[string]interface{}) bool {
+	_, hasContent := object["content"]
+	return object["type"] == "doc" && hasContent
+}
+
+// transformJSON applies the transformation to the values of the JSON document, the children are transformed
+// before their parents; the numbers are kept as json.Number, so the IDs aren't rounded.
+func transformJSON(content []byte, transform func(value interface{}) interface{}) ([]byte, error) {
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	var walk func(value interface{}) interface{}
+	walk = func(value interface{}) interface{} {
+
+		switch typed := value.(type) {
+		case map[string]interface{}:
+
+			// The documents are converted as a whole
+			if isDocument(typed) {
+				return transform(typed)
+			}
+
+			for key, nested := range typed {
+				typed[key] = walk(nested)
+			}
+
+		case []interface{}:
+			for index, nested := range typed {
+				typed[index] = walk(nested)
+			}
+		}
+
+		return transform(value)
+	}
+
+	var buffer bytes.Buffer
+
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(walk(value)); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+func remarshal(from, to interface{}) error {
+
+	content, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(content, to)
+}
@@ -0,0 +1,271 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/ctreminiom/go-atlassian/internal/route"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestClient_Detect(t *testing.T) {
+
+	testCases := []struct {
+		name           string
+		deploymentType string
+		want           string
+	}{
+		{name: "DetectTheDeploymentWhenTheInstanceIsCloud", deploymentType: `"Cloud"`, want: DeploymentCloud},
+		{name: "DetectTheDeploymentWhenTheInstanceIsServer", deploymentType: `"Server"`, want: DeploymentServer},
+		{name: "DetectTheDeploymentWhenTheDeploymentTypeIsNotReturned", deploymentType: `null`, want: DeploymentServer},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/rest/api/2/serverInfo", r.URL.Path)
+				_, _ = w.Write([]byte(`{"version": "8.20.1", "deploymentType": ` + testCase.deploymentType + `}`))
+			}))
+			defer mockServer.Close()
+
+			mockClient, err := New(nil, mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, DeploymentCloud, mockClient.Deployment())
+
+			deployment, response, err := mockClient.Detect(context.Background())
+			assert.NoError(t, err)
+			assert.NotNil(t, response)
+			assert.Equal(t, testCase.want, deployment)
+			assert.Equal(t, testCase.want, mockClient.Deployment())
+		})
+	}
+}
+
+func TestClient_DetectWhenTheClientIsCloud(t *testing.T) {
+
+	var paths []string
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(`{"version": "1001.0.0-SNAPSHOT", "deploymentType": "Cloud"}`))
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := New(nil, mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = mockClient.Detect(context.Background())
+	assert.NoError(t, err)
+
+	// Only the detection is sent to the REST API v2
+	_, _, err = mockClient.Server.Info(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"/rest/api/2/serverInfo", "/rest/api/3/serverInfo"}, paths)
+}
+
+func TestClient_NewRequestWhenTheDeploymentIsServer(t *testing.T) {
+
+	mockClient, err := New(nil, "https://jira.example.com/", WithDeployment(DeploymentServer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		endpoint string
+		want     string
+	}{
+		{name: "NewRequestWhenThePathIsNotEscaped", endpoint: "rest/api/3/issue/KP-1", want: "/rest/api/2/issue/KP-1"},
+		{name: "NewRequestWhenThePathIsEscaped", endpoint: "rest/api/3/issue/KP%2F1/comment", want: "/rest/api/2/issue/KP%2F1/comment"},
+		{name: "NewRequestWhenThePathIsNotCloud", endpoint: "rest/agile/1.0/board/10%2F1", want: "/rest/agile/1.0/board/10%2F1"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request, err := mockClient.newRequest(context.Background(), http.MethodGet, "rest/api/3/issue/{issueKeyOrID}", testCase.endpoint, nil)
+			if assert.NoError(t, err) {
+				assert.Equal(t, testCase.want, request.URL.EscapedPath())
+
+				// The requests sent to the REST API v2 keep the Cloud template
+				assert.Equal(t, "rest/api/3/issue/{issueKeyOrID}", route.Of(request.Context()))
+			}
+		})
+	}
+}
+
+func TestWithDeployment(t *testing.T) {
+
+	var requests []*http.Request
+	var payloads []string
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"self": "https://jira.example.com/rest/api/2/user?username=jdoe", "key": "JIRAUSER10100", "name": "jdoe", "displayName": "John Doe"}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"version": "4.20.0"}`))
+	})
+
+	mux.HandleFunc("/rest/api/2/issue/KP-1/assignee", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/rest/api/2/issue/KP-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"id": "10000",
+			"author": {"self": "https://jira.example.com/rest/api/2/user?username=jdoe", "name": "jdoe", "key": "JIRAUSER10100"},
+			"body": "The *fix* is ready for [~jdoe]"
+		}`))
+	})
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		body, _ := ioutil.ReadAll(r.Body)
+
+		requests = append(requests, r)
+		payloads = append(payloads, string(body))
+
+		mux.ServeHTTP(w, r)
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := New(nil, mockServer.URL, WithDeployment(DeploymentServer))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient.Auth.SetBearerToken("PERSONAL_ACCESS_TOKEN")
+
+	// The accountId is sent as the username and the user receives its name as accountId
+	user, _, err := mockClient.User.Get(context.Background(), "jdoe", nil)
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, user) {
+		assert.Equal(t, "jdoe", user.AccountID)
+		assert.Equal(t, "JIRAUSER10100", user.Key)
+	}
+
+	_, err = mockClient.Issue.Assign(context.Background(), "KP-1", "jdoe")
+	assert.NoError(t, err)
+
+	// The ADF documents are sent as wiki markup and the wiki markup is returned as ADF
+	comment, _, err := mockClient.Issue.Comment.Add(context.Background(), "KP-1", &CommentPayloadScheme{
+		Body: &CommentNodeScheme{
+			Version: 1,
+			Type:    "doc",
+			Content: []*CommentNodeScheme{
+				{
+					Type: "paragraph",
+					Content: []*CommentNodeScheme{
+						{Type: "text", Text: "Deployed to "},
+						{Type: "text", Text: "staging", Marks: []*MarkScheme{{Type: "strong"}}},
+					},
+				},
+			},
+		},
+	}, nil)
+	assert.NoError(t, err)
+
+	if assert.NotNil(t, comment) {
+		assert.Equal(t, "jdoe", comment.Author.AccountID)
+		assert.Equal(t, "doc", comment.Body.Type)
+
+		if assert.Len(t, comment.Body.Content, 1) && assert.Len(t, comment.Body.Content[0].Content, 4) {
+			assert.Equal(t, "fix", comment.Body.Content[0].Content[1].Text)
+		}
+	}
+
+	// The Cloud operations aren't sent
+	_, _, err = mockClient.Project.Search(context.Background(), nil, 0, 50)
+	assert.True(t, errors.Is(err, ErrUnsupportedOperation))
+	assert.EqualError(t, err, "error, the operation isn't supported by Jira Server and Data Center: GET rest/api/3/project/search")
+
+	// The Service Management module shares the bearer token
+	_, _, err = mockClient.ServiceManagement.Info.Get(context.Background())
+	assert.NoError(t, err)
+
+	if !assert.Len(t, requests, 4) {
+		return
+	}
+
+	assert.Equal(t, "Bearer PERSONAL_ACCESS_TOKEN", requests[3].Header.Get("Authorization"))
+
+	assert.Equal(t, "/rest/api/2/user", requests[0].URL.Path)
+	assert.Equal(t, "jdoe", requests[0].URL.Query().Get("username"))
+	assert.Empty(t, requests[0].URL.Query().Get("accountId"))
+	assert.Equal(t, "Bearer PERSONAL_ACCESS_TOKEN", requests[0].Header.Get("Authorization"))
+
+	assert.JSONEq(t, `{"name": "jdoe"}`, payloads[1])
+
+	var payload map[string]interface{}
+	if assert.NoError(t, json.Unmarshal([]byte(payloads[2]), &payload)) {
+		assert.Equal(t, "Deployed to *staging*", payload["body"])
+	}
+}
+
+func TestClient_Unsupported(t *testing.T) {
+
+	server := &Client{deployment: DeploymentServer}
+
+	assert.Error(t, server.unsupported(http.MethodGet, "rest/api/3/field/{fieldID}/context"))
+	assert.Error(t, server.unsupported(http.MethodPut, "rest/api/3/field/{fieldID}/context/{contextID}/option"))
+	assert.NoError(t, server.unsupported(http.MethodGet, "rest/api/3/field"))
+	assert.NoError(t, server.unsupported(http.MethodGet, "rest/api/3/issuetype"))
+	assert.NoError(t, server.unsupported(http.MethodGet, ""))
+
+	// The Cloud clients send every operation
+	assert.NoError(t, (&Client{}).unsupported(http.MethodGet, "rest/api/3/field/{fieldID}/context"))
+
+	// The Cloud routes are declared by the service methods
+	for _, cloudRoute := range cloudRoutes {
+		assert.True(t, hasTemplate(t, cloudRoute), cloudRoute)
+	}
+}
+
+// hasTemplate returns true when a service method sends a request of the route, or of a route below it
+func hasTemplate(t *testing.T, cloudRoute string) bool {
+
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := regexp.MustCompile(`(?:newRequest\([^,]+, [^,]+|route\.With\([^,]+), "([^"]+)"`)
+
+	for _, file := range files {
+
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, match := range template.FindAllStringSubmatch(string(content), -1) {
+			if match[1] == cloudRoute || strings.HasPrefix(match[1], cloudRoute+"/") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
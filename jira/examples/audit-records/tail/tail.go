@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"io/ioutil"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	jiraCloud, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	jiraCloud.Auth.SetBasicAuth(mail, token)
+	jiraCloud.Auth.SetUserAgent("curl/7.54.0")
+
+	// Resume the stream from the last record processed
+	var cursor *jira.AuditRecordCursorScheme
+	if content, err := ioutil.ReadFile("audit.cursor.json"); err == nil {
+
+		cursor = new(jira.AuditRecordCursorScheme)
+		if err = json.Unmarshal(content, cursor); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	options := &jira.AuditRecordStreamOptions{
+
+		// Only the permission and workflow changes made by anyone
+		Categories:  []string{"permissions", "workflows"},
+		ObjectTypes: []string{"PERMISSION_SCHEME", "WORKFLOW", "WORKFLOW_SCHEME"},
+
+		// Start with the last day when there's not a cursor
+		Since:        time.Now().AddDate(0, 0, -1),
+		PollInterval: time.Minute,
+	}
+
+	err = jiraCloud.Audit.Tail(context.Background(), cursor, options, func(record *jira.AuditRecordScheme, cursor *jira.AuditRecordCursorScheme) error {
+
+		event, err := record.ChangeEvent()
+		if err != nil {
+			return err
+		}
+
+		log.Println("Configuration drift:", event)
+
+		content, err := json.Marshal(cursor)
+		if err != nil {
+			return err
+		}
+
+		return ioutil.WriteFile("audit.cursor.json", content, 0644)
+	})
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+
+	options := &jira.FieldMergeOptionsScheme{
+		DuplicateFieldID: "customfield_10040",
+		CanonicalFieldID: "customfield_10020",
+		JQL:              "project = KP",
+		MigrateContexts:  true,
+	}
+
+	result, err := atlassian.Issue.Field.Merge(context.Background(), options)
+	if result != nil {
+		log.Println("Issues updated", result.Updated)
+		log.Println("Issues skipped", result.Skipped)
+
+		for issueKey, reason := range result.Failed {
+			log.Println("Issue failed", issueKey, reason)
+		}
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("Duplicate field trashed", result.Trashed)
+}
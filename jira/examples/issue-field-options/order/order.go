@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+
+	var (
+		fieldID   = "customfield_10038"
+		contextID = 10180
+	)
+
+	payload := &jira.OrderFieldOptionPayloadScheme{
+		Position:             "First",
+		CustomFieldOptionIds: []string{"10064", "10065"},
+	}
+
+	response, err := atlassian.Issue.Field.Context.Option.Order(context.Background(), fieldID, contextID, payload)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+		}
+		return
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+}
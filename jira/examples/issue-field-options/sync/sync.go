@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+
+	// The CSV contains the option, cascading option and disabled columns, e.g: "New York,Brooklyn,false"
+	document, err := os.Open("options.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer document.Close()
+
+	desired, err := jira.ParseFieldOptionTreeCSV(document, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var (
+		fieldID   = "customfield_10038"
+		contextID = 10180
+		dryRun    = true
+	)
+
+	result, err := atlassian.Issue.Field.Context.Option.Sync(context.Background(), fieldID, contextID, desired, dryRun)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	resultAsJSON, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println(string(resultAsJSON))
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"log"
+	"net/http"
+	"os"
+)
+
+func main() {
+
+	handler := jira.NewWebhookHandler(os.Getenv("WEBHOOK_SECRET"))
+
+	handler.On(jira.WebhookIssueUpdatedEvent, func(ctx context.Context, event *jira.WebhookEventScheme) error {
+
+		if event.Changelog == nil {
+			return nil
+		}
+
+		for _, item := range event.Changelog.Items {
+			log.Println(event.Issue.Key, item.Field, item.FromString, "->", item.ToString)
+		}
+
+		return nil
+	})
+
+	handler.On(jira.WebhookCommentCreatedEvent, func(ctx context.Context, event *jira.WebhookEventScheme) error {
+		log.Println(event.Issue.Key, event.Comment.ID, event.CommentBody)
+		return nil
+	})
+
+	handler.On(jira.WebhookAnyEvent, func(ctx context.Context, event *jira.WebhookEventScheme) error {
+		log.Println("Webhook received", event.WebhookEvent, event.Identifier)
+		return nil
+	})
+
+	http.Handle("/webhook-received", handler)
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+	atlassian.Auth.SetUserAgent("curl/7.54.0")
+
+	payload := &jira.WebhookSubscriptionPayloadScheme{
+		URL: "https://your-app.example.com/webhook-received",
+		Webhooks: []*jira.WebhookSubscriptionScheme{
+			{
+				JqlFilter:      "project = KP",
+				FieldIdsFilter: []string{"summary", "status"},
+				Events:         []string{jira.WebhookIssueCreatedEvent, jira.WebhookIssueUpdatedEvent},
+			},
+		},
+	}
+
+	result, response, err := atlassian.Webhook.Register(context.Background(), payload)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	log.Println("Response HTTP Code", response.StatusCode)
+	log.Println("HTTP Endpoint Used", response.Endpoint)
+
+	var webhookIDs []int
+	for _, registration := range result.WebhookRegistrationResult {
+
+		if len(registration.Errors) != 0 {
+			log.Println("The webhook can't be registered", registration.Errors)
+			continue
+		}
+
+		webhookIDs = append(webhookIDs, registration.CreatedWebhookID)
+	}
+
+	expiration, _, err := atlassian.Webhook.Refresh(context.Background(), webhookIDs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("The webhooks expire on", expiration.ExpirationDate)
+
+	failed, _, err := atlassian.Webhook.Failed(context.Background(), 100, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, webhook := range failed.Values {
+		log.Println(webhook.ID, webhook.URL, webhook.FailureTime)
+	}
+}
@@ -31,7 +31,7 @@ func (f *FilterService) Create(ctx context.Context, payload *FilterBodyScheme) (
 	}
 
 	var endpoint = "rest/api/3/filter"
-	request, err := f.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/filter", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -58,7 +58,7 @@ func (f *FilterService) Favorite(ctx context.Context) (result *[]FilterScheme, r
 
 	var endpoint = "rest/api/3/filter/favourite"
 
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/filter/favourite", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -109,7 +109,7 @@ func (f *FilterService) My(ctx context.Context, favorites bool, expands []string
 		endpoint = "rest/api/3/filter/my"
 	}
 
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/filter/my", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -190,7 +190,7 @@ func (f *FilterService) Search(ctx context.Context, options *FilterSearchOptionS
 	params.Add("maxResults", strconv.Itoa(maxResults))
 
 	var endpoint = fmt.Sprintf("rest/api/3/filter/search?%v", params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/filter/search", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -237,7 +237,7 @@ func (f *FilterService) Get(ctx context.Context, filterID int, expands []string)
 		endpoint = fmt.Sprintf("rest/api/3/filter/%v", filterID)
 	}
 
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/filter/{filterID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -265,7 +265,7 @@ func (f *FilterService) Update(ctx context.Context, filterID int, payload *Filte
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/filter/%v", filterID)
-	request, err := f.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/filter/{filterID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -291,7 +291,7 @@ func (f *FilterService) Update(ctx context.Context, filterID int, payload *Filte
 func (f *FilterService) Delete(ctx context.Context, filterID int) (response *Response, err error) {
 
 	var endpoint = fmt.Sprintf("rest/api/3/filter/%v", filterID)
-	request, err := f.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodDelete, "rest/api/3/filter/{filterID}", endpoint, nil)
 	if err != nil {
 		return
 	}
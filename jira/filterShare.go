@@ -19,7 +19,7 @@ type shareFilterScopeScheme struct {
 func (f *FilterShareService) Scope(ctx context.Context) (scope string, response *Response, err error) {
 
 	var endpoint = "rest/api/3/filter/defaultShareScope"
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/filter/defaultShareScope", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -63,7 +63,7 @@ func (f *FilterShareService) SetScope(ctx context.Context, scope string) (respon
 	}
 
 	var endpoint = "rest/api/3/filter/defaultShareScope"
-	request, err := f.client.newRequest(ctx, http.MethodPut, endpoint, shareFilterScopeScheme{Scope: scope})
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/filter/defaultShareScope", endpoint, shareFilterScopeScheme{Scope: scope})
 	if err != nil {
 		return
 	}
@@ -83,7 +83,7 @@ func (f *FilterShareService) SetScope(ctx context.Context, scope string) (respon
 func (f *FilterShareService) Gets(ctx context.Context, filterID int) (result *[]SharePermissionScheme, response *Response, err error) {
 
 	var endpoint = fmt.Sprintf("rest/api/3/filter/%v/permission", filterID)
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/filter/{filterID}/permission", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -119,7 +119,7 @@ func (f *FilterShareService) Add(ctx context.Context, filterID int, payload *Per
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/filter/%v/permission", filterID)
-	request, err := f.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/filter/{filterID}/permission", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -147,7 +147,7 @@ func (f *FilterShareService) Add(ctx context.Context, filterID int, payload *Per
 func (f *FilterShareService) Get(ctx context.Context, filterID, permissionID int) (result *SharePermissionScheme, response *Response, err error) {
 
 	var endpoint = fmt.Sprintf("rest/api/3/filter/%v/permission/%v", filterID, permissionID)
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/filter/{filterID}/permission/{permissionID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -170,7 +170,7 @@ func (f *FilterShareService) Get(ctx context.Context, filterID, permissionID int
 func (f *FilterShareService) Delete(ctx context.Context, filterID, permissionID int) (response *Response, err error) {
 
 	var endpoint = fmt.Sprintf("rest/api/3/filter/%v/permission/%v", filterID, permissionID)
-	request, err := f.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodDelete, "rest/api/3/filter/{filterID}/permission/{permissionID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -41,7 +41,7 @@ func (g *GroupService) Create(ctx context.Context, groupName string) (result *Gr
 	}
 
 	var endpoint = "rest/api/3/group"
-	request, err := g.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := g.client.newRequest(ctx, http.MethodPost, "rest/api/3/group", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -74,7 +74,7 @@ func (g *GroupService) Delete(ctx context.Context, groupName string) (response *
 	params.Add("groupname", groupName)
 	var endpoint = fmt.Sprintf("rest/api/3/group?%v", params.Encode())
 
-	request, err := g.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := g.client.newRequest(ctx, http.MethodDelete, "rest/api/3/group", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -126,7 +126,7 @@ func (g *GroupService) Bulk(ctx context.Context, options *GroupBulkOptionsScheme
 
 	var endpoint = fmt.Sprintf("rest/api/3/group/bulk?%v", params.Encode())
 
-	request, err := g.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := g.client.newRequest(ctx, http.MethodGet, "rest/api/3/group/bulk", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -191,7 +191,7 @@ func (g *GroupService) Members(ctx context.Context, groupName string, inactive b
 
 	var endpoint = fmt.Sprintf("rest/api/3/group/member?%v", params.Encode())
 
-	request, err := g.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := g.client.newRequest(ctx, http.MethodGet, "rest/api/3/group/member", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -229,7 +229,7 @@ func (g *GroupService) Add(ctx context.Context, groupName, accountID string) (re
 	params.Add("groupname", groupName)
 	var endpoint = fmt.Sprintf("rest/api/3/group/user?%v", params.Encode())
 
-	request, err := g.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := g.client.newRequest(ctx, http.MethodPost, "rest/api/3/group/user", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -267,7 +267,7 @@ func (g *GroupService) Remove(ctx context.Context, groupName, accountID string)
 	params.Add("accountId", accountID)
 	var endpoint = fmt.Sprintf("rest/api/3/group/user?%v", params.Encode())
 
-	request, err := g.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := g.client.newRequest(ctx, http.MethodDelete, "rest/api/3/group/user", endpoint, nil)
 	if err != nil {
 		return
 	}
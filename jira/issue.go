@@ -465,13 +465,13 @@ func (i *IssueService) Create(ctx context.Context, payload *IssueScheme, customF
 			return nil, nil, err
 		}
 
-		request, err = i.client.newRequest(ctx, http.MethodPost, endpoint, payloadWithCustomFields)
+		request, err = i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue", endpoint, payloadWithCustomFields)
 		if err != nil {
 			return nil, nil, err
 		}
 	} else {
 
-		request, err = i.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+		request, err = i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue", endpoint, payload)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -542,7 +542,7 @@ func (i *IssueService) Creates(ctx context.Context, payload []*IssueBulkScheme)
 
 	var endpoint = "rest/api/3/issue/bulk"
 
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, issueUpdatesNode)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue/bulk", endpoint, issueUpdatesNode)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -637,7 +637,7 @@ func (i *IssueService) Get(ctx context.Context, issueKeyOrID string, fields []st
 		endpoint = fmt.Sprintf("rest/api/3/issue/%v", issueKeyOrID)
 	}
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issue/{issueKeyOrID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -743,7 +743,7 @@ func (i *IssueService) Update(ctx context.Context, issueKeyOrID string, notify b
 	// Executed when customfields or operation are not provided
 	if customFields == nil && operations == nil {
 
-		request, err = i.client.newRequest(ctx, http.MethodPut, endpoint, payload)
+		request, err = i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issue/{issueKeyOrID}", endpoint, payload)
 		if err != nil {
 			return nil, err
 		}
@@ -766,7 +766,7 @@ func (i *IssueService) Update(ctx context.Context, issueKeyOrID string, notify b
 		//Merge the map[string]interface{} into one
 		_ = mergo.Map(&payloadWithCustomFields, &payloadWithOperations, mergo.WithOverride)
 
-		request, err = i.client.newRequest(ctx, http.MethodPut, endpoint, payloadWithCustomFields)
+		request, err = i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issue/{issueKeyOrID}", endpoint, payloadWithCustomFields)
 		if err != nil {
 			return nil, err
 		}
@@ -781,7 +781,7 @@ func (i *IssueService) Update(ctx context.Context, issueKeyOrID string, notify b
 			return nil, err
 		}
 
-		request, err = i.client.newRequest(ctx, http.MethodPut, endpoint, payloadWithCustomFields)
+		request, err = i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issue/{issueKeyOrID}", endpoint, payloadWithCustomFields)
 		if err != nil {
 			return nil, err
 		}
@@ -796,7 +796,7 @@ func (i *IssueService) Update(ctx context.Context, issueKeyOrID string, notify b
 			return nil, err
 		}
 
-		request, err = i.client.newRequest(ctx, http.MethodPut, endpoint, payloadWithOperations)
+		request, err = i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issue/{issueKeyOrID}", endpoint, payloadWithOperations)
 		if err != nil {
 			return nil, err
 		}
@@ -822,7 +822,7 @@ func (i *IssueService) Delete(ctx context.Context, issueKeyOrID string) (respons
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v", issueKeyOrID)
-	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issue/{issueKeyOrID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -854,7 +854,7 @@ func (i *IssueService) Assign(ctx context.Context, issueKeyOrID, accountID strin
 
 	var endpoint = fmt.Sprintf("/rest/api/3/issue/%v/assignee", issueKeyOrID)
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issue/{issueKeyOrID}/assignee", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -918,7 +918,7 @@ func (i *IssueService) Notify(ctx context.Context, issueKeyOrID string, options
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/notify", issueKeyOrID)
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &options)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue/{issueKeyOrID}/notify", endpoint, &options)
 	if err != nil {
 		return
 	}
@@ -946,7 +946,7 @@ func (i *IssueService) Transitions(ctx context.Context, issueKeyOrID string) (re
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/transitions", issueKeyOrID)
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issue/{issueKeyOrID}/transitions", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -990,7 +990,7 @@ func (i *IssueService) Move(ctx context.Context, issueKeyOrID, transitionID stri
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/transitions", issueKeyOrID)
 
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue/{issueKeyOrID}/transitions", endpoint, &payload)
 	if err != nil {
 		return
 	}
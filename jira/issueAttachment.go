@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/internal/route"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -24,7 +25,7 @@ type AttachmentSettingScheme struct {
 func (a *AttachmentService) Settings(ctx context.Context) (result *AttachmentSettingScheme, response *Response, err error) {
 
 	var endpoint = "rest/api/3/attachment/meta"
-	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := a.client.newRequest(ctx, http.MethodGet, "rest/api/3/attachment/meta", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -65,7 +66,7 @@ func (a *AttachmentService) Metadata(ctx context.Context, attachmentID string) (
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/attachment/%v", attachmentID)
-	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := a.client.newRequest(ctx, http.MethodGet, "rest/api/3/attachment/{attachmentID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -94,7 +95,7 @@ func (a *AttachmentService) Delete(ctx context.Context, attachmentID string) (re
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/attachment/%v", attachmentID)
-	request, err := a.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := a.client.newRequest(ctx, http.MethodDelete, "rest/api/3/attachment/{attachmentID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -132,7 +133,7 @@ func (a *AttachmentService) Human(ctx context.Context, attachmentID string) (res
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/attachment/%v/expand/human", attachmentID)
-	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := a.client.newRequest(ctx, http.MethodGet, "rest/api/3/attachment/{attachmentID}/expand/human", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -204,7 +205,7 @@ func (a *AttachmentService) Add(issueKeyOrID string, path string) (result *[]Att
 	}
 
 	var endpoint = fmt.Sprintf("%vrest/api/3/issue/%v/attachments", a.client.Site.String(), issueKeyOrID)
-	request, err := http.NewRequest(http.MethodPost, endpoint, payload)
+	request, err := http.NewRequestWithContext(route.With(context.Background(), "rest/api/3/issue/{issueKeyOrID}/attachments"), http.MethodPost, endpoint, payload)
 	if err != nil {
 		return
 	}
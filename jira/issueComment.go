@@ -79,7 +79,7 @@ func (c *CommentService) Gets(ctx context.Context, issueKeyOrID, orderBy string,
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/comment?%v", issueKeyOrID, params.Encode())
-	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := c.client.newRequest(ctx, http.MethodGet, "rest/api/3/issue/{issueKeyOrID}/comment", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -113,7 +113,7 @@ func (c *CommentService) Get(ctx context.Context, issueKeyOrID, commentID string
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/comment/%v", issueKeyOrID, commentID)
 
-	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := c.client.newRequest(ctx, http.MethodGet, "rest/api/3/issue/{issueKeyOrID}/comment/{commentID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -147,7 +147,7 @@ func (c *CommentService) Delete(ctx context.Context, issueKeyOrID, commentID str
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/comment/%v", issueKeyOrID, commentID)
 
-	request, err := c.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := c.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issue/{issueKeyOrID}/comment/{commentID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -193,7 +193,7 @@ func (c *CommentService) Add(ctx context.Context, issueKeyOrID string, payload *
 		endpoint = fmt.Sprintf("rest/api/3/issue/%v/comment", issueKeyOrID)
 	}
 
-	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := c.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue/{issueKeyOrID}/comment", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 type FieldService struct {
@@ -57,7 +58,7 @@ type IssueFieldSchemaScheme struct {
 func (f *FieldService) Gets(ctx context.Context) (result *[]IssueFieldScheme, response *Response, err error) {
 
 	var endpoint = "rest/api/3/field"
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/field", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -100,7 +101,7 @@ func (f *FieldService) Create(ctx context.Context, payload *CustomFieldScheme) (
 	payload.Format()
 
 	var endpoint = "rest/api/3/field"
-	request, err := f.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/field", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -188,7 +189,7 @@ func (f *FieldService) Search(ctx context.Context, opts *FieldSearchOptionsSchem
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/search?%v", params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/field/search", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -207,3 +208,123 @@ func (f *FieldService) Search(ctx context.Context, opts *FieldSearchOptionsSchem
 
 	return
 }
+
+type FieldUpdatePayloadScheme struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	SearcherKey string `json:"searcherKey,omitempty"`
+}
+
+// Updates a custom field.
+// The searcherKey can be provided as the short key (e.g "textsearcher"), the Atlassian plugin prefix is appended automatically.
+// Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/fields#update-custom-field
+func (f *FieldService) Update(ctx context.Context, fieldID string, payload *FieldUpdatePayloadScheme) (response *Response, err error) {
+
+	if len(fieldID) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid fieldID value")
+	}
+
+	if payload == nil {
+		return nil, fmt.Errorf("error, payload value is nil, please provide a valid FieldUpdatePayloadScheme pointer")
+	}
+
+	// The payload of the caller isn't modified
+	payloadWithSearcher := *payload
+	if len(payloadWithSearcher.SearcherKey) != 0 && !strings.Contains(payloadWithSearcher.SearcherKey, ":") {
+		payloadWithSearcher.SearcherKey = fmt.Sprintf("com.atlassian.jira.plugin.system.customfieldtypes:%v", payloadWithSearcher.SearcherKey)
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/field/%v", fieldID)
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/field/{fieldID}", endpoint, &payloadWithSearcher)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = f.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Moves a custom field to trash, the trashed fields are deleted permanently after 60 days.
+// Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/fields#move-custom-field-to-trash
+func (f *FieldService) Trash(ctx context.Context, fieldID string) (response *Response, err error) {
+
+	if len(fieldID) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid fieldID value")
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/field/%v/trash", fieldID)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/field/{fieldID}/trash", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = f.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Restores a custom field from trash.
+// Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/fields#restore-custom-field-from-trash
+func (f *FieldService) Restore(ctx context.Context, fieldID string) (response *Response, err error) {
+
+	if len(fieldID) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid fieldID value")
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/field/%v/restore", fieldID)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/field/{fieldID}/restore", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = f.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes a custom field. The custom field is deleted whether it is in the trash or not.
+// This operation is asynchronous, use the TaskService to follow the progress of the returned task.
+// Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/fields#delete-custom-field
+func (f *FieldService) Delete(ctx context.Context, fieldID string) (result *TaskScheme, response *Response, err error) {
+
+	if len(fieldID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid fieldID value")
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/field/%v", fieldID)
+	request, err := f.client.newRequest(ctx, http.MethodDelete, "rest/api/3/field/{fieldID}", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = f.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(TaskScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return nil, response, fmt.Errorf("unable to marshall the response body, error: %v", err.Error())
+	}
+
+	return
+}
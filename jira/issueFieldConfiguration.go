@@ -43,7 +43,7 @@ func (f *FieldConfigurationService) Gets(ctx context.Context, IDs []int, isDefau
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/fieldconfiguration?%v", params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/fieldconfiguration", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -86,7 +86,7 @@ func (f *FieldConfigurationService) Items(ctx context.Context, fieldConfiguratio
 	params.Add("maxResults", strconv.Itoa(maxResults))
 
 	var endpoint = fmt.Sprintf("rest/api/3/fieldconfiguration/%v/fields?%v", fieldConfigurationID, params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/fieldconfiguration/{fieldConfigurationID}/fields", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -133,7 +133,7 @@ func (f *FieldConfigurationService) Schemes(ctx context.Context, IDs []int, star
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/fieldconfigurationscheme?%v", params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/fieldconfigurationscheme", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -180,7 +180,7 @@ func (f *FieldConfigurationService) IssueTypeItems(ctx context.Context, fieldCon
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/fieldconfigurationscheme/mapping?%v", params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/fieldconfigurationscheme/mapping", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -225,7 +225,7 @@ func (f *FieldConfigurationService) SchemesByProject(ctx context.Context, projec
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/fieldconfigurationscheme/project?%v", params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/fieldconfigurationscheme/project", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -67,7 +67,7 @@ func (f *FieldContextService) Gets(ctx context.Context, fieldID string, opts *Fi
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context?%v", fieldID, params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/field/{fieldID}/context", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -107,7 +107,7 @@ func (f *FieldContextService) Create(ctx context.Context, fieldID string, payloa
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context", fieldID)
-	request, err := f.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/field/{fieldID}/context", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -163,7 +163,7 @@ func (f *FieldContextService) GetDefaultValues(ctx context.Context, fieldID stri
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/defaultValue?%s", fieldID, params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/field/{fieldID}/context/defaultValue", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -187,6 +187,67 @@ type FieldContextDefaultPayloadScheme struct {
 	DefaultValues []*CustomFieldDefaultValueScheme `json:"defaultValues,omitempty"`
 }
 
+// Option appends the default option of a single select custom field for the context provided.
+func (f *FieldContextDefaultPayloadScheme) Option(contextID, optionID string) (err error) {
+
+	if len(contextID) == 0 {
+		return fmt.Errorf("error, please provide a valid contextID value")
+	}
+
+	if len(optionID) == 0 {
+		return fmt.Errorf("error, please provide a valid optionID value")
+	}
+
+	f.DefaultValues = append(f.DefaultValues, &CustomFieldDefaultValueScheme{
+		ContextID: contextID,
+		OptionID:  optionID,
+		Type:      "option.single",
+	})
+
+	return
+}
+
+// Options appends the default options of a multi select or checkbox custom field for the context provided.
+func (f *FieldContextDefaultPayloadScheme) Options(contextID string, optionIDs []string) (err error) {
+
+	if len(contextID) == 0 {
+		return fmt.Errorf("error, please provide a valid contextID value")
+	}
+
+	if len(optionIDs) == 0 {
+		return fmt.Errorf("error, please provide a valid optionIDs value")
+	}
+
+	f.DefaultValues = append(f.DefaultValues, &CustomFieldDefaultValueScheme{
+		ContextID: contextID,
+		OptionIDs: optionIDs,
+		Type:      "option.multiple",
+	})
+
+	return
+}
+
+// Cascading appends the default parent and child options of a cascading custom field for the context provided.
+func (f *FieldContextDefaultPayloadScheme) Cascading(contextID, optionID, cascadingOptionID string) (err error) {
+
+	if len(contextID) == 0 {
+		return fmt.Errorf("error, please provide a valid contextID value")
+	}
+
+	if len(optionID) == 0 {
+		return fmt.Errorf("error, please provide a valid optionID value")
+	}
+
+	f.DefaultValues = append(f.DefaultValues, &CustomFieldDefaultValueScheme{
+		ContextID:         contextID,
+		OptionID:          optionID,
+		CascadingOptionID: cascadingOptionID,
+		Type:              "option.cascading",
+	})
+
+	return
+}
+
 // Sets default for contexts of a custom field.
 // Default are defined using these objects:
 // Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/fields/context#set-custom-field-contexts-default-values
@@ -205,7 +266,7 @@ func (f *FieldContextService) SetDefaultValue(ctx context.Context, fieldID strin
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/defaultValue", fieldID)
-	request, err := f.client.newRequest(ctx, http.MethodPut, endpoint, payload)
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/field/{fieldID}/context/defaultValue", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -243,7 +304,7 @@ func (f *FieldContextService) Update(ctx context.Context, fieldID string, contex
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/%v", fieldID, contextID)
 
-	request, err := f.client.newRequest(ctx, http.MethodPut, endpoint, payload)
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/field/{fieldID}/context/{contextID}", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -273,7 +334,7 @@ func (f *FieldContextService) Delete(ctx context.Context, fieldID string, contex
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/%v", fieldID, contextID)
 
-	request, err := f.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodDelete, "rest/api/3/field/{fieldID}/context/{contextID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -306,7 +367,7 @@ func (f *FieldContextService) AddIssueTypes(ctx context.Context, fieldID string,
 		IssueTypeIds []string `json:"issueTypeIds"`
 	}{IssueTypeIds: issueTypesIDs}
 
-	request, err := f.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/field/{fieldID}/context/{contextID}/issuetype", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -341,7 +402,7 @@ func (f *FieldContextService) RemoveIssueTypes(ctx context.Context, fieldID stri
 		IssueTypeIds []string `json:"issueTypeIds"`
 	}{IssueTypeIds: issueTypesIDs}
 
-	request, err := f.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/field/{fieldID}/context/{contextID}/issuetype/remove", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -376,7 +437,7 @@ func (f *FieldContextService) Link(ctx context.Context, fieldID string, contextI
 		ProjectIds []string `json:"projectIds"`
 	}{ProjectIds: projectIDs}
 
-	request, err := f.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/field/{fieldID}/context/{contextID}/project", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -412,7 +473,7 @@ func (f *FieldContextService) UnLink(ctx context.Context, fieldID string, contex
 		ProjectIds []string `json:"projectIds"`
 	}{ProjectIds: projectIDs}
 
-	request, err := f.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/field/{fieldID}/context/{contextID}/project/remove", endpoint, &payload)
 	if err != nil {
 		return
 	}
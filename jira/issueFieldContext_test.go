@@ -1900,3 +1900,25 @@ func TestFieldContextService_Update(t *testing.T) {
 	}
 
 }
+
+func TestFieldContextDefaultPayloadScheme(t *testing.T) {
+
+	payload := &FieldContextDefaultPayloadScheme{}
+
+	assert.NoError(t, payload.Option("10138", "10022"))
+	assert.NoError(t, payload.Options("10139", []string{"10023", "10024"}))
+	assert.NoError(t, payload.Cascading("10140", "10025", "10026"))
+
+	assert.Error(t, payload.Option("", "10022"))
+	assert.Error(t, payload.Option("10138", ""))
+	assert.Error(t, payload.Options("10139", nil))
+	assert.Error(t, payload.Cascading("10140", "", "10026"))
+
+	if assert.Len(t, payload.DefaultValues, 3) {
+		assert.Equal(t, "option.single", payload.DefaultValues[0].Type)
+		assert.Equal(t, "option.multiple", payload.DefaultValues[1].Type)
+		assert.Equal(t, []string{"10023", "10024"}, payload.DefaultValues[1].OptionIDs)
+		assert.Equal(t, "option.cascading", payload.DefaultValues[2].Type)
+		assert.Equal(t, "10026", payload.DefaultValues[2].CascadingOptionID)
+	}
+}
@@ -0,0 +1,434 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type FieldMergeOptionsScheme struct {
+	DuplicateFieldID string // The custom field merged and moved to trash, e.g: customfield_10040
+	CanonicalFieldID string // The custom field receiving the values, e.g: customfield_10020
+	JQL              string // Optional JQL appended to narrow down the issues to migrate
+	Overwrite        bool   // Replaces the canonical value when the issue already has one
+	MigrateContexts  bool   // Creates the duplicate field contexts (and its options) on the canonical field
+	KeepDuplicate    bool   // Skips moving the duplicate field to trash after the verification
+	Notify           bool   // Sends the issue updated notification to the watchers
+}
+
+type FieldMergeResultScheme struct {
+	Contexts []string          `json:"contexts,omitempty"`
+	Updated  []string          `json:"updated,omitempty"`
+	Skipped  []string          `json:"skipped,omitempty"`
+	Failed   map[string]string `json:"failed,omitempty"`
+	Trashed  bool              `json:"trashed"`
+}
+
+type fieldValuePageScheme struct {
+	StartAt    int `json:"startAt"`
+	MaxResults int `json:"maxResults"`
+	Total      int `json:"total"`
+	Issues     []*struct {
+		ID     string                 `json:"id"`
+		Key    string                 `json:"key"`
+		Fields map[string]interface{} `json:"fields"`
+	} `json:"issues"`
+}
+
+const fieldMergePageSize = 100
+
+// Merge copies the values of a duplicated custom field into the canonical one across all the issues,
+// verifies no issue was left behind and moves the duplicated field to trash.
+//
+// The issues are searched in pages of 100 and the issues of each page are updated one by one: the value copied
+// is different on every issue, and the bulk endpoints of Jira Cloud can't set it, the bulk edit applies the same
+// value to all the selected issues and the bulk custom field value update only accepts the fields of Connect and
+// Forge apps. The failures are reported on the result and stop the field to be trashed.
+func (f *FieldService) Merge(ctx context.Context, opts *FieldMergeOptionsScheme) (result *FieldMergeResultScheme, err error) {
+
+	if opts == nil {
+		return nil, fmt.Errorf("error, opts value is nil, please provide a valid FieldMergeOptionsScheme pointer")
+	}
+
+	duplicateJQLID, err := customFieldJQLID(opts.DuplicateFieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalJQLID, err := customFieldJQLID(opts.CanonicalFieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DuplicateFieldID == opts.CanonicalFieldID {
+		return nil, fmt.Errorf("error, the duplicate and canonical fields are the same: %v", opts.DuplicateFieldID)
+	}
+
+	result = &FieldMergeResultScheme{Failed: make(map[string]string)}
+
+	if opts.MigrateContexts {
+
+		result.Contexts, err = f.migrateContexts(ctx, opts.DuplicateFieldID, opts.CanonicalFieldID)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	var jql = fmt.Sprintf("%v is not EMPTY", duplicateJQLID)
+	if len(opts.JQL) != 0 {
+		jql = fmt.Sprintf("%v AND (%v)", jql, opts.JQL)
+	}
+
+	var fields = []string{opts.DuplicateFieldID, opts.CanonicalFieldID}
+
+	for startAt := 0; ; startAt += fieldMergePageSize {
+
+		page, _, err := f.searchFieldValues(ctx, jql, fields, startAt, fieldMergePageSize)
+		if err != nil {
+			return result, err
+		}
+
+		for _, issue := range page.Issues {
+
+			if issue.Fields[opts.CanonicalFieldID] != nil && !opts.Overwrite {
+				result.Skipped = append(result.Skipped, issue.Key)
+				continue
+			}
+
+			customFields := &CustomFields{Fields: []map[string]interface{}{
+				{"fields": map[string]interface{}{opts.CanonicalFieldID: normalizeFieldValue(issue.Fields[opts.DuplicateFieldID])}},
+			}}
+
+			response, err := f.client.Issue.Update(ctx, issue.Key, opts.Notify, &IssueScheme{}, customFields, nil)
+			if err != nil {
+
+				if response != nil {
+					result.Failed[issue.Key] = string(response.BodyAsBytes)
+					continue
+				}
+
+				return result, err
+			}
+
+			result.Updated = append(result.Updated, issue.Key)
+		}
+
+		if len(page.Issues) == 0 || startAt+len(page.Issues) >= page.Total {
+			break
+		}
+	}
+
+	// Verify all the issues with a duplicate value have the canonical value populated
+	var verificationJQL = fmt.Sprintf("%v is not EMPTY AND %v is EMPTY", duplicateJQLID, canonicalJQLID)
+	if len(opts.JQL) != 0 {
+		verificationJQL = fmt.Sprintf("%v AND (%v)", verificationJQL, opts.JQL)
+	}
+
+	verification, _, err := f.searchFieldValues(ctx, verificationJQL, []string{opts.CanonicalFieldID}, 0, 0)
+	if err != nil {
+		return result, err
+	}
+
+	if verification.Total != 0 || len(result.Failed) != 0 {
+		return result, fmt.Errorf("error, %v issues were not migrated to the field %v, the field %v was not trashed",
+			verification.Total, opts.CanonicalFieldID, opts.DuplicateFieldID)
+	}
+
+	if opts.KeepDuplicate {
+		return
+	}
+
+	if _, err = f.Trash(ctx, opts.DuplicateFieldID); err != nil {
+		return result, err
+	}
+
+	result.Trashed = true
+	return
+}
+
+func (f *FieldService) searchFieldValues(ctx context.Context, jql string, fields []string, startAt, maxResults int) (result *fieldValuePageScheme, response *Response, err error) {
+
+	payload := struct {
+		Jql        string   `json:"jql"`
+		StartAt    int      `json:"startAt"`
+		MaxResults int      `json:"maxResults"`
+		Fields     []string `json:"fields,omitempty"`
+	}{
+		Jql:        jql,
+		StartAt:    startAt,
+		MaxResults: maxResults,
+		Fields:     fields,
+	}
+
+	var endpoint = "rest/api/3/search"
+
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/search", endpoint, &payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = f.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(fieldValuePageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return nil, response, fmt.Errorf("unable to marshall the response body, error: %v", err.Error())
+	}
+
+	return
+}
+
+// migrateContexts creates the contexts of the duplicate field missing (by name) on the canonical field,
+// the options are copied when the field is a select, checkbox, radio button or cascading field.
+func (f *FieldService) migrateContexts(ctx context.Context, duplicateFieldID, canonicalFieldID string) (created []string, err error) {
+
+	fields, _, err := f.Gets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasOptions bool
+	for _, field := range *fields {
+
+		if field.ID == duplicateFieldID && field.Schema != nil {
+			hasOptions = isOptionFieldType(field.Schema.Custom)
+		}
+	}
+
+	duplicateContexts, err := f.allContexts(ctx, duplicateFieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	canonicalContexts, err := f.allContexts(ctx, canonicalFieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	var canonicalNames = make(map[string]bool)
+	for _, fieldContext := range canonicalContexts {
+		canonicalNames[fieldContext.Name] = true
+	}
+
+	for _, fieldContext := range duplicateContexts {
+
+		if canonicalNames[fieldContext.Name] {
+			continue
+		}
+
+		payload := &FieldContextPayloadScheme{
+			Name:        fieldContext.Name,
+			Description: fieldContext.Description,
+		}
+
+		for _, projectID := range fieldContext.ProjectIds {
+
+			projectIDAsInt, err := strconv.Atoi(projectID)
+			if err != nil {
+				return created, err
+			}
+
+			payload.ProjectIDs = append(payload.ProjectIDs, projectIDAsInt)
+		}
+
+		for _, issueTypeID := range fieldContext.IssueTypeIds {
+
+			issueTypeIDAsInt, err := strconv.Atoi(issueTypeID)
+			if err != nil {
+				return created, err
+			}
+
+			payload.IssueTypeIDs = append(payload.IssueTypeIDs, issueTypeIDAsInt)
+		}
+
+		newContext, _, err := f.Context.Create(ctx, canonicalFieldID, payload)
+		if err != nil {
+			return created, err
+		}
+
+		created = append(created, newContext.Name)
+
+		if !hasOptions {
+			continue
+		}
+
+		if err = f.copyContextOptions(ctx, duplicateFieldID, fieldContext.ID, canonicalFieldID, newContext.ID); err != nil {
+			return created, err
+		}
+	}
+
+	return
+}
+
+func (f *FieldService) allContexts(ctx context.Context, fieldID string) (contexts []*FieldContextScheme, err error) {
+
+	for startAt := 0; ; startAt += fieldMergePageSize {
+
+		page, _, err := f.Context.Gets(ctx, fieldID, nil, startAt, fieldMergePageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		contexts = append(contexts, page.Values...)
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	return
+}
+
+func (f *FieldService) copyContextOptions(ctx context.Context, fromFieldID, fromContextID, toFieldID, toContextID string) (err error) {
+
+	fromContextIDAsInt, err := strconv.Atoi(fromContextID)
+	if err != nil {
+		return err
+	}
+
+	toContextIDAsInt, err := strconv.Atoi(toContextID)
+	if err != nil {
+		return err
+	}
+
+	var options []*CustomFieldContextOptionScheme
+	for startAt := 0; ; startAt += fieldMergePageSize {
+
+		page, _, err := f.Context.Option.Gets(ctx, fromFieldID, fromContextIDAsInt, nil, startAt, fieldMergePageSize)
+		if err != nil {
+			return err
+		}
+
+		options = append(options, page.Values...)
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	// The parent options are created first, the cascading options reference the new parent IDs
+	var parents, children []*CustomFieldContextOptionScheme
+	for _, option := range options {
+
+		if len(option.OptionID) == 0 {
+			parents = append(parents, &CustomFieldContextOptionScheme{Value: option.Value, Disabled: option.Disabled})
+			continue
+		}
+
+		children = append(children, option)
+	}
+
+	if len(parents) == 0 {
+		return
+	}
+
+	createdParents, _, err := f.Context.Option.Create(ctx, toFieldID, toContextIDAsInt, &FieldContextOptionListScheme{Options: parents})
+	if err != nil {
+		return err
+	}
+
+	var parentIDsByValue = make(map[string]string)
+	for _, option := range createdParents.Options {
+		parentIDsByValue[option.Value] = option.ID
+	}
+
+	var parentValuesByID = make(map[string]string)
+	for _, option := range options {
+		if len(option.OptionID) == 0 {
+			parentValuesByID[option.ID] = option.Value
+		}
+	}
+
+	var cascading []*CustomFieldContextOptionScheme
+	for _, option := range children {
+
+		cascading = append(cascading, &CustomFieldContextOptionScheme{
+			Value:    option.Value,
+			Disabled: option.Disabled,
+			OptionID: parentIDsByValue[parentValuesByID[option.OptionID]],
+		})
+	}
+
+	if len(cascading) == 0 {
+		return
+	}
+
+	_, _, err = f.Context.Option.Create(ctx, toFieldID, toContextIDAsInt, &FieldContextOptionListScheme{Options: cascading})
+	return
+}
+
+// customFieldJQLID converts a custom field ID (customfield_10040) into its JQL representation (cf[10040]).
+func customFieldJQLID(fieldID string) (jqlID string, err error) {
+
+	if !strings.HasPrefix(fieldID, "customfield_") {
+		return "", fmt.Errorf("error, the field %q is not a custom field, please provide a valid custom field ID", fieldID)
+	}
+
+	numericID := strings.TrimPrefix(fieldID, "customfield_")
+	if _, err = strconv.Atoi(numericID); err != nil {
+		return "", fmt.Errorf("error, the field %q is not a custom field, please provide a valid custom field ID", fieldID)
+	}
+
+	return fmt.Sprintf("cf[%v]", numericID), nil
+}
+
+func isOptionFieldType(customType string) bool {
+
+	for _, fieldType := range []string{"select", "multiselect", "cascadingselect", "multicheckboxes", "radiobuttons"} {
+		if customType == "com.atlassian.jira.plugin.system.customfieldtypes:"+fieldType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeFieldValue reduces the value returned by the search into the format accepted by the issue edit,
+// the options are referenced by value (the option IDs are different per field), users by accountId and
+// groups, versions or components by name.
+func normalizeFieldValue(value interface{}) interface{} {
+
+	switch typed := value.(type) {
+
+	case []interface{}:
+
+		var values = make([]interface{}, 0, len(typed))
+		for _, item := range typed {
+			values = append(values, normalizeFieldValue(item))
+		}
+
+		return values
+
+	case map[string]interface{}:
+
+		if accountID, ok := typed["accountId"]; ok {
+			return map[string]interface{}{"accountId": accountID}
+		}
+
+		if optionValue, ok := typed["value"]; ok {
+
+			var option = map[string]interface{}{"value": optionValue}
+			if child, ok := typed["child"]; ok {
+				option["child"] = normalizeFieldValue(child)
+			}
+
+			return option
+		}
+
+		if name, ok := typed["name"]; ok {
+			return map[string]interface{}{"name": name}
+		}
+
+		return typed
+	}
+
+	return value
+}
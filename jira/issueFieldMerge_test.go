@@ -0,0 +1,234 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFieldService_Merge(t *testing.T) {
+
+	testCases := []struct {
+		name              string
+		options           *FieldMergeOptionsScheme
+		pendingIssues     int
+		wantUpdated       []string
+		wantSkipped       []string
+		wantTrashed       bool
+		wantCanonicalBody string
+		wantErr           bool
+	}{
+		{
+			name: "MergeFieldsWhenTheOptionsAreCorrect",
+			options: &FieldMergeOptionsScheme{
+				DuplicateFieldID: "customfield_10040",
+				CanonicalFieldID: "customfield_10020",
+			},
+			wantUpdated:       []string{"KP-1", "KP-3"},
+			wantSkipped:       []string{"KP-2"},
+			wantTrashed:       true,
+			wantCanonicalBody: `{"fields":{"customfield_10020":{"child":{"value":"Brooklyn"},"value":"New York"}}}`,
+			wantErr:           false,
+		},
+		{
+			name: "MergeFieldsWhenTheOverwriteIsEnabled",
+			options: &FieldMergeOptionsScheme{
+				DuplicateFieldID: "customfield_10040",
+				CanonicalFieldID: "customfield_10020",
+				Overwrite:        true,
+				KeepDuplicate:    true,
+			},
+			wantUpdated: []string{"KP-1", "KP-2", "KP-3"},
+			wantTrashed: false,
+			wantErr:     false,
+		},
+		{
+			name: "MergeFieldsWhenTheVerificationFails",
+			options: &FieldMergeOptionsScheme{
+				DuplicateFieldID: "customfield_10040",
+				CanonicalFieldID: "customfield_10020",
+			},
+			pendingIssues: 1,
+			wantUpdated:   []string{"KP-1", "KP-3"},
+			wantSkipped:   []string{"KP-2"},
+			wantTrashed:   false,
+			wantErr:       true,
+		},
+		{
+			name: "MergeFieldsWhenTheFieldsAreTheSame",
+			options: &FieldMergeOptionsScheme{
+				DuplicateFieldID: "customfield_10040",
+				CanonicalFieldID: "customfield_10040",
+			},
+			wantErr: true,
+		},
+		{
+			name: "MergeFieldsWhenTheFieldIsNotACustomField",
+			options: &FieldMergeOptionsScheme{
+				DuplicateFieldID: "summary",
+				CanonicalFieldID: "customfield_10020",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "MergeFieldsWhenTheOptionsAreNil",
+			options: nil,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var (
+				trashed       bool
+				canonicalBody string
+			)
+
+			mux := http.NewServeMux()
+
+			mux.HandleFunc("/rest/api/3/search", func(w http.ResponseWriter, r *http.Request) {
+
+				payload := struct {
+					Jql string `json:"jql"`
+				}{}
+
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				if strings.Contains(payload.Jql, "cf[10020] is EMPTY") {
+					_, _ = w.Write([]byte(`{"startAt":0,"maxResults":0,"total":` + strconv.Itoa(testCase.pendingIssues) + `,"issues":[]}`))
+					return
+				}
+
+				if payload.Jql != "cf[10040] is not EMPTY" {
+					http.Error(w, "unexpected jql: "+payload.Jql, http.StatusBadRequest)
+					return
+				}
+
+				mockResponse, err := ioutil.ReadFile("./mocks/search-field-values.json")
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusInternalServerError)
+					return
+				}
+
+				_, _ = w.Write(mockResponse)
+			})
+
+			mux.HandleFunc("/rest/api/3/issue/", func(w http.ResponseWriter, r *http.Request) {
+
+				if r.Method != http.MethodPut {
+					http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+					return
+				}
+
+				body, _ := ioutil.ReadAll(r.Body)
+				if strings.HasSuffix(r.URL.Path, "KP-1") {
+					canonicalBody = strings.TrimSpace(string(body))
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			mux.HandleFunc("/rest/api/3/field/customfield_10040/trash", func(w http.ResponseWriter, r *http.Request) {
+				trashed = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			mockServer := httptest.NewServer(mux)
+			defer mockServer.Close()
+
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotResult, err := mockClient.Issue.Field.Merge(context.Background(), testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if gotResult == nil {
+				return
+			}
+
+			assert.Equal(t, testCase.wantUpdated, gotResult.Updated)
+			assert.Equal(t, testCase.wantSkipped, gotResult.Skipped)
+			assert.Equal(t, testCase.wantTrashed, gotResult.Trashed)
+			assert.Equal(t, testCase.wantTrashed, trashed)
+
+			if len(testCase.wantCanonicalBody) != 0 {
+				assert.JSONEq(t, testCase.wantCanonicalBody, canonicalBody)
+			}
+		})
+	}
+}
+
+func Test_normalizeFieldValue(t *testing.T) {
+
+	testCases := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "WhenTheValueIsAText",
+			value: `"Alliance"`,
+			want:  `"Alliance"`,
+		},
+		{
+			name:  "WhenTheValueIsAUser",
+			value: `{"self":"https://ctreminiom.atlassian.net/rest/api/3/user?accountId=5b86be50b8e3cb5895860d6d","accountId":"5b86be50b8e3cb5895860d6d","displayName":"Carlos Treminio"}`,
+			want:  `{"accountId":"5b86be50b8e3cb5895860d6d"}`,
+		},
+		{
+			name:  "WhenTheValueIsAMultiSelect",
+			value: `[{"self":"https://ctreminiom.atlassian.net/rest/api/3/customFieldOption/10001","value":"New York","id":"10001"}]`,
+			want:  `[{"value":"New York"}]`,
+		},
+		{
+			name:  "WhenTheValueIsAGroupList",
+			value: `[{"name":"jira-users","self":"https://ctreminiom.atlassian.net/rest/api/3/group?groupname=jira-users"}]`,
+			want:  `[{"name":"jira-users"}]`,
+		},
+		{
+			name:  "WhenTheValueIsANumber",
+			value: `42.5`,
+			want:  `42.5`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var value interface{}
+			if err := json.Unmarshal([]byte(testCase.value), &value); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := json.Marshal(normalizeFieldValue(value))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.JSONEq(t, testCase.want, string(got))
+		})
+	}
+}
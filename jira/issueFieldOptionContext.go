@@ -54,7 +54,7 @@ func (f *FieldOptionContextService) Gets(ctx context.Context, fieldID string, co
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/%v/option?%v", fieldID, contextID, params.Encode())
-	request, err := f.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodGet, "rest/api/3/field/{fieldID}/context/{contextID}/option", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -93,7 +93,7 @@ func (f *FieldOptionContextService) Create(ctx context.Context, fieldID string,
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/%v/option", fieldID, contextID)
 
-	request, err := f.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := f.client.newRequest(ctx, http.MethodPost, "rest/api/3/field/{fieldID}/context/{contextID}/option", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -130,7 +130,7 @@ func (f *FieldOptionContextService) Update(ctx context.Context, fieldID string,
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/%v/option", fieldID, contextID)
 
-	request, err := f.client.newRequest(ctx, http.MethodPut, endpoint, payload)
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/field/{fieldID}/context/{contextID}/option", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -169,7 +169,7 @@ func (f *FieldOptionContextService) Delete(ctx context.Context, fieldID string,
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/%v/option/%v", fieldID, contextID, optionID)
 
-	request, err := f.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := f.client.newRequest(ctx, http.MethodDelete, "rest/api/3/field/{fieldID}/context/{contextID}/option/{optionID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -181,3 +181,52 @@ func (f *FieldOptionContextService) Delete(ctx context.Context, fieldID string,
 
 	return
 }
+
+type OrderFieldOptionPayloadScheme struct {
+	After                string   `json:"after,omitempty"`
+	Position             string   `json:"position,omitempty"`
+	CustomFieldOptionIds []string `json:"customFieldOptionIds,omitempty"`
+}
+
+// Changes the order of custom field options or cascading options in a context.
+// The options are moved after the option provided on the After param, or to the First/Last Position.
+// Docs: https://docs.go-atlassian.io/jira-software-cloud/issues/fields/context/option#reorder-custom-field-options
+func (f *FieldOptionContextService) Order(ctx context.Context, fieldID string, contextID int, payload *OrderFieldOptionPayloadScheme) (response *Response, err error) {
+
+	if fieldID == "" {
+		return nil, fmt.Errorf("error, fieldID value is nil, please provide a valid fieldID value")
+	}
+
+	if contextID == 0 {
+		return nil, fmt.Errorf("error, please provide a valid contextID value")
+	}
+
+	if payload == nil {
+		return nil, fmt.Errorf("error, payload value is nil, please provide a valid OrderFieldOptionPayloadScheme pointer")
+	}
+
+	if len(payload.CustomFieldOptionIds) == 0 {
+		return nil, fmt.Errorf("error, please provide at least one custom field option ID")
+	}
+
+	if len(payload.After) == 0 && payload.Position != "First" && payload.Position != "Last" {
+		return nil, fmt.Errorf("error, please provide the After option ID or a valid Position (First, Last)")
+	}
+
+	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/%v/option/move", fieldID, contextID)
+
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/field/{fieldID}/context/{contextID}/option/move", endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = f.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
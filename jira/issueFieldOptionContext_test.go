@@ -1080,3 +1080,190 @@ func TestFieldOptionContextService_Update(t *testing.T) {
 	}
 
 }
+
+func TestFieldOptionContextService_Order(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		fieldID            string
+		contextID          int
+		payload            *OrderFieldOptionPayloadScheme
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:      "OrderFieldOptionsWhenThePositionIsProvided",
+			fieldID:   "customfield_10038",
+			contextID: 10180,
+			payload: &OrderFieldOptionPayloadScheme{
+				Position:             "First",
+				CustomFieldOptionIds: []string{"10064", "10065"},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+		{
+			name:      "OrderFieldOptionsWhenTheAfterOptionIsProvided",
+			fieldID:   "customfield_10038",
+			contextID: 10180,
+			payload: &OrderFieldOptionPayloadScheme{
+				After:                "10066",
+				CustomFieldOptionIds: []string{"10064"},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+		{
+			name:      "OrderFieldOptionsWhenThePositionIsInvalid",
+			fieldID:   "customfield_10038",
+			contextID: 10180,
+			payload: &OrderFieldOptionPayloadScheme{
+				Position:             "Middle",
+				CustomFieldOptionIds: []string{"10064"},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "OrderFieldOptionsWhenTheOptionIDsAreNotProvided",
+			fieldID:            "customfield_10038",
+			contextID:          10180,
+			payload:            &OrderFieldOptionPayloadScheme{Position: "Last"},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "OrderFieldOptionsWhenThePayloadIsNil",
+			fieldID:            "customfield_10038",
+			contextID:          10180,
+			payload:            nil,
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:      "OrderFieldOptionsWhenTheFieldIDIsNotProvided",
+			fieldID:   "",
+			contextID: 10180,
+			payload: &OrderFieldOptionPayloadScheme{
+				Position:             "First",
+				CustomFieldOptionIds: []string{"10064"},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:      "OrderFieldOptionsWhenTheContextIDIsNotProvided",
+			fieldID:   "customfield_10038",
+			contextID: 0,
+			payload: &OrderFieldOptionPayloadScheme{
+				Position:             "First",
+				CustomFieldOptionIds: []string{"10064"},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:      "OrderFieldOptionsWhenTheRequestMethodIsIncorrect",
+			fieldID:   "customfield_10038",
+			contextID: 10180,
+			payload: &OrderFieldOptionPayloadScheme{
+				Position:             "First",
+				CustomFieldOptionIds: []string{"10064"},
+			},
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:      "OrderFieldOptionsWhenTheStatusCodeIsIncorrect",
+			fieldID:   "customfield_10038",
+			contextID: 10180,
+			payload: &OrderFieldOptionPayloadScheme{
+				Position:             "First",
+				CustomFieldOptionIds: []string{"10064"},
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option/move",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &FieldOptionContextService{client: mockClient}
+			gotResponse, err := service.Order(testCase.context, testCase.fieldID, testCase.contextID, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+			}
+
+		})
+	}
+}
@@ -0,0 +1,492 @@
+package jira
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type FieldOptionTreeScheme struct {
+	Options []*FieldOptionNodeScheme `json:"options,omitempty"`
+}
+
+type FieldOptionNodeScheme struct {
+	ID       string                   `json:"id,omitempty"`
+	Value    string                   `json:"value,omitempty"`
+	Disabled bool                     `json:"disabled,omitempty"`
+	Children []*FieldOptionNodeScheme `json:"children,omitempty"`
+}
+
+type FieldOptionSyncResultScheme struct {
+	DryRun  bool                           `json:"dryRun"`
+	Actions []*FieldOptionSyncActionScheme `json:"actions,omitempty"`
+}
+
+type FieldOptionSyncActionScheme struct {
+	Action   string   `json:"action"`
+	ID       string   `json:"id,omitempty"`
+	Parent   string   `json:"parent,omitempty"`
+	Value    string   `json:"value,omitempty"`
+	From     string   `json:"from,omitempty"`
+	Order    []string `json:"order,omitempty"`
+	Disabled bool     `json:"disabled,omitempty"`
+}
+
+const (
+	FieldOptionCreateAction  = "create"
+	FieldOptionRenameAction  = "rename"
+	FieldOptionDisableAction = "disable"
+	FieldOptionEnableAction  = "enable"
+	FieldOptionMoveAction    = "move"
+)
+
+// Tree returns the options of a custom field context as a tree, the cascading options are nested under its parent.
+// The options are returned in the order they display in Jira.
+func (f *FieldOptionContextService) Tree(ctx context.Context, fieldID string, contextID int) (result *FieldOptionTreeScheme, err error) {
+
+	if fieldID == "" {
+		return nil, fmt.Errorf("error, fieldID value is nil, please provide a valid fieldID value")
+	}
+
+	if contextID == 0 {
+		return nil, fmt.Errorf("error, please provide a valid contextID value")
+	}
+
+	var options []*CustomFieldContextOptionScheme
+	for startAt := 0; ; startAt += 100 {
+
+		page, _, err := f.Gets(ctx, fieldID, contextID, nil, startAt, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, page.Values...)
+
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	return newFieldOptionTree(options), nil
+}
+
+// Sync compares the options of the custom field context with the desired tree and applies the differences.
+// The options are matched by ID (when it's provided) or by value under the same parent, the options are never
+// deleted, the options missing on the desired tree are disabled, so the issue values are preserved.
+// The actions are planned but not executed when dryRun is enabled.
+func (f *FieldOptionContextService) Sync(ctx context.Context, fieldID string, contextID int, desired *FieldOptionTreeScheme, dryRun bool) (result *FieldOptionSyncResultScheme, err error) {
+
+	if desired == nil {
+		return nil, fmt.Errorf("error, desired value is nil, please provide a valid FieldOptionTreeScheme pointer")
+	}
+
+	current, err := f.Tree(ctx, fieldID, contextID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planFieldOptionTree(current, desired)
+	if err != nil {
+		return nil, err
+	}
+
+	result = &FieldOptionSyncResultScheme{DryRun: dryRun, Actions: plan.log}
+	if dryRun {
+		return
+	}
+
+	// 1. Rename, enable and disable the existing options
+	if len(plan.updates) != 0 {
+
+		if _, err = f.updateTree(ctx, fieldID, contextID, plan.updates); err != nil {
+			return result, err
+		}
+	}
+
+	// 2. Create the new parent options
+	if len(plan.parents) != 0 {
+
+		var options []*CustomFieldContextOptionScheme
+		for _, node := range plan.parents {
+			options = append(options, &CustomFieldContextOptionScheme{Value: node.value, Disabled: node.disabled})
+		}
+
+		created, _, err := f.Create(ctx, fieldID, contextID, &FieldContextOptionListScheme{Options: options})
+		if err != nil {
+			return result, err
+		}
+
+		for _, option := range created.Options {
+			for _, node := range plan.parents {
+				if node.value == option.Value {
+					node.id = option.ID
+				}
+			}
+		}
+	}
+
+	// 3. Create the new cascading options, the parents were created on the previous step
+	if len(plan.children) != 0 {
+
+		var options []*CustomFieldContextOptionScheme
+		for _, node := range plan.children {
+			options = append(options, &CustomFieldContextOptionScheme{Value: node.value, Disabled: node.disabled, OptionID: node.parent.id})
+		}
+
+		created, _, err := f.Create(ctx, fieldID, contextID, &FieldContextOptionListScheme{Options: options})
+		if err != nil {
+			return result, err
+		}
+
+		for _, option := range created.Options {
+			for _, node := range plan.children {
+				if node.value == option.Value && node.parent.id == option.OptionID {
+					node.id = option.ID
+				}
+			}
+		}
+	}
+
+	// 4. Reorder the options, the new options are appended by Jira at the end of each level
+	for _, level := range plan.moves {
+
+		var optionIDs []string
+		for _, node := range level.nodes {
+
+			if len(node.id) == 0 {
+				return result, fmt.Errorf("error, the option %q was not created, the options cannot be reordered", node.value)
+			}
+
+			optionIDs = append(optionIDs, node.id)
+		}
+
+		payload := &OrderFieldOptionPayloadScheme{Position: "First", CustomFieldOptionIds: optionIDs}
+		if _, err = f.Order(ctx, fieldID, contextID, payload); err != nil {
+			return result, err
+		}
+	}
+
+	return
+}
+
+// ParseFieldOptionTreeCSV reads a desired option tree from a CSV document.
+// Each record contains the option value, the cascading option value (optional) and the disabled flag (optional),
+// e.g: "New York,Brooklyn,false". The options keep the order of the records.
+func ParseFieldOptionTreeCSV(reader io.Reader, hasHeader bool) (result *FieldOptionTreeScheme, err error) {
+
+	if reader == nil {
+		return nil, fmt.Errorf("error, please provide a valid io.Reader value")
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if hasHeader && len(records) != 0 {
+		records = records[1:]
+	}
+
+	result = &FieldOptionTreeScheme{}
+	var parents = make(map[string]*FieldOptionNodeScheme)
+
+	for index, record := range records {
+
+		var value, childValue, disabledAsString string
+
+		switch len(record) {
+		case 3:
+			disabledAsString = strings.TrimSpace(record[2])
+			fallthrough
+		case 2:
+			childValue = strings.TrimSpace(record[1])
+			fallthrough
+		case 1:
+			value = strings.TrimSpace(record[0])
+		default:
+			return nil, fmt.Errorf("error, the record #%v has %v columns, please provide between 1 and 3 columns", index+1, len(record))
+		}
+
+		if len(value) == 0 {
+			return nil, fmt.Errorf("error, the record #%v does not contain the option value", index+1)
+		}
+
+		var disabled bool
+		if len(disabledAsString) != 0 {
+
+			disabled, err = strconv.ParseBool(disabledAsString)
+			if err != nil {
+				return nil, fmt.Errorf("error, the record #%v contains an invalid disabled value: %v", index+1, disabledAsString)
+			}
+		}
+
+		parent, ok := parents[value]
+		if !ok {
+			parent = &FieldOptionNodeScheme{Value: value}
+			parents[value] = parent
+			result.Options = append(result.Options, parent)
+		}
+
+		if len(childValue) == 0 {
+			parent.Disabled = disabled
+			continue
+		}
+
+		parent.Children = append(parent.Children, &FieldOptionNodeScheme{Value: childValue, Disabled: disabled})
+	}
+
+	return
+}
+
+func newFieldOptionTree(options []*CustomFieldContextOptionScheme) *FieldOptionTreeScheme {
+
+	var (
+		tree    = &FieldOptionTreeScheme{}
+		parents = make(map[string]*FieldOptionNodeScheme)
+	)
+
+	for _, option := range options {
+
+		if len(option.OptionID) != 0 {
+			continue
+		}
+
+		node := &FieldOptionNodeScheme{ID: option.ID, Value: option.Value, Disabled: option.Disabled}
+		parents[option.ID] = node
+		tree.Options = append(tree.Options, node)
+	}
+
+	for _, option := range options {
+
+		parent, ok := parents[option.OptionID]
+		if len(option.OptionID) == 0 || !ok {
+			continue
+		}
+
+		parent.Children = append(parent.Children, &FieldOptionNodeScheme{ID: option.ID, Value: option.Value, Disabled: option.Disabled})
+	}
+
+	return tree
+}
+
+type fieldOptionPlanNode struct {
+	id       string
+	value    string
+	disabled bool
+	parent   *fieldOptionPlanNode
+}
+
+// fieldOptionUpdateScheme is an option updated by Sync, the disabled value is always sent so the disabled options
+// can be enabled again, CustomFieldContextOptionScheme omits the false value.
+type fieldOptionUpdateScheme struct {
+	ID       string `json:"id"`
+	Value    string `json:"value,omitempty"`
+	Disabled bool   `json:"disabled"`
+}
+
+func (f *FieldOptionContextService) updateTree(ctx context.Context, fieldID string, contextID int, options []*fieldOptionUpdateScheme) (response *Response, err error) {
+
+	payload := struct {
+		Options []*fieldOptionUpdateScheme `json:"options"`
+	}{Options: options}
+
+	var endpoint = fmt.Sprintf("rest/api/3/field/%v/context/%v/option", fieldID, contextID)
+
+	request, err := f.client.newRequest(ctx, http.MethodPut, "rest/api/3/field/{fieldID}/context/{contextID}/option", endpoint, &payload)
+	if err != nil {
+		return
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	return f.client.Do(request)
+}
+
+type fieldOptionPlanLevel struct {
+	parent *fieldOptionPlanNode
+	nodes  []*fieldOptionPlanNode
+}
+
+type fieldOptionPlan struct {
+	updates  []*fieldOptionUpdateScheme
+	parents  []*fieldOptionPlanNode
+	children []*fieldOptionPlanNode
+	moves    []*fieldOptionPlanLevel
+
+	log []*FieldOptionSyncActionScheme
+}
+
+func planFieldOptionTree(current, desired *FieldOptionTreeScheme) (plan *fieldOptionPlan, err error) {
+
+	plan = &fieldOptionPlan{}
+
+	parentLevel, err := plan.level(nil, current.Options, desired.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	for index, desiredNode := range desired.Options {
+
+		parent := parentLevel.nodes[index]
+
+		var currentChildren []*FieldOptionNodeScheme
+		for _, currentNode := range current.Options {
+			if len(parent.id) != 0 && currentNode.ID == parent.id {
+				currentChildren = currentNode.Children
+			}
+		}
+
+		if _, err = plan.level(parent, currentChildren, desiredNode.Children); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// level plans the actions of the sibling options under the same parent (nil for the parent options).
+func (p *fieldOptionPlan) level(parent *fieldOptionPlanNode, current, desired []*FieldOptionNodeScheme) (level *fieldOptionPlanLevel, err error) {
+
+	level = &fieldOptionPlanLevel{parent: parent}
+
+	var (
+		parentValue string
+		matched     = make(map[*FieldOptionNodeScheme]*fieldOptionPlanNode)
+		reserved    = make(map[string]bool)
+		values      = make(map[string]bool)
+	)
+
+	if parent != nil {
+		parentValue = parent.value
+	}
+
+	// The options referenced by ID cannot be matched by value with other options
+	for _, desiredNode := range desired {
+		if len(desiredNode.ID) != 0 {
+			reserved[desiredNode.ID] = true
+		}
+	}
+
+	for _, desiredNode := range desired {
+
+		if len(desiredNode.Value) == 0 {
+			return nil, fmt.Errorf("error, the option tree contains an option without value under %q", parentValue)
+		}
+
+		if values[desiredNode.Value] {
+			return nil, fmt.Errorf("error, the option %q is duplicated under %q", desiredNode.Value, parentValue)
+		}
+
+		values[desiredNode.Value] = true
+
+		currentNode := matchFieldOptionNode(current, desiredNode, matched, reserved)
+		if currentNode == nil && len(desiredNode.ID) != 0 {
+			return nil, fmt.Errorf("error, the option %v (%q) does not exist under %q", desiredNode.ID, desiredNode.Value, parentValue)
+		}
+
+		node := &fieldOptionPlanNode{value: desiredNode.Value, disabled: desiredNode.Disabled, parent: parent}
+		level.nodes = append(level.nodes, node)
+
+		if currentNode == nil {
+
+			if parent == nil {
+				p.parents = append(p.parents, node)
+			} else {
+				p.children = append(p.children, node)
+			}
+
+			p.log = append(p.log, &FieldOptionSyncActionScheme{Action: FieldOptionCreateAction, Parent: parentValue, Value: node.value, Disabled: node.disabled})
+			continue
+		}
+
+		matched[currentNode] = node
+		node.id = currentNode.ID
+
+		if currentNode.Value == desiredNode.Value && currentNode.Disabled == desiredNode.Disabled {
+			continue
+		}
+
+		p.updates = append(p.updates, &fieldOptionUpdateScheme{ID: currentNode.ID, Value: desiredNode.Value, Disabled: desiredNode.Disabled})
+
+		if currentNode.Value != desiredNode.Value {
+			p.log = append(p.log, &FieldOptionSyncActionScheme{Action: FieldOptionRenameAction, ID: currentNode.ID, Parent: parentValue, Value: desiredNode.Value, From: currentNode.Value})
+		}
+
+		if currentNode.Disabled != desiredNode.Disabled {
+
+			var action = FieldOptionEnableAction
+			if desiredNode.Disabled {
+				action = FieldOptionDisableAction
+			}
+
+			p.log = append(p.log, &FieldOptionSyncActionScheme{Action: action, ID: currentNode.ID, Parent: parentValue, Value: desiredNode.Value, Disabled: desiredNode.Disabled})
+		}
+	}
+
+	// The options missing on the desired tree are disabled instead of deleted, the issues keep the values
+	var order []string
+	for _, currentNode := range current {
+
+		if node, ok := matched[currentNode]; ok {
+			order = append(order, node.value)
+			continue
+		}
+
+		if currentNode.Disabled {
+			continue
+		}
+
+		p.updates = append(p.updates, &fieldOptionUpdateScheme{ID: currentNode.ID, Value: currentNode.Value, Disabled: true})
+		p.log = append(p.log, &FieldOptionSyncActionScheme{Action: FieldOptionDisableAction, ID: currentNode.ID, Parent: parentValue, Value: currentNode.Value, Disabled: true})
+	}
+
+	// Jira appends the new options at the end of the level, the level is reordered when the result differs
+	for _, node := range level.nodes {
+		if len(node.id) == 0 {
+			order = append(order, node.value)
+		}
+	}
+
+	var desiredOrder []string
+	for _, node := range level.nodes {
+		desiredOrder = append(desiredOrder, node.value)
+	}
+
+	if strings.Join(order, "\x00") != strings.Join(desiredOrder, "\x00") {
+		p.moves = append(p.moves, level)
+		p.log = append(p.log, &FieldOptionSyncActionScheme{Action: FieldOptionMoveAction, Parent: parentValue, Order: desiredOrder})
+	}
+
+	return level, nil
+}
+
+func matchFieldOptionNode(current []*FieldOptionNodeScheme, desired *FieldOptionNodeScheme, matched map[*FieldOptionNodeScheme]*fieldOptionPlanNode, reserved map[string]bool) *FieldOptionNodeScheme {
+
+	for _, currentNode := range current {
+
+		if _, ok := matched[currentNode]; ok {
+			continue
+		}
+
+		if len(desired.ID) != 0 {
+			if currentNode.ID == desired.ID {
+				return currentNode
+			}
+			continue
+		}
+
+		if currentNode.Value == desired.Value && !reserved[currentNode.ID] {
+			return currentNode
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFieldOptionContextService_Tree(t *testing.T) {
+
+	mockOptions := mockServerOptions{
+		Endpoint:           "/rest/api/3/field/customfield_10038/context/10180/option?maxResults=100&startAt=0",
+		MockFilePath:       "./mocks/get-custom-field-context-options.json",
+		MethodAccepted:     http.MethodGet,
+		ResponseCodeWanted: http.StatusOK,
+	}
+
+	mockServer, err := startMockServer(&mockOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &FieldOptionContextService{client: mockClient}
+
+	gotResult, err := service.Tree(context.Background(), "customfield_10038", 10180)
+	assert.NoError(t, err)
+
+	if assert.Len(t, gotResult.Options, 3) {
+		assert.Equal(t, "New York", gotResult.Options[0].Value)
+		assert.Equal(t, []*FieldOptionNodeScheme{{ID: "10003", Value: "Brooklyn"}}, gotResult.Options[0].Children)
+		assert.True(t, gotResult.Options[1].Disabled)
+	}
+
+	_, err = service.Tree(context.Background(), "", 10180)
+	assert.Error(t, err)
+
+	_, err = service.Tree(context.Background(), "customfield_10038", 0)
+	assert.Error(t, err)
+}
+
+func TestFieldOptionContextService_Sync(t *testing.T) {
+
+	desired := &FieldOptionTreeScheme{
+		Options: []*FieldOptionNodeScheme{
+			{Value: "Denver"},
+			{ID: "10001", Value: "New York City", Children: []*FieldOptionNodeScheme{
+				{Value: "Queens"},
+				{Value: "Brooklyn"},
+			}},
+			{Value: "Chicago", Children: []*FieldOptionNodeScheme{{Value: "Loop"}}},
+		},
+	}
+
+	wantActions := []*FieldOptionSyncActionScheme{
+		{Action: FieldOptionRenameAction, ID: "10001", Value: "New York City", From: "New York"},
+		{Action: FieldOptionCreateAction, Value: "Chicago"},
+		{Action: FieldOptionMoveAction, Order: []string{"Denver", "New York City", "Chicago"}},
+		{Action: FieldOptionCreateAction, Parent: "New York City", Value: "Queens"},
+		{Action: FieldOptionMoveAction, Parent: "New York City", Order: []string{"Queens", "Brooklyn"}},
+		{Action: FieldOptionCreateAction, Parent: "Chicago", Value: "Loop"},
+	}
+
+	testCases := []struct {
+		name      string
+		dryRun    bool
+		wantCalls []string
+		wantErr   bool
+	}{
+		{
+			name:      "SyncFieldOptionsWhenTheDryRunIsEnabled",
+			dryRun:    true,
+			wantCalls: []string{"GET"},
+		},
+		{
+			name:   "SyncFieldOptionsWhenTheDryRunIsDisabled",
+			dryRun: false,
+			wantCalls: []string{
+				"GET",
+				`PUT [{"id":"10001","value":"New York City","disabled":false}]`,
+				`POST [{"value":"Chicago"}]`,
+				`POST [{"value":"Queens","optionId":"10001"},{"value":"Loop","optionId":"10005"}]`,
+				`MOVE ["10004","10001","10005"]`,
+				`MOVE ["10006","10003"]`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var calls []string
+
+			mux := http.NewServeMux()
+
+			mux.HandleFunc("/rest/api/3/field/customfield_10038/context/10180/option", func(w http.ResponseWriter, r *http.Request) {
+
+				if r.Method == http.MethodGet {
+
+					calls = append(calls, "GET")
+
+					mockResponse, _ := ioutil.ReadFile("./mocks/get-custom-field-context-options.json")
+					_, _ = w.Write(mockResponse)
+					return
+				}
+
+				// The options are recorded as they were sent
+				var sent struct {
+					Options json.RawMessage `json:"options"`
+				}
+
+				body, _ := ioutil.ReadAll(r.Body)
+				payload := new(FieldContextOptionListScheme)
+
+				if err := json.Unmarshal(body, &sent); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				if err := json.Unmarshal(body, payload); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				calls = append(calls, r.Method+" "+string(sent.Options))
+
+				// Assign the IDs of the created options
+				for index, option := range payload.Options {
+
+					if r.Method == http.MethodPost && len(option.OptionID) == 0 {
+						option.ID = "10005"
+					} else if r.Method == http.MethodPost {
+						option.ID = []string{"10006", "10007"}[index]
+					}
+				}
+
+				_ = json.NewEncoder(w).Encode(payload)
+			})
+
+			mux.HandleFunc("/rest/api/3/field/customfield_10038/context/10180/option/move", func(w http.ResponseWriter, r *http.Request) {
+
+				payload := new(OrderFieldOptionPayloadScheme)
+				if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				calls = append(calls, `MOVE ["`+strings.Join(payload.CustomFieldOptionIds, `","`)+`"]`)
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			mockServer := httptest.NewServer(mux)
+			defer mockServer.Close()
+
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &FieldOptionContextService{client: mockClient}
+
+			gotResult, err := service.Sync(context.Background(), "customfield_10038", 10180, desired, testCase.dryRun)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.dryRun, gotResult.DryRun)
+			assert.Equal(t, wantActions, gotResult.Actions)
+			assert.Equal(t, testCase.wantCalls, calls)
+		})
+	}
+}
+
+func Test_planFieldOptionTree(t *testing.T) {
+
+	current := &FieldOptionTreeScheme{
+		Options: []*FieldOptionNodeScheme{
+			{ID: "1", Value: "A"},
+			{ID: "2", Value: "B", Disabled: true},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		desired     *FieldOptionTreeScheme
+		wantActions []string
+		wantErr     bool
+	}{
+		{
+			name:        "PlanWhenTheTreesAreEqual",
+			desired:     &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{{Value: "A"}, {Value: "B", Disabled: true}}},
+			wantActions: nil,
+		},
+		{
+			name:        "PlanWhenAnOptionIsEnabled",
+			desired:     &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{{Value: "A"}, {Value: "B"}}},
+			wantActions: []string{"enable B"},
+		},
+		{
+			name:        "PlanWhenTheDisabledOptionsAreMissing",
+			desired:     &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{{Value: "A"}}},
+			wantActions: nil,
+		},
+		{
+			name:        "PlanWhenTheEnabledOptionsAreMissing",
+			desired:     &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{{Value: "B", Disabled: true}}},
+			wantActions: []string{"disable A"},
+		},
+		{
+			name:        "PlanWhenTheOptionsAreReordered",
+			desired:     &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{{Value: "B", Disabled: true}, {Value: "A"}}},
+			wantActions: []string{"move "},
+		},
+		{
+			name:    "PlanWhenTheOptionIsDuplicated",
+			desired: &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{{Value: "A"}, {Value: "A"}}},
+			wantErr: true,
+		},
+		{
+			name:    "PlanWhenTheOptionIDDoesNotExist",
+			desired: &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{{ID: "3", Value: "C"}}},
+			wantErr: true,
+		},
+		{
+			name:    "PlanWhenTheOptionValueIsEmpty",
+			desired: &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{{Value: ""}}},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			plan, err := planFieldOptionTree(current, testCase.desired)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var gotActions []string
+			for _, action := range plan.log {
+				gotActions = append(gotActions, action.Action+" "+action.Value)
+			}
+
+			assert.Equal(t, testCase.wantActions, gotActions)
+		})
+	}
+}
+
+func TestParseFieldOptionTreeCSV(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		document  string
+		hasHeader bool
+		want      *FieldOptionTreeScheme
+		wantErr   bool
+	}{
+		{
+			name:      "ParseWhenTheDocumentIsCorrect",
+			document:  "option,child,disabled\nNew York,Brooklyn\nNew York,Queens,true\nBoston,,true\nDenver\n",
+			hasHeader: true,
+			want: &FieldOptionTreeScheme{Options: []*FieldOptionNodeScheme{
+				{Value: "New York", Children: []*FieldOptionNodeScheme{{Value: "Brooklyn"}, {Value: "Queens", Disabled: true}}},
+				{Value: "Boston", Disabled: true},
+				{Value: "Denver"},
+			}},
+		},
+		{
+			name:     "ParseWhenTheValueIsEmpty",
+			document: ",Brooklyn\n",
+			wantErr:  true,
+		},
+		{
+			name:     "ParseWhenTheDisabledFlagIsInvalid",
+			document: "New York,Brooklyn,maybe\n",
+			wantErr:  true,
+		},
+		{
+			name:     "ParseWhenTheRecordHasTooManyColumns",
+			document: "New York,Brooklyn,true,extra\n",
+			wantErr:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := ParseFieldOptionTreeCSV(strings.NewReader(testCase.document), testCase.hasHeader)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
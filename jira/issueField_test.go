@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 )
@@ -506,3 +508,514 @@ func TestFieldService_Search(t *testing.T) {
 	}
 
 }
+
+func TestFieldService_Update(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		fieldID            string
+		payload            *FieldUpdatePayloadScheme
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:    "UpdateFieldWhenTheParametersAreCorrect",
+			fieldID: "customfield_10040",
+			payload: &FieldUpdatePayloadScheme{
+				Name:        "Alliance",
+				Description: "this is the alliance description field",
+				SearcherKey: "cascadingselectsearcher",
+			},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+		{
+			name:               "UpdateFieldWhenTheFieldIDIsNotProvided",
+			fieldID:            "",
+			payload:            &FieldUpdatePayloadScheme{Name: "Alliance"},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "UpdateFieldWhenThePayloadIsNil",
+			fieldID:            "customfield_10040",
+			payload:            nil,
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "UpdateFieldWhenTheContextIsNil",
+			fieldID:            "customfield_10040",
+			payload:            &FieldUpdatePayloadScheme{Name: "Alliance"},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "UpdateFieldWhenTheRequestMethodIsIncorrect",
+			fieldID:            "customfield_10040",
+			payload:            &FieldUpdatePayloadScheme{Name: "Alliance"},
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "UpdateFieldWhenTheStatusCodeIsIncorrect",
+			fieldID:            "customfield_10040",
+			payload:            &FieldUpdatePayloadScheme{Name: "Alliance"},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &FieldService{client: mockClient}
+
+			gotResponse, err := service.Update(testCase.context, testCase.fieldID, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+			}
+
+		})
+	}
+}
+
+func TestFieldService_UpdateWhenTheSearcherKeyIsShort(t *testing.T) {
+
+	var payloads []string
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		payloads = append(payloads, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payload := &FieldUpdatePayloadScheme{Name: "Alliance", SearcherKey: "cascadingselectsearcher"}
+
+	_, err = mockClient.Issue.Field.Update(context.Background(), "customfield_10040", payload)
+	assert.NoError(t, err)
+
+	// The plugin prefix is sent, the payload of the caller keeps the short key
+	if assert.Len(t, payloads, 1) {
+		assert.JSONEq(t, `{"name": "Alliance", "searcherKey": "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselectsearcher"}`, payloads[0])
+	}
+
+	assert.Equal(t, "cascadingselectsearcher", payload.SearcherKey)
+}
+
+func TestFieldService_Trash(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		fieldID            string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "TrashFieldWhenTheFieldIDIsCorrect",
+			fieldID:            "customfield_10040",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/trash",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+		{
+			name:               "TrashFieldWhenTheFieldIDIsNotProvided",
+			fieldID:            "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/trash",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "TrashFieldWhenTheContextIsNil",
+			fieldID:            "customfield_10040",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/trash",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "TrashFieldWhenTheRequestMethodIsIncorrect",
+			fieldID:            "customfield_10040",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/field/customfield_10040/trash",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "TrashFieldWhenTheStatusCodeIsIncorrect",
+			fieldID:            "customfield_10040",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/trash",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNotFound,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &FieldService{client: mockClient}
+
+			gotResponse, err := service.Trash(testCase.context, testCase.fieldID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+			}
+
+		})
+	}
+}
+
+func TestFieldService_Restore(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		fieldID            string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "RestoreFieldWhenTheFieldIDIsCorrect",
+			fieldID:            "customfield_10040",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/restore",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+		{
+			name:               "RestoreFieldWhenTheFieldIDIsNotProvided",
+			fieldID:            "",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/restore",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "RestoreFieldWhenTheContextIsNil",
+			fieldID:            "customfield_10040",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/restore",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "RestoreFieldWhenTheEndpointIsIncorrect",
+			fieldID:            "customfield_10040",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/trash",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+		{
+			name:               "RestoreFieldWhenTheStatusCodeIsIncorrect",
+			fieldID:            "customfield_10040",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/field/customfield_10040/restore",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNotFound,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &FieldService{client: mockClient}
+
+			gotResponse, err := service.Restore(testCase.context, testCase.fieldID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+			}
+
+		})
+	}
+}
+
+func TestFieldService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		fieldID            string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteFieldWhenTheFieldIDIsCorrect",
+			fieldID:            "customfield_10040",
+			mockFile:           "./mocks/task.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+		{
+			name:               "DeleteFieldWhenTheFieldIDIsNotProvided",
+			fieldID:            "",
+			mockFile:           "./mocks/task.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+		{
+			name:               "DeleteFieldWhenTheContextIsNil",
+			fieldID:            "customfield_10040",
+			mockFile:           "./mocks/task.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+		{
+			name:               "DeleteFieldWhenTheRequestMethodIsIncorrect",
+			fieldID:            "customfield_10040",
+			mockFile:           "./mocks/task.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+		{
+			name:               "DeleteFieldWhenTheStatusCodeIsIncorrect",
+			fieldID:            "customfield_10040",
+			mockFile:           "./mocks/task.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+		{
+			name:               "DeleteFieldWhenTheResponseBodyHasADifferentFormat",
+			fieldID:            "customfield_10040",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/field/customfield_10040",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &FieldService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Delete(testCase.context, testCase.fieldID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, "1", gotResult.ID)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+			}
+
+		})
+	}
+}
@@ -29,7 +29,7 @@ func (l *LabelService) Gets(ctx context.Context, startAt, maxResults int) (resul
 
 	var endpoint = fmt.Sprintf("rest/api/3/label?%v", params.Encode())
 
-	request, err := l.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := l.client.newRequest(ctx, http.MethodGet, "rest/api/3/label", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -51,7 +51,7 @@ type LinkTypeScheme struct {
 func (i *IssueLinkService) Create(ctx context.Context, payload *LinkPayloadScheme) (response *Response, err error) {
 
 	var endpoint = "rest/api/3/issueLink"
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issueLink", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -84,7 +84,7 @@ func (i *IssueLinkService) Get(ctx context.Context, linkID string) (result *Issu
 
 	var endpoint = fmt.Sprintf("rest/api/3/issueLink/%v", linkID)
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issueLink/{linkID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -124,7 +124,7 @@ func (i *IssueLinkService) Gets(ctx context.Context, issueKeyOrID string) (resul
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v?fields=issuelinks", issueKeyOrID)
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issue/{issueKeyOrID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -154,7 +154,7 @@ func (i *IssueLinkService) Delete(ctx context.Context, linkID string) (response
 
 	var endpoint = fmt.Sprintf("rest/api/3/issueLink/%v", linkID)
 
-	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issueLink/{linkID}", endpoint, nil)
 	if err != nil {
 		return
 	}
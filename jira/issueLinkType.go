@@ -27,7 +27,7 @@ func (i *IssueLinkTypeService) Gets(ctx context.Context) (result *IssueLinkTypeS
 
 	var endpoint = "rest/api/3/issueLinkType"
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issueLinkType", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -57,7 +57,7 @@ func (i *IssueLinkTypeService) Get(ctx context.Context, issueLinkTypeID string)
 
 	var endpoint = fmt.Sprintf("rest/api/3/issueLinkType/%v", issueLinkTypeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issueLinkType/{issueLinkTypeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -89,7 +89,7 @@ func (i *IssueLinkTypeService) Create(ctx context.Context, payload *LinkTypeSche
 
 	var endpoint = "rest/api/3/issueLinkType"
 
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issueLinkType", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -124,7 +124,7 @@ func (i *IssueLinkTypeService) Update(ctx context.Context, issueLinkTypeID strin
 
 	var endpoint = fmt.Sprintf("rest/api/3/issueLinkType/%v", issueLinkTypeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issueLinkType/{issueLinkTypeID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -155,7 +155,7 @@ func (i *IssueLinkTypeService) Delete(ctx context.Context, issueLinkTypeID strin
 
 	var endpoint = fmt.Sprintf("rest/api/3/issueLinkType/%v", issueLinkTypeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issueLinkType/{issueLinkTypeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
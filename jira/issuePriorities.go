@@ -23,7 +23,7 @@ type PriorityScheme struct {
 func (p *PriorityService) Gets(ctx context.Context) (result *[]PriorityScheme, response *Response, err error) {
 
 	var endpoint = "rest/api/3/priority"
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/priority", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -51,7 +51,7 @@ func (p *PriorityService) Get(ctx context.Context, priorityID string) (result *P
 	}
 
 	var endpoint = fmt.Sprintf("rest/api/3/priority/%v", priorityID)
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/priority/{priorityID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -21,7 +21,7 @@ type IssueResolutionScheme struct {
 func (r *ResolutionService) Gets(ctx context.Context) (result *[]IssueResolutionScheme, response *Response, err error) {
 
 	var endpoint = "rest/api/3/resolution"
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/api/3/resolution", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -50,7 +50,7 @@ func (r *ResolutionService) Get(ctx context.Context, resolutionID string) (resul
 
 	var endpoint = fmt.Sprintf("rest/api/3/resolution/%v", resolutionID)
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/api/3/resolution/{resolutionID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -164,7 +164,7 @@ func (s *IssueSearchService) Get(ctx context.Context, jql string, fields, expand
 
 	var endpoint = fmt.Sprintf("rest/api/3/search?%v", params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/api/3/search", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -229,7 +229,7 @@ func (s *IssueSearchService) Post(ctx context.Context, jql string, fields, expan
 
 	var endpoint = "rest/api/3/search"
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := s.client.newRequest(ctx, http.MethodPost, "rest/api/3/search", endpoint, &payload)
 	if err != nil {
 		return
 	}
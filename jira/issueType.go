@@ -38,7 +38,7 @@ func (i *IssueTypeService) Gets(ctx context.Context) (result *[]IssueTypeScheme,
 
 	var endpoint = "rest/api/3/issuetype"
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetype", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -78,7 +78,7 @@ func (i *IssueTypeService) Create(ctx context.Context, payload *IssueTypePayload
 
 	var endpoint = "rest/api/3/issuetype"
 
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issuetype", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -105,7 +105,7 @@ func (i *IssueTypeService) Get(ctx context.Context, issueTypeID string) (result
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetype/%v", issueTypeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetype/{issueTypeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -134,7 +134,7 @@ func (i *IssueTypeService) Update(ctx context.Context, issueTypeID string, paylo
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetype/%v", issueTypeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issuetype/{issueTypeID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -163,7 +163,7 @@ func (i *IssueTypeService) Delete(ctx context.Context, issueTypeID string) (resp
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetype/%v", issueTypeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issuetype/{issueTypeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -183,7 +183,7 @@ func (i *IssueTypeService) Alternatives(ctx context.Context, issueTypeID string)
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetype/%v/alternatives", issueTypeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetype/{issueTypeID}/alternatives", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -43,7 +43,7 @@ func (i *IssueTypeSchemeService) Gets(ctx context.Context, issueTypeSchemeIDs []
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescheme?%v", params.Encode())
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetypescheme", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -115,7 +115,7 @@ func (i *IssueTypeSchemeService) Create(ctx context.Context, payload *IssueTypeS
 
 	var endpoint = "rest/api/3/issuetypescheme"
 
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issuetypescheme", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -164,7 +164,7 @@ func (i *IssueTypeSchemeService) Items(ctx context.Context, issueTypeSchemeIDs [
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescheme/mapping?%v", params.Encode())
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetypescheme/mapping", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -214,7 +214,7 @@ func (i *IssueTypeSchemeService) Projects(ctx context.Context, projectIDs []int,
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescheme/project?%v", params.Encode())
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetypescheme/project", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -256,7 +256,7 @@ func (i *IssueTypeSchemeService) Assign(ctx context.Context, issueTypeSchemeID,
 
 	var endpoint = "rest/api/3/issuetypescheme/project"
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issuetypescheme/project", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -286,7 +286,7 @@ func (i *IssueTypeSchemeService) Update(ctx context.Context, issueTypeSchemeID i
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescheme/%v", issueTypeSchemeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issuetypescheme/{issueTypeSchemeID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -312,7 +312,7 @@ func (i *IssueTypeSchemeService) Delete(ctx context.Context, issueTypeSchemeID i
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescheme/%v", issueTypeSchemeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issuetypescheme/{issueTypeSchemeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -348,7 +348,7 @@ func (i *IssueTypeSchemeService) AddIssueTypes(ctx context.Context, issueTypeSch
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescheme/%v/issuetype", issueTypeSchemeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issuetypescheme/{issueTypeSchemeID}/issuetype", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -370,7 +370,7 @@ func (i *IssueTypeSchemeService) RemoveIssueType(ctx context.Context, issueTypeS
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescheme/%v/issuetype/%v", issueTypeSchemeID, issueTypeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issuetypescheme/{issueTypeSchemeID}/issuetype/{issueTypeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
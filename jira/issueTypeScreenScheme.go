@@ -42,7 +42,7 @@ func (i *IssueTypeScreenSchemeService) Gets(ctx context.Context, ids []int, star
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescreenscheme?%v", params.Encode())
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetypescreenscheme", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -91,7 +91,7 @@ func (i *IssueTypeScreenSchemeService) Create(ctx context.Context, payload *Issu
 
 	var endpoint = "rest/api/3/issuetypescreenscheme"
 
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issuetypescreenscheme", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -137,7 +137,7 @@ func (i *IssueTypeScreenSchemeService) Assign(ctx context.Context, issueTypeScre
 
 	var endpoint = "rest/api/3/issuetypescreenscheme/project"
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issuetypescreenscheme/project", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -172,7 +172,7 @@ func (i *IssueTypeScreenSchemeService) Projects(ctx context.Context, projectIDs
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescreenscheme/project?%v", params.Encode())
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetypescreenscheme/project", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -220,7 +220,7 @@ func (i *IssueTypeScreenSchemeService) Mapping(ctx context.Context, issueTypeScr
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescreenscheme/mapping?%v", params.Encode())
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/api/3/issuetypescreenscheme/mapping", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -273,7 +273,7 @@ func (i *IssueTypeScreenSchemeService) Update(ctx context.Context, issueTypeScre
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescreenscheme/%v", issueTypeScreenSchemeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issuetypescreenscheme/{issueTypeScreenSchemeID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -299,7 +299,7 @@ func (i *IssueTypeScreenSchemeService) Delete(ctx context.Context, issueTypeScre
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescreenscheme/%v", issueTypeScreenSchemeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issuetypescreenscheme/{issueTypeScreenSchemeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -328,7 +328,7 @@ func (i *IssueTypeScreenSchemeService) Append(ctx context.Context, issueTypeScre
 
 	var endpoint = fmt.Sprintf("rest/api/3/issuetypescreenscheme/%v/mapping", issueTypeScreenSchemeID)
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issuetypescreenscheme/{issueTypeScreenSchemeID}/mapping", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -362,7 +362,7 @@ func (i *IssueTypeScreenSchemeService) UpdateDefault(ctx context.Context, issueT
 		ScreenSchemeID string `json:"screenSchemeId"`
 	}{ScreenSchemeID: screenSchemeID}
 
-	request, err := i.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPut, "rest/api/3/issuetypescreenscheme/{issueTypeScreenSchemeID}/mapping/default", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -396,7 +396,7 @@ func (i *IssueTypeScreenSchemeService) Remove(ctx context.Context, issueTypeScre
 		IssueTypeIds []string `json:"issueTypeIds"`
 	}{IssueTypeIds: issueTypeIDs}
 
-	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := i.client.newRequest(ctx, http.MethodPost, "rest/api/3/issuetypescreenscheme/{issueTypeScreenSchemeID}/mapping/remove", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -22,7 +22,7 @@ func (v *VoteService) Gets(ctx context.Context, issueKeyOrID string) (result *Is
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/votes", issueKeyOrID)
 
-	request, err := v.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := v.client.newRequest(ctx, http.MethodGet, "rest/api/3/issue/{issueKeyOrID}/votes", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -51,7 +51,7 @@ func (v *VoteService) Add(ctx context.Context, issueKeyOrID string) (response *R
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/votes", issueKeyOrID)
 
-	request, err := v.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := v.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue/{issueKeyOrID}/votes", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -75,7 +75,7 @@ func (v *VoteService) Delete(ctx context.Context, issueKeyOrID string) (response
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/votes", issueKeyOrID)
 
-	request, err := v.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := v.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issue/{issueKeyOrID}/votes", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -45,7 +45,7 @@ func (w *WatcherService) Gets(ctx context.Context, issueKeyOrID string) (result
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/watchers", issueKeyOrID)
 
-	request, err := w.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := w.client.newRequest(ctx, http.MethodGet, "rest/api/3/issue/{issueKeyOrID}/watchers", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -75,7 +75,7 @@ func (w *WatcherService) Add(ctx context.Context, issueKeyOrID string) (response
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/watchers", issueKeyOrID)
 
-	request, err := w.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := w.client.newRequest(ctx, http.MethodPost, "rest/api/3/issue/{issueKeyOrID}/watchers", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -108,7 +108,7 @@ func (w *WatcherService) Delete(ctx context.Context, issueKeyOrID, accountID str
 
 	var endpoint = fmt.Sprintf("rest/api/3/issue/%v/watchers?%v", issueKeyOrID, params.Encode())
 
-	request, err := w.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := w.client.newRequest(ctx, http.MethodDelete, "rest/api/3/issue/{issueKeyOrID}/watchers", endpoint, nil)
 	if err != nil {
 		return
 	}
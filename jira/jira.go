@@ -6,7 +6,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/cache"
+	"github.com/ctreminiom/go-atlassian/internal/httplog"
+	"github.com/ctreminiom/go-atlassian/internal/instrument"
+	"github.com/ctreminiom/go-atlassian/internal/route"
 	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"github.com/ctreminiom/go-atlassian/ratelimit"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -18,6 +23,12 @@ type Client struct {
 	HTTP *http.Client
 	Site *url.URL
 
+	logger          *httplog.Hook
+	instrumentation *instrument.Hook
+	limiter         *ratelimit.Limiter
+	cache           *cache.Cache
+	deployment      string
+
 	Role       *ApplicationRoleService
 	Audit      *AuditService
 	Auth       *AuthenticationService
@@ -31,6 +42,7 @@ type Client struct {
 	Server     *ServerService
 	Task       *TaskService
 	User       *UserService
+	Webhook    *WebhookService
 
 	//Service Management Module
 	ServiceManagement *sm.Client
@@ -40,8 +52,11 @@ const (
 	DateFormatJira = "2006-01-02T15:04:05.999-0700"
 )
 
+// ClientOption configures the client created by New, e.g: WithInstrumentation
+type ClientOption func(*Client)
+
 //New
-func New(httpClient *http.Client, site string) (client *Client, err error) {
+func New(httpClient *http.Client, site string, options ...ClientOption) (client *Client, err error) {
 
 	if httpClient == nil {
 		httpClient = http.DefaultClient
@@ -158,10 +173,16 @@ func New(httpClient *http.Client, site string) (client *Client, err error) {
 		Search: &UserSearchService{client: client},
 	}
 
+	client.Webhook = &WebhookService{client: client}
+
+	for _, option := range options {
+		option(client)
+	}
+
 	return
 }
 
-func (c *Client) newRequest(ctx context.Context, method, urlAsString string, payload interface{}) (request *http.Request, err error) {
+func (c *Client) newRequest(ctx context.Context, method, template, urlAsString string, payload interface{}) (request *http.Request, err error) {
 
 	if ctx == nil {
 		return nil, errors.New("the context param is nil, please provide a valid one")
@@ -174,6 +195,10 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 
 	relativePath.Path = strings.TrimLeft(relativePath.Path, "/")
 
+	if c.isServer() || isServerEndpoint(ctx) {
+		serverEndpoint(relativePath)
+	}
+
 	endpointPath := c.Site.ResolveReference(relativePath)
 	var payloadBuffer io.ReadWriter
 	if payload != nil {
@@ -181,9 +206,19 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 		if err = json.NewEncoder(payloadBuffer).Encode(payload); err != nil {
 			return
 		}
+
+		if c.isServer() {
+
+			content, err := serverPayload(payloadBuffer.(*bytes.Buffer).Bytes())
+			if err != nil {
+				return nil, err
+			}
+
+			payloadBuffer = bytes.NewBuffer(content)
+		}
 	}
 
-	request, err = http.NewRequestWithContext(ctx, method, endpointPath.String(), payloadBuffer)
+	request, err = http.NewRequestWithContext(route.With(ctx, template), method, endpointPath.String(), payloadBuffer)
 	if err != nil {
 		return
 	}
@@ -192,6 +227,10 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 		request.SetBasicAuth(c.Auth.mail, c.Auth.token)
 	}
 
+	if c.Auth.bearerTokenProvided {
+		request.Header.Set("Authorization", "Bearer "+c.Auth.bearerToken)
+	}
+
 	if c.Auth.userAgentProvided {
 		request.Header.Set("User-Agent", c.Auth.agent)
 	}
@@ -201,7 +240,15 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 
 func (c *Client) Do(request *http.Request) (response *Response, err error) {
 
-	httpResponse, err := c.HTTP.Do(request)
+	template := route.Of(request.Context())
+
+	if err = c.unsupported(request.Method, template); err != nil {
+		return
+	}
+
+	httpResponse, err := c.cache.Do(request, template, func(request *http.Request) (*http.Response, error) {
+		return c.send(request, template)
+	})
 	if err != nil {
 		return
 	}
@@ -216,9 +263,31 @@ func (c *Client) Do(request *http.Request) (response *Response, err error) {
 		return
 	}
 
+	if c.isServer() && len(response.BodyAsBytes) != 0 {
+		response.BodyAsBytes = cloudBody(response.BodyAsBytes)
+	}
+
 	return
 }
 
+// send sends the request with the rate limiter, the instrumentation and the logger of the client
+func (c *Client) send(request *http.Request, route string) (*http.Response, error) {
+
+	if err := c.limiter.Wait(request.Context(), request.Method, route); err != nil {
+		return nil, err
+	}
+
+	request, call := c.instrumentation.Start(request, route)
+	exchange := c.logger.Start(request)
+
+	httpResponse, err := c.HTTP.Do(request)
+	exchange.Finish(httpResponse, err)
+	call.End(httpResponse, err)
+	c.limiter.Update(httpResponse)
+
+	return httpResponse, err
+}
+
 type Response struct {
 	StatusCode  int
 	Endpoint    string
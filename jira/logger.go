@@ -0,0 +1,18 @@
+package jira
+
+import "github.com/ctreminiom/go-atlassian/internal/httplog"
+
+// Logger is implemented by *slog.Logger and the loggers with the same Debug and Error key/value methods
+type Logger = httplog.Logger
+
+// LoggerOptionsScheme configures the dump of the bodies and the redacted JSON paths,
+// e.g: &LoggerOptionsScheme{DumpBody: true, RedactPaths: []string{"fields.reporter"}}
+type LoggerOptionsScheme = httplog.Options
+
+// SetLogger logs the requests of the Jira and Service Management clients, the nil logger disables the logs
+func (c *Client) SetLogger(logger Logger, options *LoggerOptionsScheme) {
+	c.logger = httplog.New(logger, options)
+
+	// The Service Management module shares the logger
+	c.ServiceManagement.SetLogger(logger, options)
+}
@@ -0,0 +1,48 @@
+package jira
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/internal/httplog/httplogtest"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_SetLogger(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient.Auth.SetBasicAuth("example@example.com", "API_TOKEN")
+
+	logger := &httplogtest.Recorder{}
+	mockClient.SetLogger(logger, &LoggerOptionsScheme{DumpBody: true})
+
+	_, _, err = mockClient.Server.Info(context.Background())
+	assert.NoError(t, err)
+
+	// The Service Management module shares the logger
+	_, _, err = mockClient.ServiceManagement.Info.Get(context.Background())
+	assert.NoError(t, err)
+
+	if entries := logger.Entries(); assert.Len(t, entries, 2) {
+		assert.Equal(t, mockServer.URL+"/rest/api/3/serverInfo", entries[0].Args["endpoint"])
+		assert.Equal(t, mockServer.URL+"/rest/servicedeskapi/info", entries[1].Args["endpoint"])
+		assert.Contains(t, entries[0].Args["request.headers"], "Authorization: [REDACTED]")
+	}
+
+	// The nil logger disables the logs
+	mockClient.SetLogger(nil, nil)
+
+	_, _, err = mockClient.Server.Info(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, logger.Entries(), 2)
+}
@@ -89,7 +89,7 @@ func (p *PermissionService) Gets(ctx context.Context) (result *GlobalPermissions
 
 	var endpoint = "rest/api/3/permissions"
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/permissions", endpoint, nil)
 	if err != nil {
 		return
 	}
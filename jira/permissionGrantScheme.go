@@ -47,7 +47,7 @@ func (p *PermissionGrantSchemeService) Create(ctx context.Context, schemeID int,
 
 	var endpoint = fmt.Sprintf("rest/api/3/permissionscheme/%v/permission", schemeID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/permissionscheme/{schemeID}/permission", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -99,7 +99,7 @@ func (p *PermissionGrantSchemeService) Gets(ctx context.Context, permissionSchem
 		endpoint = fmt.Sprintf("rest/api/3/permissionscheme/%v/permission", permissionSchemeID)
 	}
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/permissionscheme/{permissionSchemeID}/permission", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -156,7 +156,7 @@ func (p *PermissionGrantSchemeService) Get(ctx context.Context, schemeID, permis
 		endpoint = fmt.Sprintf("rest/api/3/permissionscheme/%v/permission/%v", schemeID, permissionID)
 	}
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/permissionscheme/{schemeID}/permission/{permissionID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -190,7 +190,7 @@ func (p *PermissionGrantSchemeService) Delete(ctx context.Context, schemeID, per
 
 	var endpoint = fmt.Sprintf("rest/api/3/permissionscheme/%v/permission/%v", schemeID, permissionID)
 
-	request, err := p.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodDelete, "rest/api/3/permissionscheme/{schemeID}/permission/{permissionID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -22,7 +22,7 @@ func (p *PermissionSchemeService) Gets(ctx context.Context) (result *PermissionS
 
 	var endpoint = "rest/api/3/permissionscheme"
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/permissionscheme", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -72,7 +72,7 @@ func (p *PermissionSchemeService) Get(ctx context.Context, permissionSchemeID in
 
 	var endpoint = fmt.Sprintf("rest/api/3/permissionscheme/%v", permissionSchemeID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/permissionscheme/{permissionSchemeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -102,7 +102,7 @@ func (p *PermissionSchemeService) Delete(ctx context.Context, permissionSchemeID
 
 	var endpoint = fmt.Sprintf("rest/api/3/permissionscheme/%v", permissionSchemeID)
 
-	request, err := p.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodDelete, "rest/api/3/permissionscheme/{permissionSchemeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -126,7 +126,7 @@ func (p *PermissionSchemeService) Create(ctx context.Context, payload *Permissio
 
 	var endpoint = "rest/api/3/permissionscheme"
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/permissionscheme", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -165,7 +165,7 @@ func (p *PermissionSchemeService) Update(ctx context.Context, schemeID int, payl
 
 	var endpoint = fmt.Sprintf("rest/api/3/permissionscheme/%v", schemeID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPut, "rest/api/3/permissionscheme/{schemeID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -58,7 +58,7 @@ func (p *ProjectService) Create(ctx context.Context, payload *ProjectPayloadSche
 
 	var endpoint = "rest/api/3/project"
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/project", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -146,7 +146,7 @@ func (p *ProjectService) Search(ctx context.Context, opts *ProjectSearchOptionsS
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/search?%v", params.Encode())
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/search", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -249,7 +249,7 @@ func (p *ProjectService) Get(ctx context.Context, projectKeyOrID string, expands
 		endpoint = fmt.Sprintf("rest/api/3/project/%v", projectKeyOrID)
 	}
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -298,7 +298,7 @@ func (p *ProjectService) Update(ctx context.Context, projectKeyOrID string, payl
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPut, "rest/api/3/project/{projectKeyOrID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -338,7 +338,7 @@ func (p *ProjectService) Delete(ctx context.Context, projectKeyOrID string, enab
 		endpoint = fmt.Sprintf("rest/api/3/project/%v", projectKeyOrID)
 	}
 
-	request, err := p.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodDelete, "rest/api/3/project/{projectKeyOrID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -363,7 +363,7 @@ func (p *ProjectService) DeleteAsynchronously(ctx context.Context, projectKeyOrI
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/delete", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/project/{projectKeyOrID}/delete", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -393,7 +393,7 @@ func (p *ProjectService) Archive(ctx context.Context, projectKeyOrID string) (re
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/archive", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/project/{projectKeyOrID}/archive", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -416,7 +416,7 @@ func (p *ProjectService) Restore(ctx context.Context, projectKeyOrID string) (re
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/restore", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/project/{projectKeyOrID}/restore", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -462,7 +462,7 @@ func (p *ProjectService) Statuses(ctx context.Context, projectKeyOrID string) (r
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/statuses", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/statuses", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -505,7 +505,7 @@ func (p *ProjectService) Hierarchy(ctx context.Context, projectKeyOrID string) (
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/hierarchy", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/hierarchy", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -590,7 +590,7 @@ func (p *ProjectService) NotificationScheme(ctx context.Context, projectKeyOrID
 		endpoint = fmt.Sprintf("rest/api/3/project/%v/notificationscheme", projectKeyOrID)
 	}
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/notificationscheme", endpoint, nil)
 	if err != nil {
 		return
 	}
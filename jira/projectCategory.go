@@ -22,7 +22,7 @@ func (p *ProjectCategoryService) Gets(ctx context.Context) (result *[]ProjectCat
 
 	var endpoint = "rest/api/3/projectCategory"
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/projectCategory", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -48,7 +48,7 @@ func (p *ProjectCategoryService) Get(ctx context.Context, projectCategoryID int)
 
 	var endpoint = fmt.Sprintf("rest/api/3/projectCategory/%v", projectCategoryID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/projectCategory/{projectCategoryID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -85,7 +85,7 @@ func (p *ProjectCategoryService) Create(ctx context.Context, name, description s
 
 	var endpoint = "rest/api/3/projectCategory"
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/projectCategory", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -120,7 +120,7 @@ func (p *ProjectCategoryService) Update(ctx context.Context, projectCategoryID i
 
 	var endpoint = fmt.Sprintf("rest/api/3/projectCategory/%v", projectCategoryID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPut, "rest/api/3/projectCategory/{projectCategoryID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -146,7 +146,7 @@ func (p *ProjectCategoryService) Delete(ctx context.Context, projectCategoryID i
 
 	var endpoint = fmt.Sprintf("rest/api/3/projectCategory/%v", projectCategoryID)
 
-	request, err := p.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodDelete, "rest/api/3/projectCategory/{projectCategoryID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -29,7 +29,7 @@ func (p *ProjectComponentService) Create(ctx context.Context, payload *ProjectCo
 
 	var endpoint = "rest/api/3/component"
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/component", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -77,7 +77,7 @@ func (p *ProjectComponentService) Gets(ctx context.Context, projectKeyOrID strin
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/components", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/components", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -111,7 +111,7 @@ func (p *ProjectComponentService) Count(ctx context.Context, componentID string)
 
 	var endpoint = fmt.Sprintf("rest/api/3/component/%v/relatedIssueCounts", componentID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/component/{componentID}/relatedIssueCounts", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -140,7 +140,7 @@ func (p *ProjectComponentService) Delete(ctx context.Context, componentID string
 
 	var endpoint = fmt.Sprintf("rest/api/3/component/%v", componentID)
 
-	request, err := p.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodDelete, "rest/api/3/component/{componentID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -169,7 +169,7 @@ func (p *ProjectComponentService) Update(ctx context.Context, componentID string
 
 	var endpoint = fmt.Sprintf("rest/api/3/component/%v", componentID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPut, "rest/api/3/component/{componentID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -200,7 +200,7 @@ func (p *ProjectComponentService) Get(ctx context.Context, componentID string) (
 
 	var endpoint = fmt.Sprintf("rest/api/3/component/%v", componentID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/component/{componentID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -43,7 +43,7 @@ func (p *ProjectPermissionSchemeService) Get(ctx context.Context, projectKeyOrID
 		endpoint = fmt.Sprintf("rest/api/3/project/%v/permissionscheme", projectKeyOrID)
 	}
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/permissionscheme", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -77,7 +77,7 @@ func (p *ProjectPermissionSchemeService) Assign(ctx context.Context, projectKeyO
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/permissionscheme", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPut, "rest/api/3/project/{projectKeyOrID}/permissionscheme", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -117,7 +117,7 @@ func (p *ProjectPermissionSchemeService) SecurityLevels(ctx context.Context, pro
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/securitylevel", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/securitylevel", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -25,7 +25,7 @@ func (p *ProjectRoleService) Gets(ctx context.Context, projectKeyOrID string) (r
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/role", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/role", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -103,7 +103,7 @@ func (p *ProjectRoleService) Get(ctx context.Context, projectKeyOrID string, rol
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/role/%v", projectKeyOrID, roleID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/role/{roleID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -144,7 +144,7 @@ func (p *ProjectRoleService) Details(ctx context.Context, projectKeyOrID string)
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/roledetails", projectKeyOrID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/roledetails", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -169,7 +169,7 @@ func (p *ProjectRoleService) Global(ctx context.Context) (result *[]ProjectRoleS
 
 	var endpoint = "rest/api/3/role"
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/role", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -206,7 +206,7 @@ func (p *ProjectRoleService) Create(ctx context.Context, name, description strin
 
 	var endpoint = "rest/api/3/role"
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/role", endpoint, &payload)
 	if err != nil {
 		return
 	}
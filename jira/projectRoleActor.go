@@ -28,7 +28,7 @@ func (p *ProjectRoleActorService) Add(ctx context.Context, projectKeyOrID string
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/role/%v", projectKeyOrID, projectRoleID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/project/{projectKeyOrID}/role/{projectRoleID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -69,7 +69,7 @@ func (p *ProjectRoleActorService) Delete(ctx context.Context, projectKeyOrID str
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/role/%v?%v", projectKeyOrID, projectRoleID, params.Encode())
 
-	request, err := p.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodDelete, "rest/api/3/project/{projectKeyOrID}/role/{projectRoleID}", endpoint, nil)
 	if err != nil {
 		return
 	}
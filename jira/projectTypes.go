@@ -23,7 +23,7 @@ func (p *ProjectTypeService) Gets(ctx context.Context) (result *[]ProjectTypeSch
 
 	var endpoint = "rest/api/3/project/type"
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/type", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -49,7 +49,7 @@ func (p *ProjectTypeService) Licensed(ctx context.Context) (result *[]ProjectTyp
 
 	var endpoint = "rest/api/3/project/type/accessible"
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/type/accessible", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -79,7 +79,7 @@ func (p *ProjectTypeService) Get(ctx context.Context, projectTypeKey string) (re
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/type/%v", projectTypeKey)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/type/{projectTypeKey}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -109,7 +109,7 @@ func (p *ProjectTypeService) Accessible(ctx context.Context, projectTypeKey stri
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/type/%v/accessible", projectTypeKey)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/type/{projectTypeKey}/accessible", endpoint, nil)
 	if err != nil {
 		return
 	}
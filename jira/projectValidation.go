@@ -30,7 +30,7 @@ func (p *ProjectValidationService) Validate(ctx context.Context, projectKey stri
 
 	var endpoint = fmt.Sprintf("rest/api/3/projectvalidate/key?%v", params.Encode())
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/projectvalidate/key", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -62,7 +62,7 @@ func (p *ProjectValidationService) Key(ctx context.Context, projectKey string) (
 
 	var endpoint = fmt.Sprintf("rest/api/3/projectvalidate/validProjectKey?%v", params.Encode())
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/projectvalidate/validProjectKey", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -94,7 +94,7 @@ func (p *ProjectValidationService) Name(ctx context.Context, projectName string)
 
 	var endpoint = fmt.Sprintf("rest/api/3/projectvalidate/validProjectName?%v", params.Encode())
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/projectvalidate/validProjectName", endpoint, nil)
 	if err != nil {
 		return
 	}
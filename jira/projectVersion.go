@@ -103,7 +103,7 @@ func (p *ProjectVersionService) Gets(ctx context.Context, projectKeyOrID string,
 
 	var endpoint = fmt.Sprintf("rest/api/3/project/%v/version?%v", projectKeyOrID, params.Encode())
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/project/{projectKeyOrID}/version", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -143,7 +143,7 @@ func (p *ProjectVersionService) Create(ctx context.Context, payload *ProjectVers
 
 	var endpoint = "rest/api/3/version"
 
-	request, err := p.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPost, "rest/api/3/version", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -192,7 +192,7 @@ func (p *ProjectVersionService) Get(ctx context.Context, versionID string, expan
 		endpoint = fmt.Sprintf("rest/api/3/version/%v", versionID)
 	}
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/version/{versionID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -226,7 +226,7 @@ func (p *ProjectVersionService) Update(ctx context.Context, versionID string, pa
 
 	var endpoint = fmt.Sprintf("rest/api/3/version/%v", versionID)
 
-	request, err := p.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := p.client.newRequest(ctx, http.MethodPut, "rest/api/3/version/{versionID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -262,7 +262,7 @@ func (p *ProjectVersionService) Merge(ctx context.Context, versionID, moveIssues
 
 	var endpoint = fmt.Sprintf("rest/api/3/version/%v/mergeto/%v", versionID, moveIssuesTo)
 
-	request, err := p.client.newRequest(ctx, http.MethodPut, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodPut, "rest/api/3/version/{versionID}/mergeto/{moveIssuesTo}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -304,7 +304,7 @@ func (p *ProjectVersionService) RelatedIssueCounts(ctx context.Context, versionI
 
 	var endpoint = fmt.Sprintf("rest/api/3/version/%v/relatedIssueCounts", versionID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/version/{versionID}/relatedIssueCounts", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -340,7 +340,7 @@ func (p *ProjectVersionService) UnresolvedIssueCount(ctx context.Context, versio
 
 	var endpoint = fmt.Sprintf("rest/api/3/version/%v/unresolvedIssueCount", versionID)
 
-	request, err := p.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := p.client.newRequest(ctx, http.MethodGet, "rest/api/3/version/{versionID}/unresolvedIssueCount", endpoint, nil)
 	if err != nil {
 		return
 	}
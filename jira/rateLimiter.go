@@ -0,0 +1,17 @@
+package jira
+
+import (
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"github.com/ctreminiom/go-atlassian/ratelimit"
+)
+
+// WithRateLimiter waits for the tokens of the limiter before sending the requests of the client, the weights
+// of the limiter are matched with the endpoint templates of the service methods, e.g: POST rest/api/3/search
+func WithRateLimiter(limiter *ratelimit.Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = limiter
+
+		// The Service Management module shares the budget of the site
+		sm.WithRateLimiter(limiter)(c.ServiceManagement)
+	}
+}
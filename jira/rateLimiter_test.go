@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithRateLimiter(t *testing.T) {
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/api/3/search", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-NearLimit", "true")
+		_, _ = w.Write([]byte(`{"startAt": 0, "maxResults": 50, "total": 0, "issues": []}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/request/DESK-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"issueId": "10001", "issueKey": "DESK-1"}`))
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	limiter, err := ratelimit.New(&ratelimit.OptionsScheme{Rate: 1000, Weights: map[string]float64{"POST rest/api/3/search": 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient, err := New(nil, mockServer.URL, WithRateLimiter(limiter))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = mockClient.Issue.Search.Post(context.Background(), "project = KP", nil, nil, 0, 50, "")
+	assert.NoError(t, err)
+
+	// The Service Management module consumes the tokens of the same limiter
+	_, _, err = mockClient.ServiceManagement.Request.Get(context.Background(), "DESK-1", nil)
+	assert.NoError(t, err)
+
+	stats := limiter.Stats()
+	assert.Equal(t, int64(2), stats.Requests)
+	assert.Equal(t, 6.0, stats.Consumed)
+	assert.True(t, stats.Rate < 1000)
+
+	// The requests aren't sent once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	limiter.Update(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"60"}}})
+
+	_, _, err = mockClient.ServiceManagement.Request.Get(ctx, "DESK-1", nil)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, int64(2), limiter.Stats().Requests)
+}
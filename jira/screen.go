@@ -54,7 +54,7 @@ func (s *ScreenService) Fields(ctx context.Context, fieldID string, startAt, max
 
 	var endpoint = fmt.Sprintf("rest/api/3/field/%v/screens?%v", fieldID, params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/api/3/field/{fieldID}/screens", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -97,7 +97,7 @@ func (s *ScreenService) Gets(ctx context.Context, screenIDs []int, startAt, maxR
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens?%v", params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/api/3/screens", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -135,7 +135,7 @@ func (s *ScreenService) Create(ctx context.Context, name, description string) (r
 
 	var endpoint = "rest/api/3/screens"
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := s.client.newRequest(ctx, http.MethodPost, "rest/api/3/screens", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -166,7 +166,7 @@ func (s *ScreenService) AddToDefault(ctx context.Context, fieldID string) (respo
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/addToDefault/%v", fieldID)
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodPost, "rest/api/3/screens/addToDefault/{fieldID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -195,7 +195,7 @@ func (s *ScreenService) Update(ctx context.Context, screenID int, name, descript
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v", screenID)
 
-	request, err := s.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := s.client.newRequest(ctx, http.MethodPut, "rest/api/3/screens/{screenID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -224,7 +224,7 @@ func (s *ScreenService) Delete(ctx context.Context, screenID int) (response *Res
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v", screenID)
 
-	request, err := s.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodDelete, "rest/api/3/screens/{screenID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -248,7 +248,7 @@ func (s *ScreenService) Available(ctx context.Context, screenID int) (result *[]
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v/availableFields", screenID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/api/3/screens/{screenID}/availableFields", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -52,7 +52,7 @@ func (s *ScreenSchemeService) Gets(ctx context.Context, screenSchemeIDs []int, s
 
 	var endpoint = fmt.Sprintf("rest/api/3/screenscheme?%v", params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/api/3/screenscheme", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -93,7 +93,7 @@ func (s *ScreenSchemeService) Create(ctx context.Context, payload *ScreenSchemeP
 
 	var endpoint = "rest/api/3/screenscheme"
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := s.client.newRequest(ctx, http.MethodPost, "rest/api/3/screenscheme", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -128,7 +128,7 @@ func (s *ScreenSchemeService) Update(ctx context.Context, screenSchemeID string,
 
 	var endpoint = fmt.Sprintf("rest/api/3/screenscheme/%v", screenSchemeID)
 
-	request, err := s.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := s.client.newRequest(ctx, http.MethodPut, "rest/api/3/screenscheme/{screenSchemeID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -156,7 +156,7 @@ func (s *ScreenSchemeService) Delete(ctx context.Context, screenSchemeID string)
 
 	var endpoint = fmt.Sprintf("rest/api/3/screenscheme/%v", screenSchemeID)
 
-	request, err := s.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodDelete, "rest/api/3/screenscheme/{screenSchemeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -35,7 +35,7 @@ func (s *ScreenTabService) Gets(ctx context.Context, screenID int, projectKey st
 		endpoint = fmt.Sprintf("rest/api/3/screens/%v/tabs", screenID)
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/api/3/screens/{screenID}/tabs", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -69,7 +69,7 @@ func (s *ScreenTabService) Create(ctx context.Context, screenID int, tabName str
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v/tabs", screenID)
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := s.client.newRequest(ctx, http.MethodPost, "rest/api/3/screens/{screenID}/tabs", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -104,7 +104,7 @@ func (s *ScreenTabService) Update(ctx context.Context, screenID, tabID int, newT
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v/tabs/%v", screenID, tabID)
 
-	request, err := s.client.newRequest(ctx, http.MethodPut, endpoint, &payload)
+	request, err := s.client.newRequest(ctx, http.MethodPut, "rest/api/3/screens/{screenID}/tabs/{tabID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -131,7 +131,7 @@ func (s *ScreenTabService) Delete(ctx context.Context, screenID, tabID int) (res
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v/tabs/%v", screenID, tabID)
 
-	request, err := s.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodDelete, "rest/api/3/screens/{screenID}/tabs/{tabID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -150,7 +150,7 @@ func (s *ScreenTabService) Move(ctx context.Context, screenID, tabID, tabPositio
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v/tabs/%v/move/%v", screenID, tabID, tabPosition)
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodPost, "rest/api/3/screens/{screenID}/tabs/{tabID}/move/{tabPosition}", endpoint, nil)
 	if err != nil {
 		return
 	}
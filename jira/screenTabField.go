@@ -20,7 +20,7 @@ func (s *ScreenTabFieldService) Gets(ctx context.Context, screenID, tabID int) (
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v/tabs/%v/fields", screenID, tabID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/api/3/screens/{screenID}/tabs/{tabID}/fields", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -54,7 +54,7 @@ func (s *ScreenTabFieldService) Add(ctx context.Context, screenID, tabID int, fi
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v/tabs/%v/fields", screenID, tabID)
 
-	request, err := s.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := s.client.newRequest(ctx, http.MethodPost, "rest/api/3/screens/{screenID}/tabs/{tabID}/fields", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -85,7 +85,7 @@ func (s *ScreenTabFieldService) Remove(ctx context.Context, screenID, tabID int,
 
 	var endpoint = fmt.Sprintf("rest/api/3/screens/%v/tabs/%v/fields/%v", screenID, tabID, fieldID)
 
-	request, err := s.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodDelete, "rest/api/3/screens/{screenID}/tabs/{tabID}/fields/{fieldID}", endpoint, nil)
 	if err != nil {
 		return
 	}
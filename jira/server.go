@@ -31,7 +31,7 @@ func (s *ServerService) Info(ctx context.Context) (result *ServerInformationSche
 
 	var endpoint = "rest/api/3/serverInfo"
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/api/3/serverInfo", endpoint, nil)
 	if err != nil {
 		return
 	}
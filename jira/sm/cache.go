@@ -0,0 +1,11 @@
+package sm
+
+import "github.com/ctreminiom/go-atlassian/cache"
+
+// WithCache returns the cached responses of the GET requests of the client, the TTLs of the cache are matched with
+// the endpoint templates of the service methods, e.g: rest/servicedeskapi/info
+func WithCache(cache *cache.Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
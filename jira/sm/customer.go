@@ -41,7 +41,7 @@ func (c *CustomerService) Create(ctx context.Context, email, displayName string)
 
 	var endpoint = "rest/servicedeskapi/customer"
 
-	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := c.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/customer", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -104,7 +104,7 @@ func (c *CustomerService) Get(ctx context.Context, serviceDeskID int, query stri
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer?%v", serviceDeskID, params.Encode())
 
-	request, err := c.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := c.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/customer", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -144,7 +144,7 @@ func (c *CustomerService) Add(ctx context.Context, serviceDeskID int, accountIDs
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID)
 
-	request, err := c.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := c.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/servicedesk/{serviceDeskID}/customer", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -173,7 +173,7 @@ func (c *CustomerService) Remove(ctx context.Context, serviceDeskID int, account
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID)
 
-	request, err := c.client.newRequest(ctx, http.MethodDelete, endpoint, &payload)
+	request, err := c.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/servicedesk/{serviceDeskID}/customer", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,510 @@
+package sm
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	CustomerImportCreatedStatus  = "created"
+	CustomerImportExistingStatus = "existing"
+	CustomerImportFailedStatus   = "failed"
+)
+
+// CustomerImportRowScheme represents a customer to be imported, the organization is created when it doesn't exist
+type CustomerImportRowScheme struct {
+	Email         string `json:"email"`
+	DisplayName   string `json:"displayName"`
+	Organization  string `json:"organization,omitempty"`
+	ServiceDeskID int    `json:"serviceDeskId,omitempty"`
+}
+
+type CustomerImportOptionsScheme struct {
+	ServiceDeskID int                                         // The service desk of the rows without the service desk
+	DryRun        bool                                        // Resolves the customers and organizations without changes, the actions are reported as planned
+	StartRow      int                                         // Resumes the import from the row, the rows are numbered from 1
+	OnRow         func(result *CustomerImportRowResultScheme) // Called after every row, e.g: to store the last imported row
+}
+
+type CustomerImportResultScheme struct {
+	DryRun   bool                             `json:"dryRun"`
+	Created  int                              `json:"created"`
+	Existing int                              `json:"existing"`
+	Failed   int                              `json:"failed"`
+	Skipped  int                              `json:"skipped"`
+	Rows     []*CustomerImportRowResultScheme `json:"rows,omitempty"`
+}
+
+// CustomerImportRowResultScheme contains the outcome of a row, the actions are the changes made,
+// or planned on a dry run, in order.
+type CustomerImportRowResultScheme struct {
+	Row            int      `json:"row"`
+	Email          string   `json:"email"`
+	AccountID      string   `json:"accountId,omitempty"`
+	Organization   string   `json:"organization,omitempty"`
+	OrganizationID int      `json:"organizationId,omitempty"`
+	ServiceDeskID  int      `json:"serviceDeskId,omitempty"`
+	Status         string   `json:"status"`
+	Actions        []string `json:"actions,omitempty"`
+	Error          string   `json:"error,omitempty"`
+}
+
+// ReadCustomerImportCSV reads the rows of a CSV file, the first row is the header with the columns
+// email, display_name, organization and service_desk_id, only the email column is required.
+func ReadCustomerImportCSV(reader io.Reader) (rows []*CustomerImportRowScheme, err error) {
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error, the CSV header can't be read: %v", err)
+	}
+
+	columns := make(map[string]int)
+	for index, name := range header {
+		name = strings.NewReplacer("_", "", " ", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+		columns[name] = index
+	}
+
+	if _, ok := columns["email"]; !ok {
+		return nil, fmt.Errorf("error, the CSV header doesn't contain the email column")
+	}
+
+	value := func(record []string, names ...string) string {
+
+		for _, name := range names {
+			if index, ok := columns[name]; ok && index < len(record) {
+				return strings.TrimSpace(record[index])
+			}
+		}
+
+		return ""
+	}
+
+	for line := 2; ; line++ {
+
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		row := &CustomerImportRowScheme{
+			Email:        value(record, "email"),
+			DisplayName:  value(record, "displayname", "name"),
+			Organization: value(record, "organization"),
+		}
+
+		if serviceDeskID := value(record, "servicedeskid", "servicedesk"); len(serviceDeskID) != 0 {
+
+			row.ServiceDeskID, err = strconv.Atoi(serviceDeskID)
+			if err != nil {
+				return nil, fmt.Errorf("error, the service desk ID of the line %v is not valid: %v", line, err)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+}
+
+// ReadCustomerImportJSON reads the rows of a JSON array
+func ReadCustomerImportJSON(reader io.Reader) (rows []*CustomerImportRowScheme, err error) {
+
+	if err = json.NewDecoder(reader).Decode(&rows); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// Import creates the missing customers and organizations of the rows, adds the customers to the organizations
+// and the service desks and associates the organizations with the service desks.
+// The customers are matched by the email, so the import can be run again with the same rows,
+// the errors are reported on the row results and don't stop the import.
+func (c *CustomerService) Import(ctx context.Context, rows []*CustomerImportRowScheme, opts *CustomerImportOptionsScheme) (result *CustomerImportResultScheme, err error) {
+
+	if opts == nil {
+		opts = &CustomerImportOptionsScheme{}
+	}
+
+	importer := &customerImporter{
+		customers:     c,
+		organizations: &OrganizationService{client: c.client},
+		dryRun:        opts.DryRun,
+		associated:    make(map[string]bool),
+	}
+
+	result = &CustomerImportResultScheme{DryRun: opts.DryRun}
+
+	for index, row := range rows {
+
+		if index+1 < opts.StartRow {
+			result.Skipped++
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		rowResult := importer.importRow(ctx, index+1, row, opts.ServiceDeskID)
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+
+		switch rowResult.Status {
+		case CustomerImportCreatedStatus:
+			result.Created++
+		case CustomerImportExistingStatus:
+			result.Existing++
+		default:
+			result.Failed++
+		}
+
+		result.Rows = append(result.Rows, rowResult)
+
+		if opts.OnRow != nil {
+			opts.OnRow(rowResult)
+		}
+	}
+
+	return result, nil
+}
+
+type customerImporter struct {
+	customers     *CustomerService
+	organizations *OrganizationService
+	dryRun        bool
+
+	byName         map[string]*OrganizationScheme // lowercase organization name -> organization
+	associated     map[string]bool                // service desk ID and lowercase organization name already associated
+	serviceDeskIDs []int                          // the service desks visible to the user, read on the first conflict
+}
+
+func (i *customerImporter) importRow(ctx context.Context, number int, row *CustomerImportRowScheme, serviceDeskID int) (result *CustomerImportRowResultScheme) {
+
+	result = &CustomerImportRowResultScheme{
+		Row:           number,
+		Email:         strings.TrimSpace(row.Email),
+		Organization:  strings.TrimSpace(row.Organization),
+		ServiceDeskID: row.ServiceDeskID,
+	}
+
+	if result.ServiceDeskID == 0 {
+		result.ServiceDeskID = serviceDeskID
+	}
+
+	fail := func(err error) *CustomerImportRowResultScheme {
+		result.Status, result.Error = CustomerImportFailedStatus, err.Error()
+		return result
+	}
+
+	if !isEmailValid(result.Email) {
+		return fail(fmt.Errorf("error, the email (%v) is not valid mail", result.Email))
+	}
+
+	customer, inServiceDesk, err := i.lookupCustomer(ctx, result.Email, result.ServiceDeskID)
+	if err != nil {
+		return fail(err)
+	}
+
+	result.Status = CustomerImportExistingStatus
+
+	if customer == nil {
+
+		displayName := strings.TrimSpace(row.DisplayName)
+		if len(displayName) == 0 {
+			displayName = result.Email
+		}
+
+		result.Status = CustomerImportCreatedStatus
+		result.Actions = append(result.Actions, fmt.Sprintf("create customer %v", result.Email))
+
+		if !i.dryRun {
+
+			var response *Response
+			customer, response, err = i.customers.Create(ctx, result.Email, displayName)
+
+			// The customers hidden from the lookup, e.g: the portal-only customers, already have an account
+			if err != nil && response != nil && (response.StatusCode == http.StatusBadRequest || response.StatusCode == http.StatusConflict) {
+
+				var resolveErr error
+				customer, inServiceDesk, resolveErr = i.resolveCustomer(ctx, result.Email, result.ServiceDeskID)
+				if resolveErr != nil {
+					return fail(resolveErr)
+				}
+
+				if customer != nil {
+					result.Status, result.Actions, err = CustomerImportExistingStatus, nil, nil
+				}
+			}
+
+			if err != nil {
+				return fail(err)
+			}
+		}
+	}
+
+	if customer != nil {
+		result.AccountID = customer.AccountID
+	}
+
+	if result.ServiceDeskID != 0 && !inServiceDesk {
+
+		result.Actions = append(result.Actions, fmt.Sprintf("add customer to service desk %v", result.ServiceDeskID))
+
+		if !i.dryRun {
+			if _, err = i.customers.Add(ctx, result.ServiceDeskID, []string{result.AccountID}); err != nil {
+				return fail(err)
+			}
+		}
+	}
+
+	if len(result.Organization) == 0 {
+		return result
+	}
+
+	organization, err := i.organization(ctx, result.Organization)
+	if err != nil {
+		return fail(err)
+	}
+
+	if organization == nil {
+
+		result.Actions = append(result.Actions, fmt.Sprintf("create organization %v", result.Organization))
+
+		if i.dryRun {
+			organization = &OrganizationScheme{Name: result.Organization}
+		} else {
+
+			organization, _, err = i.organizations.Create(ctx, result.Organization)
+			if err != nil {
+				return fail(err)
+			}
+		}
+
+		i.byName[strings.ToLower(result.Organization)] = organization
+	}
+
+	if len(organization.ID) != 0 {
+
+		result.OrganizationID, err = strconv.Atoi(organization.ID)
+		if err != nil {
+			return fail(fmt.Errorf("error, the organization ID %v is not valid: %v", organization.ID, err))
+		}
+	}
+
+	result.Actions = append(result.Actions, fmt.Sprintf("add customer to organization %v", result.Organization))
+
+	if !i.dryRun {
+		if _, err = i.organizations.Add(ctx, result.OrganizationID, []string{result.AccountID}); err != nil {
+			return fail(err)
+		}
+	}
+
+	var association = fmt.Sprintf("%v/%v", result.ServiceDeskID, strings.ToLower(result.Organization))
+	if result.ServiceDeskID == 0 || i.associated[association] {
+		return result
+	}
+
+	associated, err := i.isAssociated(ctx, result.ServiceDeskID, organization.ID)
+	if err != nil {
+		return fail(err)
+	}
+
+	if !associated {
+
+		result.Actions = append(result.Actions, fmt.Sprintf("associate organization %v with service desk %v", result.Organization, result.ServiceDeskID))
+
+		if !i.dryRun {
+			if _, err = i.organizations.Associate(ctx, result.ServiceDeskID, result.OrganizationID); err != nil {
+				return fail(err)
+			}
+		}
+	}
+
+	i.associated[association] = true
+
+	return result
+}
+
+// lookupCustomer searches the customer on the service desk and on the Jira users,
+// it returns nil when the email doesn't belong to an account.
+// The Jira users search doesn't return the accounts with the email hidden, e.g: the portal-only customers,
+// so the creation of those customers fails and they're resolved with the resolveCustomer func.
+func (i *customerImporter) lookupCustomer(ctx context.Context, email string, serviceDeskID int) (customer *CustomerScheme, inServiceDesk bool, err error) {
+
+	if serviceDeskID != 0 {
+
+		customer, err = i.searchCustomer(ctx, email, serviceDeskID)
+		if err != nil || customer != nil {
+			return customer, customer != nil, err
+		}
+	}
+
+	params := url.Values{}
+	params.Add("query", email)
+
+	var endpoint = fmt.Sprintf("rest/api/3/user/search?%v", params.Encode())
+
+	request, err := i.customers.client.newRequest(ctx, http.MethodGet, "rest/api/3/user/search", endpoint, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err := i.customers.client.Do(request)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var users []*CustomerScheme
+	if err = json.Unmarshal(response.BodyAsBytes, &users); err != nil {
+		return nil, false, err
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.EmailAddress, email) {
+			return user, false, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// resolveCustomer searches the customer rejected by the creation on the customers of the service desks visible
+// to the user, the service desk of the row is searched by the lookupCustomer func.
+// It returns nil when the customer is not found on any service desk.
+func (i *customerImporter) resolveCustomer(ctx context.Context, email string, serviceDeskID int) (customer *CustomerScheme, inServiceDesk bool, err error) {
+
+	if i.serviceDeskIDs == nil {
+
+		service := &ServiceDeskService{client: i.customers.client}
+
+		for start, limit := 0, 50; ; start += limit {
+
+			page, _, err := service.Gets(ctx, start, limit)
+			if err != nil {
+				return nil, false, err
+			}
+
+			for _, serviceDesk := range page.Values {
+
+				id, err := strconv.Atoi(serviceDesk.ID)
+				if err != nil {
+					return nil, false, fmt.Errorf("error, the service desk ID %v is not valid: %v", serviceDesk.ID, err)
+				}
+
+				i.serviceDeskIDs = append(i.serviceDeskIDs, id)
+			}
+
+			if page.IsLastPage || len(page.Values) == 0 {
+				break
+			}
+		}
+	}
+
+	for _, id := range i.serviceDeskIDs {
+
+		if id == serviceDeskID {
+			continue
+		}
+
+		customer, err = i.searchCustomer(ctx, email, id)
+		if err != nil || customer != nil {
+			return customer, false, err
+		}
+	}
+
+	return nil, false, nil
+}
+
+// searchCustomer searches the customer on the customers of the service desk, it returns nil when it's not found
+func (i *customerImporter) searchCustomer(ctx context.Context, email string, serviceDeskID int) (*CustomerScheme, error) {
+
+	for start, limit := 0, 50; ; start += limit {
+
+		page, _, err := i.customers.Get(ctx, serviceDeskID, email, start, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, customer := range page.Values {
+			if strings.EqualFold(customer.EmailAddress, email) {
+				return customer, nil
+			}
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+// organization returns the organization with the name, the organizations are read on the first call
+func (i *customerImporter) organization(ctx context.Context, name string) (*OrganizationScheme, error) {
+
+	if i.byName == nil {
+
+		i.byName = make(map[string]*OrganizationScheme)
+
+		for start, limit := 0, 50; ; start += limit {
+
+			page, _, err := i.organizations.Gets(ctx, "", start, limit)
+			if err != nil {
+				i.byName = nil
+				return nil, err
+			}
+
+			for _, organization := range page.Values {
+				i.byName[strings.ToLower(organization.Name)] = organization
+			}
+
+			if page.IsLastPage || len(page.Values) == 0 {
+				break
+			}
+		}
+	}
+
+	return i.byName[strings.ToLower(name)], nil
+}
+
+// isAssociated checks if the organization is associated with the service desk,
+// the organizations without ID are planned by a dry run and are not associated.
+func (i *customerImporter) isAssociated(ctx context.Context, serviceDeskID int, organizationID string) (bool, error) {
+
+	if len(organizationID) == 0 {
+		return false, nil
+	}
+
+	for start, limit := 0, 50; ; start += limit {
+
+		page, _, err := i.organizations.Project(ctx, "", serviceDeskID, start, limit)
+		if err != nil {
+			return false, err
+		}
+
+		for _, organization := range page.Values {
+			if organization.ID == organizationID {
+				return true, nil
+			}
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			return false, nil
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package sm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeCustomerImportServer serves a service desk with a customer, an organization not associated
+// with the service desk and a customer of another service desk, the changes are recorded.
+type fakeCustomerImportServer struct {
+	mu      sync.Mutex
+	changes []string
+}
+
+func (f *fakeCustomerImportServer) handler(t *testing.T) http.Handler {
+
+	mux := http.NewServeMux()
+
+	record := func(r *http.Request) string {
+
+		body, _ := ioutil.ReadAll(r.Body)
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		f.changes = append(f.changes, strings.TrimSpace(fmt.Sprintf("%v %v %s", r.Method, r.URL.Path, body)))
+		return string(body)
+	}
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/1/customer", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method == http.MethodPost {
+			record(r)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if r.URL.Query().Get("query") == "existing@go-atlassian.io" {
+			_, _ = w.Write([]byte(`{"isLastPage": true, "values": [{"accountId": "account-existing", "emailAddress": "Existing@go-atlassian.io"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": []}`))
+	})
+
+	mux.HandleFunc("/rest/api/3/user/search", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Query().Get("query") == "elsewhere@go-atlassian.io" {
+			_, _ = w.Write([]byte(`[{"accountId": "account-elsewhere", "emailAddress": "elsewhere@go-atlassian.io"}]`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/customer", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		record(r)
+		_, _ = w.Write([]byte(`{"accountId": "account-new", "emailAddress": "new@go-atlassian.io"}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/organization", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method == http.MethodPost {
+			record(r)
+			_, _ = w.Write([]byte(`{"id": "20", "name": "Globex"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": [{"id": "10", "name": "Acme"}]}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/organization/", func(w http.ResponseWriter, r *http.Request) {
+		record(r)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/1/organization", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.Method == http.MethodPost {
+			record(r)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": []}`))
+	})
+
+	return mux
+}
+
+const customerImportCSV = `Email,Display Name,Organization,Service Desk ID
+existing@go-atlassian.io,Existing,Acme,
+new@go-atlassian.io,New,Globex,1
+elsewhere@go-atlassian.io,,acme,
+invalid-email,Invalid,,
+`
+
+func TestReadCustomerImport(t *testing.T) {
+
+	rows, err := ReadCustomerImportCSV(strings.NewReader(customerImportCSV))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if assert.Len(t, rows, 4) {
+		assert.Equal(t, &CustomerImportRowScheme{Email: "new@go-atlassian.io", DisplayName: "New", Organization: "Globex", ServiceDeskID: 1}, rows[1])
+		assert.Equal(t, "", rows[2].DisplayName)
+	}
+
+	_, err = ReadCustomerImportCSV(strings.NewReader("name,organization\nCarlos,Acme\n"))
+	assert.Error(t, err)
+
+	_, err = ReadCustomerImportCSV(strings.NewReader("email,service_desk_id\ncarlos@go-atlassian.io,one\n"))
+	assert.Error(t, err)
+
+	encoded, err := json.Marshal(rows)
+	assert.NoError(t, err)
+
+	decoded, err := ReadCustomerImportJSON(strings.NewReader(string(encoded)))
+	assert.NoError(t, err)
+	assert.Equal(t, rows, decoded)
+}
+
+func TestCustomerService_Import(t *testing.T) {
+
+	rows, err := ReadCustomerImportCSV(strings.NewReader(customerImportCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name        string
+		opts        *CustomerImportOptionsScheme
+		wantStatus  []string
+		wantSkipped int
+		wantChanges []string
+	}{
+		{
+			name: "ImportWhenTheRowsAreValid",
+			opts: &CustomerImportOptionsScheme{ServiceDeskID: 1},
+			wantStatus: []string{CustomerImportExistingStatus, CustomerImportCreatedStatus, CustomerImportExistingStatus,
+				CustomerImportFailedStatus},
+			wantChanges: []string{
+				`POST /rest/servicedeskapi/organization/10/user {"accountIds":["account-existing"]}`,
+				`POST /rest/servicedeskapi/servicedesk/1/organization {"organizationId":10}`,
+				`POST /rest/servicedeskapi/customer {"displayName":"New","email":"new@go-atlassian.io"}`,
+				`POST /rest/servicedeskapi/servicedesk/1/customer {"accountIds":["account-new"]}`,
+				`POST /rest/servicedeskapi/organization {"name":"Globex"}`,
+				`POST /rest/servicedeskapi/organization/20/user {"accountIds":["account-new"]}`,
+				`POST /rest/servicedeskapi/servicedesk/1/organization {"organizationId":20}`,
+				`POST /rest/servicedeskapi/servicedesk/1/customer {"accountIds":["account-elsewhere"]}`,
+				`POST /rest/servicedeskapi/organization/10/user {"accountIds":["account-elsewhere"]}`,
+			},
+		},
+		{
+			name: "ImportWhenItIsADryRun",
+			opts: &CustomerImportOptionsScheme{ServiceDeskID: 1, DryRun: true},
+			wantStatus: []string{CustomerImportExistingStatus, CustomerImportCreatedStatus, CustomerImportExistingStatus,
+				CustomerImportFailedStatus},
+		},
+		{
+			name:        "ImportWhenItIsResumed",
+			opts:        &CustomerImportOptionsScheme{ServiceDeskID: 1, StartRow: 3},
+			wantStatus:  []string{CustomerImportExistingStatus, CustomerImportFailedStatus},
+			wantSkipped: 2,
+			wantChanges: []string{
+				`POST /rest/servicedeskapi/servicedesk/1/customer {"accountIds":["account-elsewhere"]}`,
+				`POST /rest/servicedeskapi/organization/10/user {"accountIds":["account-elsewhere"]}`,
+				`POST /rest/servicedeskapi/servicedesk/1/organization {"organizationId":10}`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			fakeServer := &fakeCustomerImportServer{}
+
+			mockServer := httptest.NewServer(fakeServer.handler(t))
+			defer mockServer.Close()
+
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var imported []int
+			testCase.opts.OnRow = func(result *CustomerImportRowResultScheme) { imported = append(imported, result.Row) }
+
+			result, err := mockClient.Customer.Import(context.Background(), rows, testCase.opts)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var status []string
+			for _, row := range result.Rows {
+				status = append(status, row.Status)
+			}
+
+			assert.Equal(t, testCase.wantStatus, status)
+			assert.Equal(t, testCase.wantSkipped, result.Skipped)
+			assert.Equal(t, testCase.wantChanges, fakeServer.changes)
+			assert.Len(t, imported, len(result.Rows))
+			assert.NotEmpty(t, result.Rows[len(result.Rows)-1].Error)
+		})
+	}
+}
+
+func TestCustomerService_ImportWhenItIsADryRun(t *testing.T) {
+
+	mockServer := httptest.NewServer((&fakeCustomerImportServer{}).handler(t))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := []*CustomerImportRowScheme{
+		{Email: "new@go-atlassian.io", Organization: "Globex"},
+		{Email: "other@go-atlassian.io", Organization: "globex"},
+	}
+
+	result, err := mockClient.Customer.Import(context.Background(), rows, &CustomerImportOptionsScheme{ServiceDeskID: 1, DryRun: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 2, result.Created)
+
+	// The planned organization is created once and associated once
+	assert.Equal(t, []string{
+		"create customer new@go-atlassian.io",
+		"add customer to service desk 1",
+		"create organization Globex",
+		"add customer to organization Globex",
+		"associate organization Globex with service desk 1",
+	}, result.Rows[0].Actions)
+
+	assert.Equal(t, []string{
+		"create customer other@go-atlassian.io",
+		"add customer to service desk 1",
+		"add customer to organization globex",
+	}, result.Rows[1].Actions)
+}
+
+func TestCustomerService_ImportWhenTheCustomerIsHidden(t *testing.T) {
+
+	fakeServer := &fakeCustomerImportServer{}
+	mux := http.NewServeMux()
+
+	// The portal-only customer is not returned by the Jira users search, its creation is rejected
+	mux.HandleFunc("/rest/servicedeskapi/customer", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errorMessage": "An account already exists for this email"}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": [{"id": "1"}, {"id": "2"}]}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/2/customer", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Query().Get("query") == "portal@go-atlassian.io" {
+			_, _ = w.Write([]byte(`{"isLastPage": true, "values": [{"accountId": "account-portal", "emailAddress": "portal@go-atlassian.io"}]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": []}`))
+	})
+
+	mux.Handle("/", fakeServer.handler(t))
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := []*CustomerImportRowScheme{
+		{Email: "portal@go-atlassian.io", DisplayName: "Portal"},
+		{Email: "unknown@go-atlassian.io", DisplayName: "Unknown"},
+	}
+
+	result, err := mockClient.Customer.Import(context.Background(), rows, &CustomerImportOptionsScheme{ServiceDeskID: 1})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if assert.Len(t, result.Rows, 2) {
+
+		assert.Equal(t, CustomerImportExistingStatus, result.Rows[0].Status)
+		assert.Equal(t, "account-portal", result.Rows[0].AccountID)
+		assert.Equal(t, []string{"add customer to service desk 1"}, result.Rows[0].Actions)
+
+		// The rejected creation is reported when the customer is not found on the service desks
+		assert.Equal(t, CustomerImportFailedStatus, result.Rows[1].Status)
+		assert.Contains(t, result.Rows[1].Error, "400")
+	}
+
+	assert.Equal(t, []string{`POST /rest/servicedeskapi/servicedesk/1/customer {"accountIds":["account-portal"]}`}, fakeServer.changes)
+}
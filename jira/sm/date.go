@@ -0,0 +1,56 @@
+package sm
+
+import "time"
+
+// DateScheme represents the date returned by the Service Management API in the iso8601, jira, friendly and epochMillis formats
+type DateScheme struct {
+	Iso8601     string `json:"iso8601,omitempty"`
+	Jira        string `json:"jira,omitempty"`
+	Friendly    string `json:"friendly,omitempty"`
+	EpochMillis int64  `json:"epochMillis,omitempty"`
+}
+
+// Time returns the date as a time.Time, the epochMillis value is used when it's available,
+// otherwise the iso8601 and jira values are parsed. A nil or empty date returns the zero time.
+func (d *DateScheme) Time() time.Time {
+
+	if d == nil {
+		return time.Time{}
+	}
+
+	if d.EpochMillis != 0 {
+		return time.Unix(0, d.EpochMillis*int64(time.Millisecond))
+	}
+
+	for _, value := range []struct{ layout, date string }{
+		{"2006-01-02T15:04:05-0700", d.Iso8601},
+		{"2006-01-02T15:04:05.000-0700", d.Jira},
+	} {
+
+		if len(value.date) == 0 {
+			continue
+		}
+
+		if dateAsTime, err := time.Parse(value.layout, value.date); err == nil {
+			return dateAsTime
+		}
+	}
+
+	return time.Time{}
+}
+
+// DurationScheme represents the duration returned by the Service Management API in the millis and friendly formats
+type DurationScheme struct {
+	Millis   int64  `json:"millis"`
+	Friendly string `json:"friendly,omitempty"`
+}
+
+// Duration returns the duration as a time.Duration, the remaining time of a breached SLA is negative.
+func (d *DurationScheme) Duration() time.Duration {
+
+	if d == nil {
+		return 0
+	}
+
+	return time.Duration(d.Millis) * time.Millisecond
+}
@@ -0,0 +1,91 @@
+package sm
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestDateScheme_Time(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		date *DateScheme
+		want time.Time
+	}{
+		{
+			name: "TimeWhenTheEpochMillisIsSet",
+			date: &DateScheme{EpochMillis: 1444362323000, Iso8601: "2000-01-01T00:00:00+0000"},
+			want: time.Date(2015, 10, 9, 3, 45, 23, 0, time.UTC),
+		},
+		{
+			name: "TimeWhenOnlyTheIso8601IsSet",
+			date: &DateScheme{Iso8601: "2015-10-09T10:45:23+0700"},
+			want: time.Date(2015, 10, 9, 3, 45, 23, 0, time.UTC),
+		},
+		{
+			name: "TimeWhenOnlyTheJiraDateIsSet",
+			date: &DateScheme{Jira: "2015-10-09T10:45:23.120+0700"},
+			want: time.Date(2015, 10, 9, 3, 45, 23, 120000000, time.UTC),
+		},
+		{
+			name: "TimeWhenTheDateIsNotValid",
+			date: &DateScheme{Friendly: "Yesterday 10:45 AM", Iso8601: "yesterday"},
+			want: time.Time{},
+		},
+		{
+			name: "TimeWhenTheDateIsNil",
+			date: nil,
+			want: time.Time{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.True(t, testCase.want.Equal(testCase.date.Time()), "got %v", testCase.date.Time())
+		})
+	}
+}
+
+func TestDurationScheme_Duration(t *testing.T) {
+
+	assert.Equal(t, 4*time.Hour, (&DurationScheme{Millis: 14400000, Friendly: "4h 240m"}).Duration())
+	assert.Equal(t, -83*time.Minute, (&DurationScheme{Millis: -4980000, Friendly: "-1h -83m"}).Duration())
+
+	var duration *DurationScheme
+	assert.Equal(t, time.Duration(0), duration.Duration())
+}
+
+func TestRequestSLAScheme_Decode(t *testing.T) {
+
+	content, err := ioutil.ReadFile("./mocks/get-customer-sla.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sla := new(RequestSLAScheme)
+	if err = json.Unmarshal(content, sla); err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.Len(t, sla.CompletedCycles, 2) {
+
+		cycle := sla.CompletedCycles[1]
+		assert.True(t, cycle.Breached)
+		assert.Equal(t, time.Date(2015, 10, 10, 3, 52, 23, 0, time.UTC), cycle.StartTime.Time().UTC())
+		assert.Equal(t, time.Date(2015, 10, 10, 9, 15, 23, 0, time.UTC), cycle.StopTime.Time().UTC())
+		assert.Equal(t, 4*time.Hour, cycle.GoalDuration.Duration())
+		assert.Equal(t, -83*time.Minute, cycle.RemainingTime.Duration())
+	}
+
+	if assert.NotNil(t, sla.OngoingCycle) {
+		assert.Nil(t, sla.OngoingCycle.BreachTime)
+		assert.True(t, sla.OngoingCycle.BreachTime.Time().IsZero())
+		assert.Equal(t, 94*time.Minute, sla.OngoingCycle.ElapsedTime.Duration())
+		assert.Equal(t, 146*time.Minute, sla.OngoingCycle.RemainingTime.Duration())
+	}
+}
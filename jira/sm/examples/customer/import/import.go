@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+	atlassian.Auth.SetUserAgent("curl/7.54.0")
+
+	file, err := os.Open("customers.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer file.Close()
+
+	rows, err := sm.ReadCustomerImportCSV(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opts := &sm.CustomerImportOptionsScheme{
+		ServiceDeskID: 1,
+		DryRun:        true,
+		StartRow:      1,
+		OnRow: func(result *sm.CustomerImportRowResultScheme) {
+			log.Println(result.Row, result.Email, result.Status, result.Actions, result.Error)
+		},
+	}
+
+	result, err := atlassian.ServiceManagement.Customer.Import(context.Background(), rows, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("Created", result.Created, "Existing", result.Existing, "Failed", result.Failed, "Skipped", result.Skipped)
+}
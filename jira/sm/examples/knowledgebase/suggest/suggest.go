@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+	atlassian.Auth.SetUserAgent("curl/7.54.0")
+
+	opts := &sm.ArticleSuggestOptionsScheme{
+		Summary:        "Laptop stolen",
+		Description:    "My computer was stolen at the airport",
+		ServiceDeskIDs: []int{1},
+		Limit:          3,
+	}
+
+	suggestions, raised, err := atlassian.ServiceManagement.Knowledgebase.Deflect(context.Background(), opts,
+		func(suggestions []*sm.ArticleSuggestionScheme) bool {
+
+			for _, suggestion := range suggestions {
+
+				var excerpt strings.Builder
+				for _, span := range suggestion.ExcerptSpans {
+
+					if span.Highlighted {
+						excerpt.WriteString("*" + span.Text + "*")
+						continue
+					}
+
+					excerpt.WriteString(span.Text)
+				}
+
+				log.Println(suggestion.Title, suggestion.Link, excerpt.String())
+			}
+
+			// Raise the request when the articles don't answer the question
+			return false
+		},
+		func(ctx context.Context) error {
+			log.Println("Creating the customer request")
+			return nil
+		})
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("Suggestions", len(suggestions), "Request raised", raised)
+}
@@ -42,4 +42,10 @@ func main() {
 	log.Println("SLA ID", sla.ID)
 	log.Println("SLA Name", sla.Name)
 
+	if sla.OngoingCycle != nil {
+		log.Println("SLA Elapsed Time", sla.OngoingCycle.ElapsedTime.Duration())
+		log.Println("SLA Remaining Time", sla.OngoingCycle.RemainingTime.Duration())
+		log.Println("SLA Breach Time", sla.OngoingCycle.BreachTime.Time())
+	}
+
 }
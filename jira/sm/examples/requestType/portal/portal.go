@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+	atlassian.Auth.SetUserAgent("curl/7.54.0")
+
+	portal, err := atlassian.ServiceManagement.RequestType.Portal(context.Background(), 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, group := range portal.Groups {
+
+		log.Println("Group", group.Name)
+
+		for _, requestType := range group.RequestTypes {
+
+			log.Println("  Request Type", requestType.Name)
+
+			for _, field := range requestType.Fields {
+
+				if !field.Visible {
+					continue
+				}
+
+				log.Println("    Field", field.FieldID, field.Name, field.Required, field.JiraSchema.Type, len(field.ValidValues))
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+	atlassian.Auth.SetUserAgent("curl/7.54.0")
+
+	var (
+		serviceDeskID = 1
+		requestTypeID = 2
+		propertyKey   = "support-team"
+	)
+
+	response, err := atlassian.ServiceManagement.RequestType.SetProperty(context.Background(), serviceDeskID, requestTypeID, propertyKey,
+		map[string]interface{}{"team": "tier-2"})
+
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	property, response, err := atlassian.ServiceManagement.RequestType.Property(context.Background(), serviceDeskID, requestTypeID, propertyKey)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+		}
+		log.Fatal(err)
+	}
+
+	log.Println(property.Key, property.Value)
+
+	keys, _, err := atlassian.ServiceManagement.RequestType.Properties(context.Background(), serviceDeskID, requestTypeID)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, key := range keys.Keys {
+		log.Println(key.Key)
+	}
+
+	if _, err = atlassian.ServiceManagement.RequestType.DeleteProperty(context.Background(), serviceDeskID, requestTypeID, propertyKey); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+	atlassian.Auth.SetUserAgent("curl/7.54.0")
+
+	var (
+		serviceDeskID = 1
+		queueID       = 1
+	)
+
+	options := &sm.RequestSLABreachOptionsScheme{
+		Window:   30 * time.Minute,
+		SLANames: []string{"Time to first response", "Time to resolution"},
+	}
+
+	breaches, err := atlassian.ServiceManagement.ServiceDesk.Queue.Breaching(context.Background(), serviceDeskID, queueID, options)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, breach := range breaches {
+		log.Printf("%v %v breaches at %v (%v remaining)", breach.IssueKey, breach.SLAName, breach.BreachTime, breach.Remaining)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := jira.New(nil, host)
+	if err != nil {
+		return
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+	atlassian.Auth.SetUserAgent("curl/7.54.0")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	options := &sm.QueueMonitorOptionsScheme{
+		Queues: []*sm.QueueMonitorQueueScheme{
+			{ServiceDeskID: 1, QueueID: 1, Threshold: 25},
+			{ServiceDeskID: 1, QueueID: 2},
+		},
+		Interval:   30 * time.Second,
+		MaxBackoff: 5 * time.Minute,
+		OnError:    func(err error) { log.Println(err) },
+	}
+
+	events, err := atlassian.ServiceManagement.ServiceDesk.Queue.Events(ctx, options)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for event := range events {
+
+		switch event.Type {
+		case sm.QueueIssueEnteredEvent, sm.QueueIssueLeftEvent:
+			log.Printf("%v %v the queue %v", event.IssueKey, event.Type, event.QueueID)
+		case sm.QueueThresholdExceededEvent, sm.QueueThresholdRecoveredEvent:
+			log.Printf("queue %v %v: %v issues (threshold %v)", event.QueueID, event.Type, event.Count, event.Threshold)
+		}
+	}
+}
@@ -14,7 +14,7 @@ func (i *InfoService) Get(ctx context.Context) (result *InfoScheme, response *Re
 
 	var endpoint = "rest/servicedeskapi/info"
 
-	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := i.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/info", endpoint, nil)
 	if err != nil {
 		return
 	}
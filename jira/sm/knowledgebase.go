@@ -29,7 +29,7 @@ func (k *KnowledgebaseService) Search(ctx context.Context, query string, highlig
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/knowledgebase/article?%v", params.Encode())
 
-	request, err := k.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := k.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/knowledgebase/article", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -52,6 +52,17 @@ func (k *KnowledgebaseService) Search(ctx context.Context, query string, highlig
 
 func (k *KnowledgebaseService) Gets(ctx context.Context, serviceDeskID int, query string, highlight bool, start, limit int) (result *ArticlePageScheme, response *Response, err error) {
 
+	result = new(ArticlePageScheme)
+	if response, err = k.gets(ctx, serviceDeskID, query, highlight, start, limit, result); err != nil {
+		return nil, response, err
+	}
+
+	return
+}
+
+// gets searches the articles of the service desk and decodes the page on the result value
+func (k *KnowledgebaseService) gets(ctx context.Context, serviceDeskID int, query string, highlight bool, start, limit int, result interface{}) (response *Response, err error) {
+
 	params := url.Values{}
 	params.Add("start", strconv.Itoa(start))
 	params.Add("limit", strconv.Itoa(limit))
@@ -66,7 +77,7 @@ func (k *KnowledgebaseService) Gets(ctx context.Context, serviceDeskID int, quer
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/knowledgebase/article?%v", serviceDeskID, params.Encode())
 
-	request, err := k.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := k.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/knowledgebase/article", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -79,8 +90,7 @@ func (k *KnowledgebaseService) Gets(ctx context.Context, serviceDeskID int, quer
 		return
 	}
 
-	result = new(ArticlePageScheme)
-	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+	if err = json.Unmarshal(response.BodyAsBytes, result); err != nil {
 		return
 	}
 
@@ -0,0 +1,343 @@
+package sm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	ArticleHighlightStartMarker = "@@@hl@@@"
+	ArticleHighlightEndMarker   = "@@@endhl@@@"
+)
+
+// ArticleHighlightSpanScheme represents a fragment of a title or excerpt returned with the highlight enabled
+type ArticleHighlightSpanScheme struct {
+	Text        string `json:"text"`
+	Highlighted bool   `json:"highlighted"`
+}
+
+type ArticleSuggestOptionsScheme struct {
+	Summary        string // The summary of the request to be raised (REQUIRED)
+	Description    string // The description of the request, the keywords are added to the search
+	ServiceDeskIDs []int  // The service desks to search, the default value is every service desk visible to the authenticated user
+	Limit          int    // The maximum number of suggestions, the default value is 5
+	PerQueryLimit  int    // The articles requested per service desk and query, the default value is 10
+}
+
+// ArticleSuggestionScheme represents an article suggested for a request, the articles found
+// on several service desks or queries are merged and their scores added.
+type ArticleSuggestionScheme struct {
+	Title          string                        `json:"title"`
+	TitleSpans     []*ArticleHighlightSpanScheme `json:"titleSpans,omitempty"`
+	Excerpt        string                        `json:"excerpt"`
+	ExcerptSpans   []*ArticleHighlightSpanScheme `json:"excerptSpans,omitempty"`
+	Link           string                        `json:"link,omitempty"`
+	SourceType     string                        `json:"sourceType,omitempty"`
+	PageID         string                        `json:"pageId,omitempty"`
+	SpaceKey       string                        `json:"spaceKey,omitempty"`
+	ServiceDeskIDs []int                         `json:"serviceDeskIds,omitempty"`
+	Score          float64                       `json:"score"`
+}
+
+// articleSuggestPageScheme is the page of articles read by the Suggest func, the source of
+// the articles contains the Confluence page used to de-duplicate them.
+type articleSuggestPageScheme struct {
+	IsLastPage bool             `json:"isLastPage"`
+	Values     []*articleScheme `json:"values"`
+}
+
+type articleScheme struct {
+	Title   string `json:"title"`
+	Excerpt string `json:"excerpt"`
+	Source  struct {
+		Type     string `json:"type"`
+		PageID   string `json:"pageId"`
+		SpaceKey string `json:"spaceKey"`
+	} `json:"source"`
+	Content struct {
+		IframeSrc string `json:"iframeSrc"`
+	} `json:"content"`
+}
+
+// ParseArticleHighlight splits a title or excerpt into spans using the @@@hl@@@ and @@@endhl@@@ markers,
+// the text after a start marker without the end marker is returned as highlighted.
+func ParseArticleHighlight(text string) (spans []*ArticleHighlightSpanScheme) {
+
+	appendSpan := func(value string, highlighted bool) {
+		if len(value) != 0 {
+			spans = append(spans, &ArticleHighlightSpanScheme{Text: value, Highlighted: highlighted})
+		}
+	}
+
+	for len(text) != 0 {
+
+		start := strings.Index(text, ArticleHighlightStartMarker)
+		if start == -1 {
+			appendSpan(text, false)
+			break
+		}
+
+		appendSpan(text[:start], false)
+		text = text[start+len(ArticleHighlightStartMarker):]
+
+		end := strings.Index(text, ArticleHighlightEndMarker)
+		if end == -1 {
+			appendSpan(text, true)
+			break
+		}
+
+		appendSpan(text[:end], true)
+		text = text[end+len(ArticleHighlightEndMarker):]
+	}
+
+	return spans
+}
+
+// articlePlainText returns the text of the spans without the highlight markers
+func articlePlainText(spans []*ArticleHighlightSpanScheme) string {
+
+	var builder strings.Builder
+	for _, span := range spans {
+		builder.WriteString(span.Text)
+	}
+
+	return builder.String()
+}
+
+// Suggest searches the knowledge base of the service desks with the summary and the description keywords
+// and returns the articles ranked by relevance, it's meant to be called before raising a request to deflect it.
+// The service desks are not resolved from the requester, the default scope is every service desk visible to the
+// authenticated user, so the ServiceDeskIDs option should be used to limit the search to the desks of the requester.
+// The articles are de-duplicated by the Confluence page, the highlighted terms are returned as spans.
+func (k *KnowledgebaseService) Suggest(ctx context.Context, opts *ArticleSuggestOptionsScheme) (result []*ArticleSuggestionScheme, err error) {
+
+	if opts == nil || len(strings.TrimSpace(opts.Summary)) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid ArticleSuggestOptionsScheme pointer with the Summary value")
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 5
+	}
+
+	perQueryLimit := opts.PerQueryLimit
+	if perQueryLimit <= 0 {
+		perQueryLimit = 10
+	}
+
+	serviceDeskIDs := opts.ServiceDeskIDs
+	if len(serviceDeskIDs) == 0 {
+
+		serviceDeskIDs, err = k.serviceDesks(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		keywords = articleKeywords(opts.Summary+" "+opts.Description, 8)
+		queries  = []string{strings.TrimSpace(opts.Summary)}
+		indexes  = make(map[string]*ArticleSuggestionScheme)
+	)
+
+	if query := strings.Join(keywords, " "); len(query) != 0 && !strings.EqualFold(query, queries[0]) {
+		queries = append(queries, query)
+	}
+
+	for _, serviceDeskID := range serviceDeskIDs {
+		for _, query := range queries {
+
+			page := new(articleSuggestPageScheme)
+			if _, err = k.gets(ctx, serviceDeskID, query, true, 0, perQueryLimit, page); err != nil {
+				return nil, fmt.Errorf("error, the knowledge base of the service desk %v can't be searched: %v", serviceDeskID, err)
+			}
+
+			for position, article := range page.Values {
+
+				var (
+					key          = articleKey(article)
+					titleSpans   = ParseArticleHighlight(article.Title)
+					excerptSpans = ParseArticleHighlight(article.Excerpt)
+				)
+
+				suggestion, ok := indexes[key]
+				if !ok {
+
+					suggestion = &ArticleSuggestionScheme{
+						Title:        articlePlainText(titleSpans),
+						TitleSpans:   titleSpans,
+						Excerpt:      articlePlainText(excerptSpans),
+						ExcerptSpans: excerptSpans,
+						Link:         article.Content.IframeSrc,
+						SourceType:   article.Source.Type,
+						PageID:       article.Source.PageID,
+						SpaceKey:     article.Source.SpaceKey,
+					}
+
+					indexes[key] = suggestion
+					result = append(result, suggestion)
+				}
+
+				if !containsInt(suggestion.ServiceDeskIDs, serviceDeskID) {
+					suggestion.ServiceDeskIDs = append(suggestion.ServiceDeskIDs, serviceDeskID)
+				}
+
+				suggestion.Score += articleScore(position, titleSpans, excerptSpans, keywords)
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Score > result[j].Score })
+
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
+// Deflect suggests the articles for the request and calls the raise func to decide if the request is still needed,
+// the create func is called when there're no suggestions or the raise func returns true.
+// It returns the suggestions and if the request was created, the create errors are returned.
+func (k *KnowledgebaseService) Deflect(ctx context.Context, opts *ArticleSuggestOptionsScheme, raise func(suggestions []*ArticleSuggestionScheme) bool,
+	create func(ctx context.Context) error) (suggestions []*ArticleSuggestionScheme, raised bool, err error) {
+
+	if raise == nil || create == nil {
+		return nil, false, fmt.Errorf("error, please provide a valid raise and create func")
+	}
+
+	suggestions, err = k.Suggest(ctx, opts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(suggestions) != 0 && !raise(suggestions) {
+		return suggestions, false, nil
+	}
+
+	if err = create(ctx); err != nil {
+		return suggestions, false, err
+	}
+
+	return suggestions, true, nil
+}
+
+// serviceDesks returns the ID of every service desk visible to the user
+func (k *KnowledgebaseService) serviceDesks(ctx context.Context) (ids []int, err error) {
+
+	service := &ServiceDeskService{client: k.client}
+
+	for start, limit := 0, 50; ; start += limit {
+
+		page, _, err := service.Gets(ctx, start, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, serviceDesk := range page.Values {
+
+			id, err := strconv.Atoi(serviceDesk.ID)
+			if err != nil {
+				return nil, fmt.Errorf("error, the service desk ID %v is not valid: %v", serviceDesk.ID, err)
+			}
+
+			ids = append(ids, id)
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			return ids, nil
+		}
+	}
+}
+
+// articleKey returns the value used to de-duplicate the articles found on several service desks
+func articleKey(article *articleScheme) string {
+
+	switch {
+	case len(article.Source.PageID) != 0:
+		return "page:" + article.Source.PageID
+	case len(article.Content.IframeSrc) != 0:
+		return "link:" + article.Content.IframeSrc
+	default:
+		return "title:" + strings.ToLower(articlePlainText(ParseArticleHighlight(article.Title)))
+	}
+}
+
+// articleScore weights the position of the article on the results, the highlighted terms
+// and the keywords found on the title.
+func articleScore(position int, titleSpans, excerptSpans []*ArticleHighlightSpanScheme, keywords []string) (score float64) {
+
+	score = 1 / float64(position+1)
+
+	for _, span := range titleSpans {
+		if span.Highlighted {
+			score += 0.5
+		}
+	}
+
+	for _, span := range excerptSpans {
+		if span.Highlighted {
+			score += 0.25
+		}
+	}
+
+	title := articleKeywords(articlePlainText(titleSpans), 0)
+	for _, keyword := range keywords {
+		for _, word := range title {
+			if word == keyword {
+				score += 0.5
+				break
+			}
+		}
+	}
+
+	return score
+}
+
+var articleStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "can": true, "cant": true, "not": true, "this": true,
+	"that": true, "from": true, "have": true, "has": true, "was": true, "are": true, "but": true, "how": true,
+	"what": true, "when": true, "where": true, "why": true, "you": true, "your": true, "our": true, "please": true,
+	"help": true, "need": true, "does": true, "doesn": true, "don": true, "any": true, "into": true, "been": true,
+}
+
+// articleKeywords returns the distinct lowercase words of the text without the stop words,
+// the words are returned in order and the max value limits them, 0 returns every word.
+func articleKeywords(text string, max int) (keywords []string) {
+
+	var (
+		words = strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsNumber(r) })
+		seen  = make(map[string]bool)
+	)
+
+	for _, word := range words {
+
+		if len([]rune(word)) < 3 || articleStopWords[word] || seen[word] {
+			continue
+		}
+
+		seen[word] = true
+		keywords = append(keywords, word)
+
+		if max > 0 && len(keywords) == max {
+			break
+		}
+	}
+
+	return keywords
+}
+
+func containsInt(values []int, value int) bool {
+
+	for _, current := range values {
+		if current == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,194 @@
+package sm
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestParseArticleHighlight(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		text string
+		want []*ArticleHighlightSpanScheme
+	}{
+		{
+			name: "ParseArticleHighlightWhenTheTextIsHighlighted",
+			text: "assuming your @@@hl@@@computer@@@endhl@@@ was @@@hl@@@stolen@@@endhl@@@",
+			want: []*ArticleHighlightSpanScheme{
+				{Text: "assuming your "},
+				{Text: "computer", Highlighted: true},
+				{Text: " was "},
+				{Text: "stolen", Highlighted: true},
+			},
+		},
+		{
+			name: "ParseArticleHighlightWhenTheTextIsNotHighlighted",
+			text: "Upgrading computer",
+			want: []*ArticleHighlightSpanScheme{{Text: "Upgrading computer"}},
+		},
+		{
+			name: "ParseArticleHighlightWhenTheEndMarkerIsMissing",
+			text: "Reset your @@@hl@@@password",
+			want: []*ArticleHighlightSpanScheme{{Text: "Reset your "}, {Text: "password", Highlighted: true}},
+		},
+		{
+			name: "ParseArticleHighlightWhenTheTextIsEmpty",
+			text: "",
+			want: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testCase.want, ParseArticleHighlight(testCase.text))
+		})
+	}
+}
+
+// startKnowledgebaseServer serves two service desks, the stolen computer article is found on both
+func startKnowledgebaseServer(t *testing.T, queries *[]string) *httptest.Server {
+
+	var (
+		mu  sync.Mutex
+		mux = http.NewServeMux()
+	)
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": [{"id": "1"}, {"id": "2"}]}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/1/knowledgebase/article", func(w http.ResponseWriter, r *http.Request) {
+
+		assert.Equal(t, "opt-in", r.Header.Get("X-ExperimentalApi"))
+		assert.Empty(t, r.URL.Query().Get("highlight"))
+
+		mu.Lock()
+		*queries = append(*queries, r.URL.Query().Get("query"))
+		mu.Unlock()
+
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": [
+			{"title": "Upgrading computer", "excerpt": "each computer older then 3 years can be upgraded",
+			 "source": {"type": "confluence", "pageId": "8785228", "spaceKey": "IT"},
+			 "content": {"iframeSrc": "https://your-domain.atlassian.net/rest/servicedeskapi/knowledgebase/article/view/8785228"}},
+			{"title": "@@@hl@@@Stolen@@@endhl@@@ computer", "excerpt": "assuming your @@@hl@@@computer@@@endhl@@@ was stolen",
+			 "source": {"type": "confluence", "pageId": "8786177", "spaceKey": "IT"},
+			 "content": {"iframeSrc": "https://your-domain.atlassian.net/rest/servicedeskapi/knowledgebase/article/view/8786177"}}
+		]}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/2/knowledgebase/article", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": [
+			{"title": "@@@hl@@@Stolen@@@endhl@@@ computer", "excerpt": "assuming your computer was stolen",
+			 "source": {"type": "confluence", "pageId": "8786177", "spaceKey": "IT"},
+			 "content": {"iframeSrc": "https://your-domain.atlassian.net/rest/servicedeskapi/knowledgebase/article/view/8786177"}}
+		]}`))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestKnowledgebaseService_Suggest(t *testing.T) {
+
+	var queries []string
+
+	mockServer := startKnowledgebaseServer(t, &queries)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &ArticleSuggestOptionsScheme{
+		Summary:     "Laptop stolen",
+		Description: "My computer was stolen at the airport, please help",
+	}
+
+	// The default scope is every service desk visible to the authenticated user, not the desks of the requester
+	suggestions, err := mockClient.Knowledgebase.Suggest(context.Background(), opts)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []string{"Laptop stolen", "laptop stolen computer airport"}, queries)
+
+	if assert.Len(t, suggestions, 2) {
+
+		assert.Equal(t, "Stolen computer", suggestions[0].Title)
+		assert.Equal(t, "8786177", suggestions[0].PageID)
+		assert.Equal(t, []int{1, 2}, suggestions[0].ServiceDeskIDs)
+		assert.Equal(t, "assuming your computer was stolen", suggestions[0].Excerpt)
+		assert.Equal(t, &ArticleHighlightSpanScheme{Text: "computer", Highlighted: true}, suggestions[0].ExcerptSpans[1])
+		assert.Equal(t, "https://your-domain.atlassian.net/rest/servicedeskapi/knowledgebase/article/view/8786177", suggestions[0].Link)
+
+		assert.Equal(t, "Upgrading computer", suggestions[1].Title)
+		assert.Equal(t, []int{1}, suggestions[1].ServiceDeskIDs)
+		assert.Greater(t, suggestions[0].Score, suggestions[1].Score)
+	}
+
+	// The service desks of the requester limit the search
+	opts.ServiceDeskIDs, opts.Limit = []int{2}, 1
+
+	suggestions, err = mockClient.Knowledgebase.Suggest(context.Background(), opts)
+	assert.NoError(t, err)
+
+	if assert.Len(t, suggestions, 1) {
+		assert.Equal(t, []int{2}, suggestions[0].ServiceDeskIDs)
+	}
+
+	_, err = mockClient.Knowledgebase.Suggest(context.Background(), &ArticleSuggestOptionsScheme{Description: "description"})
+	assert.Error(t, err)
+}
+
+func TestKnowledgebaseService_Deflect(t *testing.T) {
+
+	var queries []string
+
+	mockServer := startKnowledgebaseServer(t, &queries)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		opts    = &ArticleSuggestOptionsScheme{Summary: "Laptop stolen", ServiceDeskIDs: []int{2}}
+		created int
+		create  = func(ctx context.Context) error { created++; return nil }
+	)
+
+	// The requester found the answer on the suggested articles
+	suggestions, raised, err := mockClient.Knowledgebase.Deflect(context.Background(), opts,
+		func(suggestions []*ArticleSuggestionScheme) bool { return false }, create)
+
+	assert.NoError(t, err)
+	assert.False(t, raised)
+	assert.Len(t, suggestions, 1)
+	assert.Equal(t, 0, created)
+
+	_, raised, err = mockClient.Knowledgebase.Deflect(context.Background(), opts,
+		func(suggestions []*ArticleSuggestionScheme) bool { return true }, create)
+
+	assert.NoError(t, err)
+	assert.True(t, raised)
+	assert.Equal(t, 1, created)
+
+	_, raised, err = mockClient.Knowledgebase.Deflect(context.Background(), opts,
+		func(suggestions []*ArticleSuggestionScheme) bool { return true },
+		func(ctx context.Context) error { return errors.New("the request type is not valid") })
+
+	assert.Error(t, err)
+	assert.False(t, raised)
+
+	_, _, err = mockClient.Knowledgebase.Deflect(context.Background(), opts, nil, create)
+	assert.Error(t, err)
+}
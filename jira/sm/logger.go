@@ -0,0 +1,15 @@
+package sm
+
+import "github.com/ctreminiom/go-atlassian/internal/httplog"
+
+// Logger is implemented by *slog.Logger and the loggers with the same Debug and Error key/value methods
+type Logger = httplog.Logger
+
+// LoggerOptionsScheme configures the dump of the bodies and the redacted JSON paths,
+// e.g: &LoggerOptionsScheme{RedactPaths: []string{"values.*.emailAddress"}}
+type LoggerOptionsScheme = httplog.Options
+
+// SetLogger logs the requests of the client, it's called by the Jira client when the module is shared
+func (c *Client) SetLogger(logger Logger, options *LoggerOptionsScheme) {
+	c.logger = httplog.New(logger, options)
+}
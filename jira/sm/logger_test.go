@@ -0,0 +1,34 @@
+package sm
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/internal/httplog/httplogtest"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_SetLogger(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := &httplogtest.Recorder{}
+	mockClient.SetLogger(logger, nil)
+
+	_, _, err = mockClient.Info.Get(context.Background())
+	assert.NoError(t, err)
+
+	if entries := logger.Entries(); assert.Len(t, entries, 1) {
+		assert.Equal(t, mockServer.URL+"/rest/servicedeskapi/info", entries[0].Args["endpoint"])
+		assert.Equal(t, http.StatusOK, entries[0].Args["status"])
+	}
+}
@@ -25,7 +25,7 @@ func (o *OrganizationService) Gets(ctx context.Context, accountID string, start,
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/organization?%v", params.Encode())
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/organization", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -52,7 +52,7 @@ func (o *OrganizationService) Get(ctx context.Context, organizationID int) (resu
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/organization/%v", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/organization/{organizationID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -79,7 +79,7 @@ func (o *OrganizationService) Delete(ctx context.Context, organizationID int) (r
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/organization/%v", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/organization/{organizationID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -105,7 +105,7 @@ func (o *OrganizationService) Create(ctx context.Context, name string) (result *
 
 	var endpoint = "rest/servicedeskapi/organization"
 
-	request, err := o.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := o.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/organization", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -137,7 +137,7 @@ func (o *OrganizationService) Users(ctx context.Context, organizationID, start,
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/organization/%v/user?%v", organizationID, params.Encode())
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/organization/{organizationID}/user", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -170,7 +170,7 @@ func (o *OrganizationService) Add(ctx context.Context, organizationID int, accou
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/organization/%v/user", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := o.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/organization/{organizationID}/user", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -198,7 +198,7 @@ func (o *OrganizationService) Remove(ctx context.Context, organizationID int, ac
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/organization/%v/user", organizationID)
 
-	request, err := o.client.newRequest(ctx, http.MethodDelete, endpoint, payload)
+	request, err := o.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/organization/{organizationID}/user", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -226,7 +226,7 @@ func (o *OrganizationService) Project(ctx context.Context, accountID string, ser
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization?%v", serviceDeskPortalID, params.Encode())
 
-	request, err := o.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := o.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskPortalID}/organization", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -257,7 +257,7 @@ func (o *OrganizationService) Associate(ctx context.Context, serviceDeskPortalID
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization", serviceDeskPortalID)
 
-	request, err := o.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	request, err := o.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/servicedesk/{serviceDeskPortalID}/organization", endpoint, payload)
 	if err != nil {
 		return
 	}
@@ -283,7 +283,7 @@ func (o *OrganizationService) Detach(ctx context.Context, serviceDeskPortalID, o
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization", serviceDeskPortalID)
 
-	request, err := o.client.newRequest(ctx, http.MethodDelete, endpoint, payload)
+	request, err := o.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/servicedesk/{serviceDeskPortalID}/organization", endpoint, payload)
 	if err != nil {
 		return
 	}
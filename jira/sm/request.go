@@ -88,7 +88,7 @@ func (r *RequestService) Gets(ctx context.Context, opts *RequestGetOptionsScheme
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request?%v", params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -142,7 +142,7 @@ func (r *RequestService) Get(ctx context.Context, issueKeyOrID string, expands [
 		endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v", issueKeyOrID)
 	}
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -162,6 +162,54 @@ func (r *RequestService) Get(ctx context.Context, issueKeyOrID string, expands [
 	return
 }
 
+type CreateCustomerRequestPayloadScheme struct {
+	ServiceDeskID       string                 `json:"serviceDeskId"`
+	RequestTypeID       string                 `json:"requestTypeId"`
+	RequestFieldValues  map[string]interface{} `json:"requestFieldValues"`
+	RequestParticipants []string               `json:"requestParticipants,omitempty"`
+	RaiseOnBehalfOf     string                 `json:"raiseOnBehalfOf,omitempty"`
+	Channel             string                 `json:"channel,omitempty"`
+}
+
+// This method creates a customer request in a service desk, the request field values are the
+// fields of the request type, e.g: summary and description.
+func (r *RequestService) Create(ctx context.Context, payload *CreateCustomerRequestPayloadScheme) (result *CustomerRequestScheme, response *Response, err error) {
+
+	if payload == nil {
+		return nil, nil, fmt.Errorf("error, please provide a valid CreateCustomerRequestPayloadScheme pointer")
+	}
+
+	if len(payload.ServiceDeskID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid serviceDeskID value")
+	}
+
+	if len(payload.RequestTypeID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid requestTypeID value")
+	}
+
+	var endpoint = "rest/servicedeskapi/request"
+
+	request, err := r.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/request", endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(CustomerRequestScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
 func (r *RequestService) Subscribe(ctx context.Context, issueKeyOrID string) (response *Response, err error) {
 
 	if len(issueKeyOrID) == 0 {
@@ -170,7 +218,7 @@ func (r *RequestService) Subscribe(ctx context.Context, issueKeyOrID string) (re
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/notification", issueKeyOrID)
 
-	request, err := r.client.newRequest(ctx, http.MethodPut, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodPut, "rest/servicedeskapi/request/{issueKeyOrID}/notification", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -191,7 +239,7 @@ func (r *RequestService) Unsubscribe(ctx context.Context, issueKeyOrID string) (
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/notification", issueKeyOrID)
 
-	request, err := r.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/request/{issueKeyOrID}/notification", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -216,7 +264,7 @@ func (r *RequestService) Transitions(ctx context.Context, issueKeyOrID string, s
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/transition?%v", issueKeyOrID, params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/transition", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -262,7 +310,7 @@ func (r *RequestService) Transition(ctx context.Context, issueKeyOrID, transitio
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/transition", issueKeyOrID)
 
-	request, err := r.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := r.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/request/{issueKeyOrID}/transition", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -365,10 +413,9 @@ type CustomerRequestScheme struct {
 		} `json:"renderedValue"`
 	} `json:"requestFieldValues"`
 	CurrentStatus struct {
-		Status         string `json:"status"`
-		StatusCategory string `json:"statusCategory"`
-		StatusDate     struct {
-		} `json:"statusDate"`
+		Status         string      `json:"status"`
+		StatusCategory string      `json:"statusCategory"`
+		StatusDate     *DateScheme `json:"statusDate"`
 	} `json:"currentStatus"`
 	Status struct {
 		Size       int  `json:"size"`
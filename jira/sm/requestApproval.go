@@ -23,7 +23,7 @@ func (r *RequestApprovalService) Gets(ctx context.Context, issueKeyOrID string,
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/approval?%v", issueKeyOrID, params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/approval", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -51,7 +51,7 @@ func (r *RequestApprovalService) Get(ctx context.Context, issueKeyOrID string, a
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/approval/%v", issueKeyOrID, approvalID)
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/approval/{approvalID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -93,7 +93,7 @@ func (r *RequestApprovalService) Answer(ctx context.Context, issueKeyOrID string
 		Decision: approveAsString,
 	}
 
-	request, err := r.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := r.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/request/{issueKeyOrID}/approval/{approvalID}", endpoint, &payload)
 	if err != nil {
 		return
 	}
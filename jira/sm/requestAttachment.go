@@ -23,7 +23,7 @@ func (r *RequestAttachmentService) Gets(ctx context.Context, issueKeyOrID string
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/attachment?%v", issueKeyOrID, params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/attachment", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -63,7 +63,7 @@ func (r *RequestAttachmentService) Create(ctx context.Context, issueKeyOrID stri
 		Public:                 public,
 	}
 
-	request, err := r.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := r.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/request/{issueKeyOrID}/attachment", endpoint, &payload)
 	if err != nil {
 		return
 	}
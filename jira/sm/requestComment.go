@@ -42,7 +42,7 @@ func (r *RequestCommentService) Gets(ctx context.Context, issueKeyOrID string, p
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/comment?%v", issueKeyOrID, params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/comment", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -91,7 +91,7 @@ func (r *RequestCommentService) Get(ctx context.Context, issueKeyOrID string, co
 		endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/comment/%v", issueKeyOrID, commentID)
 	}
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/comment/{commentID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -131,7 +131,7 @@ func (r *RequestCommentService) Create(ctx context.Context, issueKeyOrID, body s
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/comment", issueKeyOrID)
 
-	request, err := r.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := r.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/request/{issueKeyOrID}/comment", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -164,7 +164,7 @@ func (r *RequestCommentService) Attachments(ctx context.Context, issueKeyOrID st
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/comment/%v/attachment?%v", issueKeyOrID, commentID, params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/comment/{commentID}/attachment", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -17,7 +17,7 @@ func (r *RequestFeedbackService) Get(ctx context.Context, requestIDOrKey string)
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/feedback", requestIDOrKey)
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{requestIDOrKey}/feedback", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -62,7 +62,7 @@ func (r *RequestFeedbackService) Post(ctx context.Context, requestIDOrKey string
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/feedback", requestIDOrKey)
 
-	request, err := r.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := r.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/request/{requestIDOrKey}/feedback", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -92,7 +92,7 @@ func (r *RequestFeedbackService) Delete(ctx context.Context, requestIDOrKey stri
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/feedback", requestIDOrKey)
 
-	request, err := r.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/request/{requestIDOrKey}/feedback", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -23,7 +23,7 @@ func (r *RequestParticipantService) Gets(ctx context.Context, issueKeyOrID strin
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/participant?%v", issueKeyOrID, params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/participant", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -61,7 +61,7 @@ func (r *RequestParticipantService) Add(ctx context.Context, issueKeyOrID string
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/participant", issueKeyOrID)
 
-	request, err := r.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := r.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/request/{issueKeyOrID}/participant", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -100,7 +100,7 @@ func (r *RequestParticipantService) Remove(ctx context.Context, issueKeyOrID str
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/participant", issueKeyOrID)
 
-	request, err := r.client.newRequest(ctx, http.MethodDelete, endpoint, &payload)
+	request, err := r.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/request/{issueKeyOrID}/participant", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -23,7 +23,7 @@ func (r *RequestSLAService) Gets(ctx context.Context, issueKeyOrID string, start
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/sla?%v", issueKeyOrID, params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/sla", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -51,7 +51,7 @@ func (r *RequestSLAService) Get(ctx context.Context, issueKeyOrID string, slaMet
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/request/%v/sla/%v", issueKeyOrID, slaMetricID)
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/request/{issueKeyOrID}/sla/{slaMetricID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -88,37 +88,31 @@ type RequestSLAPageScheme struct {
 }
 
 type RequestSLAScheme struct {
-	ID              string `json:"id"`
-	Name            string `json:"name"`
-	CompletedCycles []struct {
-		StartTime struct {
-		} `json:"startTime"`
-		StopTime struct {
-		} `json:"stopTime"`
-		Breached     bool `json:"breached"`
-		GoalDuration struct {
-		} `json:"goalDuration"`
-		ElapsedTime struct {
-		} `json:"elapsedTime"`
-		RemainingTime struct {
-		} `json:"remainingTime"`
-	} `json:"completedCycles"`
-	OngoingCycle struct {
-		StartTime struct {
-		} `json:"startTime"`
-		BreachTime struct {
-		} `json:"breachTime"`
-		Breached            bool `json:"breached"`
-		Paused              bool `json:"paused"`
-		WithinCalendarHours bool `json:"withinCalendarHours"`
-		GoalDuration        struct {
-		} `json:"goalDuration"`
-		ElapsedTime struct {
-		} `json:"elapsedTime"`
-		RemainingTime struct {
-		} `json:"remainingTime"`
-	} `json:"ongoingCycle"`
-	Links struct {
+	ID              string                            `json:"id"`
+	Name            string                            `json:"name"`
+	CompletedCycles []*RequestSLACompletedCycleScheme `json:"completedCycles"`
+	OngoingCycle    *RequestSLAOngoingCycleScheme     `json:"ongoingCycle"`
+	Links           struct {
 		Self string `json:"self"`
 	} `json:"_links"`
 }
+
+type RequestSLACompletedCycleScheme struct {
+	StartTime     *DateScheme     `json:"startTime"`
+	StopTime      *DateScheme     `json:"stopTime"`
+	Breached      bool            `json:"breached"`
+	GoalDuration  *DurationScheme `json:"goalDuration"`
+	ElapsedTime   *DurationScheme `json:"elapsedTime"`
+	RemainingTime *DurationScheme `json:"remainingTime"`
+}
+
+type RequestSLAOngoingCycleScheme struct {
+	StartTime           *DateScheme     `json:"startTime"`
+	BreachTime          *DateScheme     `json:"breachTime"`
+	Breached            bool            `json:"breached"`
+	Paused              bool            `json:"paused"`
+	WithinCalendarHours bool            `json:"withinCalendarHours"`
+	GoalDuration        *DurationScheme `json:"goalDuration"`
+	ElapsedTime         *DurationScheme `json:"elapsedTime"`
+	RemainingTime       *DurationScheme `json:"remainingTime"`
+}
@@ -0,0 +1,127 @@
+package sm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// RequestSLABreachScheme represents an ongoing SLA cycle of a request predicted to breach
+type RequestSLABreachScheme struct {
+	IssueID    string        `json:"issueId"`
+	IssueKey   string        `json:"issueKey"`
+	SLAID      string        `json:"slaId"`
+	SLAName    string        `json:"slaName"`
+	BreachTime time.Time     `json:"breachTime"`
+	Remaining  time.Duration `json:"remaining"`
+	Breached   bool          `json:"breached"`
+}
+
+type RequestSLABreachOptionsScheme struct {
+	Window          time.Duration // Returns the SLAs that breach within the window (REQUIRED)
+	IncludeBreached bool          // Includes the SLAs already breached
+	SLANames        []string      // Returns only the SLAs with the names, e.g: Time to resolution
+	Now             time.Time     // The reference time, the default value is the current time
+}
+
+// Breaching scans the requests of a queue and returns the ongoing SLAs predicted to breach within the window,
+// the SLAs are sorted by the breach time, the paused SLAs are ignored.
+// The breach time returned by Jira is used when it's available, it considers the calendar of the SLA,
+// otherwise the breach time is calculated with the remaining time, the SLAs without both values are ignored.
+func (s *ServiceDeskQueueService) Breaching(ctx context.Context, serviceDeskID, queueID int, opts *RequestSLABreachOptionsScheme) (result []*RequestSLABreachScheme, err error) {
+
+	if opts == nil || opts.Window <= 0 {
+		return nil, fmt.Errorf("error, please provide a valid RequestSLABreachOptionsScheme pointer with the Window value")
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	names := make(map[string]bool, len(opts.SLANames))
+	for _, name := range opts.SLANames {
+		names[name] = true
+	}
+
+	for start, limit := 0, 50; ; start += limit {
+
+		page, _, err := s.Issues(ctx, serviceDeskID, queueID, start, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Values {
+
+			slas, err := s.slas(ctx, issue.Key)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, sla := range slas {
+
+				cycle := sla.OngoingCycle
+				if cycle == nil || cycle.Paused || (len(names) != 0 && !names[sla.Name]) {
+					continue
+				}
+
+				// The cycles without breach time and remaining time can't be predicted
+				breachTime := cycle.BreachTime.Time()
+				if breachTime.IsZero() {
+
+					if cycle.RemainingTime == nil {
+						continue
+					}
+
+					breachTime = now.Add(cycle.RemainingTime.Duration())
+				}
+
+				remaining := breachTime.Sub(now)
+				breached := cycle.Breached || remaining <= 0
+
+				if remaining > opts.Window || (breached && !opts.IncludeBreached) {
+					continue
+				}
+
+				result = append(result, &RequestSLABreachScheme{
+					IssueID:    issue.ID,
+					IssueKey:   issue.Key,
+					SLAID:      sla.ID,
+					SLAName:    sla.Name,
+					BreachTime: breachTime,
+					Remaining:  remaining,
+					Breached:   breached,
+				})
+			}
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].BreachTime.Before(result[j].BreachTime) })
+
+	return result, nil
+}
+
+// slas returns every SLA of the request
+func (s *ServiceDeskQueueService) slas(ctx context.Context, issueKeyOrID string) (slas []*RequestSLAScheme, err error) {
+
+	service := &RequestSLAService{client: s.client}
+
+	for start, limit := 0, 50; ; start += limit {
+
+		page, _, err := service.Gets(ctx, issueKeyOrID, start, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		slas = append(slas, page.Values...)
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			return slas, nil
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package sm
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestServiceDeskQueueService_Breaching(t *testing.T) {
+
+	var (
+		now = time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC)
+		sla = func(id, name, cycle string) string {
+			return fmt.Sprintf(`{"id": "%v", "name": "%v", "ongoingCycle": %v}`, id, name, cycle)
+		}
+		epoch = func(value time.Time) int64 { return value.UnixNano() / int64(time.Millisecond) }
+	)
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/1/queue/2/issue", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Query().Get("start") == "0" {
+			_, _ = fmt.Fprint(w, `{"isLastPage": false, "values": [{"id": "10001", "key": "SD-1"}, {"id": "10002", "key": "SD-2"}]}`)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, `{"isLastPage": true, "values": [{"id": "10003", "key": "SD-3"}]}`)
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/request/SD-1/sla", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"isLastPage": true, "values": [%v, %v]}`,
+			sla("1", "Time to first response", fmt.Sprintf(`{"breachTime": {"epochMillis": %v}, "remainingTime": {"millis": 1}}`, epoch(now.Add(20*time.Minute)))),
+			sla("2", "Time to resolution", `{"remainingTime": {"millis": 7200000}}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/request/SD-2/sla", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"isLastPage": true, "values": [%v, %v]}`,
+			sla("1", "Time to first response", `{"paused": true, "remainingTime": {"millis": 60000}}`),
+			sla("2", "Time to resolution", `{"breached": true, "remainingTime": {"millis": -60000}}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/request/SD-3/sla", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"isLastPage": true, "values": [%v, {"id": "3", "name": "Closed"}, %v]}`,
+			sla("2", "Time to resolution", `{"remainingTime": {"millis": 600000}}`),
+			sla("4", "Time to approval", `{"breached": false}`))
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &ServiceDeskQueueService{client: mockClient}
+
+	testCases := []struct {
+		name    string
+		opts    *RequestSLABreachOptionsScheme
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "BreachingWhenTheWindowIsSet",
+			opts: &RequestSLABreachOptionsScheme{Window: 30 * time.Minute, Now: now},
+			want: []string{"SD-3 Time to resolution 10m0s", "SD-1 Time to first response 20m0s"},
+		},
+		{
+			name: "BreachingWhenTheBreachedSLAsAreIncluded",
+			opts: &RequestSLABreachOptionsScheme{Window: 30 * time.Minute, IncludeBreached: true, SLANames: []string{"Time to resolution"}, Now: now},
+			want: []string{"SD-2 Time to resolution -1m0s", "SD-3 Time to resolution 10m0s"},
+		},
+		{
+			name: "BreachingWhenTheCycleHasNoBreachTime",
+			opts: &RequestSLABreachOptionsScheme{Window: 30 * time.Minute, IncludeBreached: true, SLANames: []string{"Time to approval"}, Now: now},
+			want: nil,
+		},
+		{
+			name:    "BreachingWhenTheWindowIsNotProvided",
+			opts:    &RequestSLABreachOptionsScheme{},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotResult, err := service.Breaching(context.Background(), 1, 2, testCase.opts)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			var got []string
+			for _, breach := range gotResult {
+				got = append(got, fmt.Sprintf("%v %v %v", breach.IssueKey, breach.SLAName, breach.Remaining))
+			}
+
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
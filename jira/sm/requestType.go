@@ -25,7 +25,7 @@ func (r *RequestTypeService) Search(ctx context.Context, query string, start, li
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/requesttype?%v", params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/requesttype", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -67,7 +67,7 @@ func (r *RequestTypeService) Gets(ctx context.Context, serviceDeskID, groupID, s
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype?%v", serviceDeskID, params.Encode())
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -122,7 +122,7 @@ func (r *RequestTypeService) Create(ctx context.Context, serviceDeskID int, issu
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype", serviceDeskID)
 
-	request, err := r.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := r.client.newRequest(ctx, http.MethodPost, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -161,7 +161,7 @@ func (r *RequestTypeService) Get(ctx context.Context, serviceDeskID, requestType
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v", serviceDeskID, requestTypeID)
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype/{requestTypeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -193,7 +193,7 @@ func (r *RequestTypeService) Delete(ctx context.Context, serviceDeskID, requestT
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v", serviceDeskID, requestTypeID)
 
-	request, err := r.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype/{requestTypeID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -226,7 +226,7 @@ func (r *RequestTypeService) Fields(ctx context.Context, serviceDeskID, requestT
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v/field", serviceDeskID, requestTypeID)
 
-	request, err := r.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype/{requestTypeID}/field", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -246,6 +246,187 @@ func (r *RequestTypeService) Fields(ctx context.Context, serviceDeskID, requestT
 	return
 }
 
+// This method returns the request type groups of a service desk
+func (r *RequestTypeService) Groups(ctx context.Context, serviceDeskID, start, limit int) (result *RequestTypeGroupPageScheme, response *Response, err error) {
+
+	if serviceDeskID == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid serviceDeskID value")
+	}
+
+	params := url.Values{}
+	params.Add("start", strconv.Itoa(start))
+	params.Add("limit", strconv.Itoa(limit))
+
+	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttypegroup?%v", serviceDeskID, params.Encode())
+
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttypegroup", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	/*
+		This API is experimental.
+		Experimental APIs are not guaranteed to be stable within the preview period.
+	*/
+	request.Header.Set("X-ExperimentalApi", "opt-in")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RequestTypeGroupPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// This method returns the keys of all properties for a request type.
+func (r *RequestTypeService) Properties(ctx context.Context, serviceDeskID, requestTypeID int) (result *RequestTypePropertyKeysScheme, response *Response, err error) {
+
+	if serviceDeskID == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid serviceDeskID value")
+	}
+
+	if requestTypeID == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid requestTypeID value")
+	}
+
+	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v/property", serviceDeskID, requestTypeID)
+
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype/{requestTypeID}/property", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-ExperimentalApi", "opt-in")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RequestTypePropertyKeysScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// This method returns the value of the property from a request type.
+func (r *RequestTypeService) Property(ctx context.Context, serviceDeskID, requestTypeID int, propertyKey string) (result *RequestTypePropertyScheme, response *Response, err error) {
+
+	if serviceDeskID == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid serviceDeskID value")
+	}
+
+	if requestTypeID == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid requestTypeID value")
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid propertyKey value")
+	}
+
+	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v/property/%v", serviceDeskID, requestTypeID, url.PathEscape(propertyKey))
+
+	request, err := r.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype/{requestTypeID}/property/{propertyKey}", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("X-ExperimentalApi", "opt-in")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RequestTypePropertyScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// This method sets the value of a request type's property, the value has to be a valid, non-empty JSON value.
+func (r *RequestTypeService) SetProperty(ctx context.Context, serviceDeskID, requestTypeID int, propertyKey string, value interface{}) (response *Response, err error) {
+
+	if serviceDeskID == 0 {
+		return nil, fmt.Errorf("error, please provide a valid serviceDeskID value")
+	}
+
+	if requestTypeID == 0 {
+		return nil, fmt.Errorf("error, please provide a valid requestTypeID value")
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid propertyKey value")
+	}
+
+	if value == nil {
+		return nil, fmt.Errorf("error, please provide a valid value")
+	}
+
+	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v/property/%v", serviceDeskID, requestTypeID, url.PathEscape(propertyKey))
+
+	request, err := r.client.newRequest(ctx, http.MethodPut, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype/{requestTypeID}/property/{propertyKey}", endpoint, value)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-ExperimentalApi", "opt-in")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// This method removes a property from a request type.
+func (r *RequestTypeService) DeleteProperty(ctx context.Context, serviceDeskID, requestTypeID int, propertyKey string) (response *Response, err error) {
+
+	if serviceDeskID == 0 {
+		return nil, fmt.Errorf("error, please provide a valid serviceDeskID value")
+	}
+
+	if requestTypeID == 0 {
+		return nil, fmt.Errorf("error, please provide a valid requestTypeID value")
+	}
+
+	if len(propertyKey) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid propertyKey value")
+	}
+
+	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v/property/%v", serviceDeskID, requestTypeID, url.PathEscape(propertyKey))
+
+	request, err := r.client.newRequest(ctx, http.MethodDelete, "rest/servicedeskapi/servicedesk/{serviceDeskID}/requesttype/{requestTypeID}/property/{propertyKey}", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("X-ExperimentalApi", "opt-in")
+
+	response, err = r.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
 type RequestTypePageScheme struct {
 	Size       int                  `json:"size"`
 	Start      int                  `json:"start"`
@@ -276,10 +457,9 @@ type RequestTypeScheme struct {
 		} `json:"_links"`
 	} `json:"icon"`
 	Fields struct {
-		RequestTypeFields []struct {
-		} `json:"requestTypeFields"`
-		CanRaiseOnBehalfOf        bool `json:"canRaiseOnBehalfOf"`
-		CanAddRequestParticipants bool `json:"canAddRequestParticipants"`
+		RequestTypeFields         []*RequestTypeFieldScheme `json:"requestTypeFields"`
+		CanRaiseOnBehalfOf        bool                      `json:"canRaiseOnBehalfOf"`
+		CanAddRequestParticipants bool                      `json:"canAddRequestParticipants"`
 	} `json:"fields"`
 	Expands []string `json:"_expands"`
 	Links   struct {
@@ -288,32 +468,68 @@ type RequestTypeScheme struct {
 }
 
 type RequestTypeFieldsScheme struct {
-	RequestTypeFields []struct {
-		FieldID       string `json:"fieldId"`
-		Name          string `json:"name"`
-		Description   string `json:"description"`
-		Required      bool   `json:"required"`
-		DefaultValues []struct {
-			Value    string        `json:"value"`
-			Label    string        `json:"label"`
-			Children []interface{} `json:"children"`
-		} `json:"defaultValues"`
-		ValidValues []struct {
-			Value    string        `json:"value"`
-			Label    string        `json:"label"`
-			Children []interface{} `json:"children"`
-		} `json:"validValues"`
-		JiraSchema struct {
-			Type          string `json:"type"`
-			Items         string `json:"items"`
-			System        string `json:"system"`
-			Custom        string `json:"custom"`
-			CustomID      int    `json:"customId"`
-			Configuration struct {
-			} `json:"configuration"`
-		} `json:"jiraSchema"`
-		Visible bool `json:"visible"`
-	} `json:"requestTypeFields"`
-	CanRaiseOnBehalfOf        bool `json:"canRaiseOnBehalfOf"`
-	CanAddRequestParticipants bool `json:"canAddRequestParticipants"`
+	RequestTypeFields         []*RequestTypeFieldScheme `json:"requestTypeFields"`
+	CanRaiseOnBehalfOf        bool                      `json:"canRaiseOnBehalfOf"`
+	CanAddRequestParticipants bool                      `json:"canAddRequestParticipants"`
+}
+
+type RequestTypeFieldScheme struct {
+	FieldID       string                         `json:"fieldId"`
+	Name          string                         `json:"name"`
+	Description   string                         `json:"description"`
+	Required      bool                           `json:"required"`
+	DefaultValues []*RequestTypeFieldValueScheme `json:"defaultValues"`
+	ValidValues   []*RequestTypeFieldValueScheme `json:"validValues"`
+	PresetValues  []string                       `json:"presetValues"`
+	JiraSchema    *RequestTypeJiraSchema         `json:"jiraSchema"`
+	Visible       bool                           `json:"visible"`
+}
+
+// RequestTypeFieldValueScheme represents a value of a field, the cascading select fields contain the child values
+type RequestTypeFieldValueScheme struct {
+	Value    string                         `json:"value"`
+	Label    string                         `json:"label"`
+	Children []*RequestTypeFieldValueScheme `json:"children"`
+}
+
+type RequestTypeJiraSchema struct {
+	Type          string                 `json:"type"`
+	Items         string                 `json:"items"`
+	System        string                 `json:"system"`
+	Custom        string                 `json:"custom"`
+	CustomID      int                    `json:"customId"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type RequestTypeGroupPageScheme struct {
+	Size       int                       `json:"size"`
+	Start      int                       `json:"start"`
+	Limit      int                       `json:"limit"`
+	IsLastPage bool                      `json:"isLastPage"`
+	Values     []*RequestTypeGroupScheme `json:"values"`
+	Expands    []string                  `json:"_expands"`
+	Links      struct {
+		Self    string `json:"self"`
+		Base    string `json:"base"`
+		Context string `json:"context"`
+		Next    string `json:"next"`
+		Prev    string `json:"prev"`
+	} `json:"_links"`
+}
+
+type RequestTypeGroupScheme struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type RequestTypePropertyKeysScheme struct {
+	Keys []struct {
+		Self string `json:"self"`
+		Key  string `json:"key"`
+	} `json:"keys"`
+}
+
+type RequestTypePropertyScheme struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
 }
@@ -0,0 +1,135 @@
+package sm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// RequestTypePortalScheme represents the request types of a service desk as they're shown on the portal,
+// a request type on several groups is shared by the groups.
+type RequestTypePortalScheme struct {
+	ServiceDeskID int                                   `json:"serviceDeskId"`
+	Groups        []*RequestTypePortalGroupScheme       `json:"groups"`
+	Ungrouped     []*RequestTypePortalRequestTypeScheme `json:"ungrouped,omitempty"`
+}
+
+type RequestTypePortalGroupScheme struct {
+	ID           string                                `json:"id"`
+	Name         string                                `json:"name"`
+	RequestTypes []*RequestTypePortalRequestTypeScheme `json:"requestTypes"`
+}
+
+// RequestTypePortalRequestTypeScheme contains a request type and the fields of its form
+type RequestTypePortalRequestTypeScheme struct {
+	ID                        string                    `json:"id"`
+	Name                      string                    `json:"name"`
+	Description               string                    `json:"description,omitempty"`
+	HelpText                  string                    `json:"helpText,omitempty"`
+	IssueTypeID               string                    `json:"issueTypeId"`
+	Fields                    []*RequestTypeFieldScheme `json:"fields"`
+	CanRaiseOnBehalfOf        bool                      `json:"canRaiseOnBehalfOf"`
+	CanAddRequestParticipants bool                      `json:"canAddRequestParticipants"`
+}
+
+// Portal reads the request type groups, the request types and their fields of a service desk
+// and returns them as a single model, e.g: to render the portal forms on another UI.
+func (r *RequestTypeService) Portal(ctx context.Context, serviceDeskID int) (result *RequestTypePortalScheme, err error) {
+
+	if serviceDeskID == 0 {
+		return nil, fmt.Errorf("error, please provide a valid serviceDeskID value")
+	}
+
+	result = &RequestTypePortalScheme{ServiceDeskID: serviceDeskID}
+	groups := make(map[string]*RequestTypePortalGroupScheme)
+
+	for start, limit := 0, 50; ; start += limit {
+
+		page, _, err := r.Groups(ctx, serviceDeskID, start, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range page.Values {
+
+			portalGroup := &RequestTypePortalGroupScheme{ID: group.ID, Name: group.Name}
+
+			groups[group.ID] = portalGroup
+			result.Groups = append(result.Groups, portalGroup)
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	for start, limit := 0, 50; ; start += limit {
+
+		page, _, err := r.Gets(ctx, serviceDeskID, 0, start, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, requestType := range page.Values {
+
+			requestTypeID, err := strconv.Atoi(requestType.ID)
+			if err != nil {
+				return nil, fmt.Errorf("error, the request type ID %v is not valid: %v", requestType.ID, err)
+			}
+
+			fields, _, err := r.Fields(ctx, serviceDeskID, requestTypeID)
+			if err != nil {
+				return nil, fmt.Errorf("error, the fields of the request type %v can't be read: %v", requestType.ID, err)
+			}
+
+			portalRequestType := &RequestTypePortalRequestTypeScheme{
+				ID:                        requestType.ID,
+				Name:                      requestType.Name,
+				Description:               requestType.Description,
+				HelpText:                  requestType.HelpText,
+				IssueTypeID:               requestType.IssueTypeID,
+				Fields:                    fields.RequestTypeFields,
+				CanRaiseOnBehalfOf:        fields.CanRaiseOnBehalfOf,
+				CanAddRequestParticipants: fields.CanAddRequestParticipants,
+			}
+
+			var grouped bool
+			for _, groupID := range requestType.GroupIds {
+
+				if group, ok := groups[groupID]; ok {
+					group.RequestTypes = append(group.RequestTypes, portalRequestType)
+					grouped = true
+				}
+			}
+
+			if !grouped {
+				result.Ungrouped = append(result.Ungrouped, portalRequestType)
+			}
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			return result, nil
+		}
+	}
+}
+
+// ValidValue returns the valid value of the field, the child values of the cascading fields are included
+func (f *RequestTypeFieldScheme) ValidValue(value string) *RequestTypeFieldValueScheme {
+	return findRequestTypeFieldValue(f.ValidValues, value)
+}
+
+func findRequestTypeFieldValue(values []*RequestTypeFieldValueScheme, value string) *RequestTypeFieldValueScheme {
+
+	for _, current := range values {
+
+		if current.Value == value {
+			return current
+		}
+
+		if child := findRequestTypeFieldValue(current.Children, value); child != nil {
+			return child
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package sm
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func startRequestTypePortalServer() *httptest.Server {
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/1/requesttypegroup", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": [{"id": "12", "name": "Common Requests"}, {"id": "13", "name": "Hardware"}]}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/1/requesttype", func(w http.ResponseWriter, r *http.Request) {
+
+		if r.URL.Query().Get("start") == "0" {
+			_, _ = w.Write([]byte(`{"isLastPage": false, "values": [
+				{"id": "1", "name": "Get IT help", "issueTypeId": "10001", "groupIds": ["12"]},
+				{"id": "2", "name": "Request a laptop", "issueTypeId": "10002", "groupIds": ["12", "13"]}
+			]}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": [{"id": "3", "name": "Hidden request", "issueTypeId": "10003"}]}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/1/requesttype/2/field", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"canRaiseOnBehalfOf": true, "requestTypeFields": [
+			{"fieldId": "summary", "name": "Summary", "required": true, "visible": true, "jiraSchema": {"type": "string", "system": "summary"}},
+			{"fieldId": "customfield_10010", "name": "Model", "required": true, "visible": true,
+			 "jiraSchema": {"type": "option-with-child", "custom": "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect", "customId": 10010},
+			 "validValues": [
+				{"value": "10100", "label": "Apple", "children": [{"value": "10101", "label": "MacBook Pro", "children": []}]},
+				{"value": "10200", "label": "Lenovo", "children": [{"value": "10201", "label": "ThinkPad", "children": []}]}
+			 ]}
+		]}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/servicedesk/1/requesttype/", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"requestTypeFields": [{"fieldId": "summary", "name": "Summary", "required": true, "visible": true}]}`))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestRequestTypeService_Portal(t *testing.T) {
+
+	mockServer := startRequestTypePortalServer()
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	portal, err := mockClient.RequestType.Portal(context.Background(), 1)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	if assert.Len(t, portal.Groups, 2) {
+
+		assert.Equal(t, "Common Requests", portal.Groups[0].Name)
+		assert.Len(t, portal.Groups[0].RequestTypes, 2)
+
+		// The request types on several groups are shared
+		if assert.Len(t, portal.Groups[1].RequestTypes, 1) {
+			assert.Same(t, portal.Groups[0].RequestTypes[1], portal.Groups[1].RequestTypes[0])
+		}
+	}
+
+	if assert.Len(t, portal.Ungrouped, 1) {
+		assert.Equal(t, "Hidden request", portal.Ungrouped[0].Name)
+	}
+
+	laptop := portal.Groups[1].RequestTypes[0]
+	assert.True(t, laptop.CanRaiseOnBehalfOf)
+
+	if assert.Len(t, laptop.Fields, 2) {
+
+		model := laptop.Fields[1]
+		assert.Equal(t, "option-with-child", model.JiraSchema.Type)
+		assert.Equal(t, "ThinkPad", model.ValidValue("10201").Label)
+		assert.Equal(t, "Apple", model.ValidValue("10100").Label)
+		assert.Nil(t, model.ValidValue("99999"))
+	}
+
+	_, err = mockClient.RequestType.Portal(context.Background(), 0)
+	assert.Error(t, err)
+
+	_, err = mockClient.RequestType.Portal(context.Background(), 2)
+	assert.Error(t, err)
+}
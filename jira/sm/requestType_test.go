@@ -1007,3 +1007,692 @@ func TestRequestTypeService_Gets(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestTypeService_Groups(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		serviceDeskID      int
+		start, limit       int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetRequestTypeGroupsWhenTheParametersAreCorrect",
+			serviceDeskID:      1,
+			start:              0,
+			limit:              50,
+			mockFile:           "./mocks/get-request-type-groups.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttypegroup?limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetRequestTypeGroupsWhenTheServiceDeskIDIsNotSet",
+			start:              0,
+			limit:              50,
+			mockFile:           "./mocks/get-request-type-groups.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttypegroup?limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypeGroupsWhenTheRequestMethodIsIncorrect",
+			serviceDeskID:      1,
+			start:              0,
+			limit:              50,
+			mockFile:           "./mocks/get-request-type-groups.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttypegroup?limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypeGroupsWhenTheStatusCodeIsIncorrect",
+			serviceDeskID:      1,
+			start:              0,
+			limit:              50,
+			mockFile:           "./mocks/get-request-type-groups.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttypegroup?limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypeGroupsWhenTheContextIsNil",
+			serviceDeskID:      1,
+			start:              0,
+			limit:              50,
+			mockFile:           "./mocks/get-request-type-groups.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttypegroup?limit=50&start=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypeGroupsWhenTheResponseBodyHasADifferentFormat",
+			serviceDeskID:      1,
+			start:              0,
+			limit:              50,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttypegroup?limit=50&start=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &RequestTypeService{client: mockClient}
+			gotResult, gotResponse, err := service.Groups(testCase.context, testCase.serviceDeskID, testCase.start, testCase.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				for _, group := range gotResult.Values {
+					t.Log(group.ID, group.Name)
+				}
+			}
+
+		})
+	}
+}
+
+func TestRequestTypeService_Properties(t *testing.T) {
+
+	testCases := []struct {
+		name                         string
+		serviceDeskID, requestTypeID int
+		mockFile                     string
+		wantHTTPMethod               string
+		endpoint                     string
+		context                      context.Context
+		wantHTTPCodeReturn           int
+		wantErr                      bool
+	}{
+		{
+			name:               "GetRequestTypePropertiesWhenTheParametersAreCorrect",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			mockFile:           "./mocks/get-request-type-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetRequestTypePropertiesWhenTheServiceDeskIDIsNotSet",
+			requestTypeID:      1,
+			mockFile:           "./mocks/get-request-type-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypePropertiesWhenTheRequestTypeIDIsNotSet",
+			serviceDeskID:      1,
+			mockFile:           "./mocks/get-request-type-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypePropertiesWhenTheStatusCodeIsIncorrect",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			mockFile:           "./mocks/get-request-type-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypePropertiesWhenTheContextIsNil",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			mockFile:           "./mocks/get-request-type-property-keys.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypePropertiesWhenTheResponseBodyHasADifferentFormat",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &RequestTypeService{client: mockClient}
+			gotResult, gotResponse, err := service.Properties(testCase.context, testCase.serviceDeskID, testCase.requestTypeID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				for _, key := range gotResult.Keys {
+					t.Log(key.Key, key.Self)
+				}
+			}
+
+		})
+	}
+}
+
+func TestRequestTypeService_Property(t *testing.T) {
+
+	testCases := []struct {
+		name                         string
+		serviceDeskID, requestTypeID int
+		propertyKey                  string
+		mockFile                     string
+		wantHTTPMethod               string
+		endpoint                     string
+		context                      context.Context
+		wantHTTPCodeReturn           int
+		wantErr                      bool
+	}{
+		{
+			name:               "GetRequestTypePropertyWhenTheParametersAreCorrect",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			mockFile:           "./mocks/get-request-type-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetRequestTypePropertyWhenTheServiceDeskIDIsNotSet",
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			mockFile:           "./mocks/get-request-type-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypePropertyWhenTheRequestTypeIDIsNotSet",
+			serviceDeskID:      1,
+			propertyKey:        "propertyKey",
+			mockFile:           "./mocks/get-request-type-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypePropertyWhenThePropertyKeyIsNotSet",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			mockFile:           "./mocks/get-request-type-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypePropertyWhenTheStatusCodeIsIncorrect",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			mockFile:           "./mocks/get-request-type-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNotFound,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetRequestTypePropertyWhenTheContextIsNil",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			mockFile:           "./mocks/get-request-type-property.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &RequestTypeService{client: mockClient}
+			gotResult, gotResponse, err := service.Property(testCase.context, testCase.serviceDeskID, testCase.requestTypeID, testCase.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, apiEndpoint.Path)
+				assert.Equal(t, testCase.endpoint, apiEndpoint.Path)
+
+				assert.Equal(t, "propertyKey", gotResult.Key)
+				assert.Equal(t, map[string]interface{}{"supportTeam": "tier-2", "deflection": true}, gotResult.Value)
+			}
+
+		})
+	}
+}
+
+func TestRequestTypeService_SetProperty(t *testing.T) {
+
+	testCases := []struct {
+		name                         string
+		serviceDeskID, requestTypeID int
+		propertyKey                  string
+		value                        interface{}
+		wantHTTPMethod               string
+		endpoint                     string
+		context                      context.Context
+		wantHTTPCodeReturn           int
+		wantErr                      bool
+	}{
+		{
+			name:               "SetRequestTypePropertyWhenTheParametersAreCorrect",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			value:              map[string]interface{}{"supportTeam": "tier-2"},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:               "SetRequestTypePropertyWhenThePropertyKeyIsNotSet",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			value:              map[string]interface{}{"supportTeam": "tier-2"},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetRequestTypePropertyWhenTheValueIsNotSet",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetRequestTypePropertyWhenTheServiceDeskIDIsNotSet",
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			value:              map[string]interface{}{"supportTeam": "tier-2"},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "SetRequestTypePropertyWhenTheStatusCodeIsIncorrect",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			value:              map[string]interface{}{"supportTeam": "tier-2"},
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &RequestTypeService{client: mockClient}
+			gotResponse, err := service.SetProperty(testCase.context, testCase.serviceDeskID, testCase.requestTypeID, testCase.propertyKey, testCase.value)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+
+		})
+	}
+}
+
+func TestRequestTypeService_DeleteProperty(t *testing.T) {
+
+	testCases := []struct {
+		name                         string
+		serviceDeskID, requestTypeID int
+		propertyKey                  string
+		wantHTTPMethod               string
+		endpoint                     string
+		context                      context.Context
+		wantHTTPCodeReturn           int
+		wantErr                      bool
+	}{
+		{
+			name:               "DeleteRequestTypePropertyWhenTheParametersAreCorrect",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteRequestTypePropertyWhenThePropertyKeyIsNotSet",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRequestTypePropertyWhenTheRequestMethodIsIncorrect",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteRequestTypePropertyWhenTheContextIsNil",
+			serviceDeskID:      1,
+			requestTypeID:      1,
+			propertyKey:        "propertyKey",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/servicedeskapi/servicedesk/1/requesttype/1/property/propertyKey",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusNoContent,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &RequestTypeService{client: mockClient}
+			gotResponse, err := service.DeleteProperty(testCase.context, testCase.serviceDeskID, testCase.requestTypeID, testCase.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+
+		})
+	}
+}
@@ -379,6 +379,191 @@ func TestRequestService_Get(t *testing.T) {
 
 }
 
+func TestRequestService_Create(t *testing.T) {
+
+	payload := &CreateCustomerRequestPayloadScheme{
+		ServiceDeskID: "1",
+		RequestTypeID: "25",
+		RequestFieldValues: map[string]interface{}{
+			"summary":     "Request JSD help via REST",
+			"description": "I need a new *mouse* for my Mac",
+		},
+		RequestParticipants: []string{"qm:a713c8ea-1075-4e30-9d96-891a7d181739:5ad6d3581db05e2a66fa80b"},
+		RaiseOnBehalfOf:     "qm:a713c8ea-1075-4e30-9d96-891a7d181739:5ad6d3a01db05e2a66fa80bd",
+	}
+
+	testCases := []struct {
+		name               string
+		payload            *CreateCustomerRequestPayloadScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "CreateCustomerRequestWhenTheParametersAreCorrect",
+			payload:            payload,
+			mockFile:           "./mocks/get-customer-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/servicedeskapi/request",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateCustomerRequestWhenThePayloadIsNil",
+			payload:            nil,
+			mockFile:           "./mocks/get-customer-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/servicedeskapi/request",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateCustomerRequestWhenTheServiceDeskIDIsNotSet",
+			payload:            &CreateCustomerRequestPayloadScheme{RequestTypeID: "25"},
+			mockFile:           "./mocks/get-customer-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/servicedeskapi/request",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateCustomerRequestWhenTheRequestTypeIDIsNotSet",
+			payload:            &CreateCustomerRequestPayloadScheme{ServiceDeskID: "1"},
+			mockFile:           "./mocks/get-customer-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/servicedeskapi/request",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateCustomerRequestWhenTheRequestMethodIsIncorrect",
+			payload:            payload,
+			mockFile:           "./mocks/get-customer-request.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/servicedeskapi/request",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateCustomerRequestWhenTheStatusCodeIsIncorrect",
+			payload:            payload,
+			mockFile:           "./mocks/get-customer-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/servicedeskapi/request",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateCustomerRequestWhenTheContextIsNil",
+			payload:            payload,
+			mockFile:           "./mocks/get-customer-request.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/servicedeskapi/request",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateCustomerRequestWhenTheResponseBodyHasADifferentFormat",
+			payload:            payload,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/servicedeskapi/request",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &RequestService{client: mockClient}
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+				assert.Error(t, err)
+
+				if gotResponse != nil {
+					t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				}
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+
+				t.Log("-------------------------------------------")
+				t.Logf("Custom Request Issue Key: %v", gotResult.IssueKey)
+				t.Logf("Custom Request Type Name: %v", gotResult.RequestType.Name)
+				t.Log("-------------------------------------------")
+			}
+
+		})
+	}
+
+}
+
 func TestRequestService_Subscribe(t *testing.T) {
 
 	testCases := []struct {
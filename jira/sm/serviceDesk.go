@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/internal/route"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -27,7 +28,7 @@ func (s *ServiceDeskService) Gets(ctx context.Context, start, limit int) (result
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk?%v", params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -51,7 +52,7 @@ func (s *ServiceDeskService) Get(ctx context.Context, serviceDeskID int) (result
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v", serviceDeskID)
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -105,7 +106,7 @@ func (s *ServiceDeskService) Attach(ctx context.Context, serviceDeskID int, path
 	}
 
 	var endpoint = fmt.Sprintf("%vrest/servicedeskapi/servicedesk/%v/attachTemporaryFile", s.client.Site.String(), serviceDeskID)
-	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, payload)
+	request, err := http.NewRequestWithContext(route.With(ctx, "rest/servicedeskapi/servicedesk/{serviceDeskID}/attachTemporaryFile"), http.MethodPost, endpoint, payload)
 	if err != nil {
 		return
 	}
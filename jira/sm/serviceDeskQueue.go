@@ -23,7 +23,7 @@ func (s *ServiceDeskQueueService) Gets(ctx context.Context, serviceDeskID int, i
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/queue?%v", serviceDeskID, params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/queue", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -57,7 +57,7 @@ func (s *ServiceDeskQueueService) Get(ctx context.Context, serviceDeskID, queueI
 		endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/queue/%v", serviceDeskID, queueID)
 	}
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/queue/{queueID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -85,7 +85,7 @@ func (s *ServiceDeskQueueService) Issues(ctx context.Context, serviceDeskID, que
 
 	var endpoint = fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/queue/%v/issue?%v", serviceDeskID, queueID, params.Encode())
 
-	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := s.client.newRequest(ctx, http.MethodGet, "rest/servicedeskapi/servicedesk/{serviceDeskID}/queue/{queueID}/issue", endpoint, nil)
 	if err != nil {
 		return
 	}
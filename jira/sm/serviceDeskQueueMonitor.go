@@ -0,0 +1,242 @@
+package sm
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	QueueIssueEnteredEvent       = "issue_entered"
+	QueueIssueLeftEvent          = "issue_left"
+	QueueThresholdExceededEvent  = "threshold_exceeded"
+	QueueThresholdRecoveredEvent = "threshold_recovered"
+)
+
+type QueueMonitorOptionsScheme struct {
+	Queues      []*QueueMonitorQueueScheme // The queues to watch (REQUIRED)
+	Interval    time.Duration              // The time between polls, the default value is 1 minute
+	MaxBackoff  time.Duration              // The maximum time between polls when the requests fail, the default value is 10 times the interval
+	EmitInitial bool                       // Emits an issue_entered event for the issues found on the first poll
+	OnError     func(err error)            // Called with the poll errors, the monitor continues after the backoff
+}
+
+type QueueMonitorQueueScheme struct {
+	ServiceDeskID int
+	QueueID       int
+	Threshold     int // Emits the threshold events when the count crosses the value, 0 disables them
+}
+
+// QueueEventScheme represents a change between two snapshots of a queue
+type QueueEventScheme struct {
+	Type          string    `json:"type"`
+	ServiceDeskID int       `json:"serviceDeskId"`
+	QueueID       int       `json:"queueId"`
+	IssueID       string    `json:"issueId,omitempty"`
+	IssueKey      string    `json:"issueKey,omitempty"`
+	Count         int       `json:"count"`
+	PreviousCount int       `json:"previousCount"`
+	Threshold     int       `json:"threshold,omitempty"`
+	Time          time.Time `json:"time"`
+}
+
+type queueSnapshot struct {
+	issues map[string]string // issue key -> issue ID
+	order  []string
+}
+
+// Watch polls the queues and calls the callback with the changes between the snapshots,
+// it returns the context error when the context is cancelled.
+// The errors are sent to the OnError option and the next poll is delayed with an exponential backoff.
+func (s *ServiceDeskQueueService) Watch(ctx context.Context, opts *QueueMonitorOptionsScheme, callback func(event *QueueEventScheme)) error {
+
+	if err := validateQueueMonitorOptions(opts); err != nil {
+		return err
+	}
+
+	if callback == nil {
+		return fmt.Errorf("error, please provide a valid callback value")
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	maxBackoff := opts.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 10 * interval
+	}
+
+	var (
+		snapshots = make(map[*QueueMonitorQueueScheme]*queueSnapshot)
+		wait      = interval
+	)
+
+	for {
+
+		var failed bool
+		for _, queue := range opts.Queues {
+
+			current, err := s.snapshot(ctx, queue.ServiceDeskID, queue.QueueID)
+			if err != nil {
+
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				failed = true
+				if opts.OnError != nil {
+					opts.OnError(fmt.Errorf("error, the queue %v of the service desk %v can't be read: %v", queue.QueueID, queue.ServiceDeskID, err))
+				}
+
+				continue
+			}
+
+			previous, watched := snapshots[queue]
+			snapshots[queue] = current
+
+			if !watched {
+				previous = &queueSnapshot{issues: map[string]string{}}
+			}
+
+			for _, event := range diffQueueSnapshots(queue, previous, current, watched || opts.EmitInitial) {
+				callback(event)
+			}
+		}
+
+		if failed {
+			if wait *= 2; wait > maxBackoff {
+				wait = maxBackoff
+			}
+		} else {
+			wait = interval
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Events watches the queues and sends the changes to the returned channel, the options are validated before the
+// queues are watched, so the channel is only closed when the context is cancelled.
+// The poll errors are sent to the OnError option.
+func (s *ServiceDeskQueueService) Events(ctx context.Context, opts *QueueMonitorOptionsScheme) (<-chan *QueueEventScheme, error) {
+
+	if err := validateQueueMonitorOptions(opts); err != nil {
+		return nil, err
+	}
+
+	events := make(chan *QueueEventScheme)
+
+	go func() {
+		defer close(events)
+
+		// The options are valid, Watch only returns the context error
+		_ = s.Watch(ctx, opts, func(event *QueueEventScheme) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		})
+	}()
+
+	return events, nil
+}
+
+func validateQueueMonitorOptions(opts *QueueMonitorOptionsScheme) error {
+
+	if opts == nil || len(opts.Queues) == 0 {
+		return fmt.Errorf("error, please provide a valid QueueMonitorOptionsScheme pointer with the queues to watch")
+	}
+
+	return nil
+}
+
+func (s *ServiceDeskQueueService) snapshot(ctx context.Context, serviceDeskID, queueID int) (snapshot *queueSnapshot, err error) {
+
+	snapshot = &queueSnapshot{issues: make(map[string]string)}
+
+	for start, limit := 0, 50; ; start += limit {
+
+		page, _, err := s.Issues(ctx, serviceDeskID, queueID, start, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Values {
+
+			if _, ok := snapshot.issues[issue.Key]; ok {
+				continue
+			}
+
+			snapshot.issues[issue.Key] = issue.ID
+			snapshot.order = append(snapshot.order, issue.Key)
+		}
+
+		if page.IsLastPage || len(page.Values) == 0 {
+			return snapshot, nil
+		}
+	}
+}
+
+func diffQueueSnapshots(queue *QueueMonitorQueueScheme, previous, current *queueSnapshot, emitMembership bool) (events []*QueueEventScheme) {
+
+	var (
+		now           = time.Now()
+		count         = len(current.issues)
+		previousCount = len(previous.issues)
+	)
+
+	newEvent := func(eventType, issueKey, issueID string) *QueueEventScheme {
+		return &QueueEventScheme{
+			Type:          eventType,
+			ServiceDeskID: queue.ServiceDeskID,
+			QueueID:       queue.QueueID,
+			IssueID:       issueID,
+			IssueKey:      issueKey,
+			Count:         count,
+			PreviousCount: previousCount,
+			Time:          now,
+		}
+	}
+
+	if emitMembership {
+
+		for _, key := range current.order {
+			if _, ok := previous.issues[key]; !ok {
+				events = append(events, newEvent(QueueIssueEnteredEvent, key, current.issues[key]))
+			}
+		}
+
+		for _, key := range previous.order {
+			if _, ok := current.issues[key]; !ok {
+				events = append(events, newEvent(QueueIssueLeftEvent, key, previous.issues[key]))
+			}
+		}
+	}
+
+	if queue.Threshold > 0 {
+
+		var event *QueueEventScheme
+
+		switch {
+		case previousCount <= queue.Threshold && count > queue.Threshold:
+			event = newEvent(QueueThresholdExceededEvent, "", "")
+		case previousCount > queue.Threshold && count <= queue.Threshold:
+			event = newEvent(QueueThresholdRecoveredEvent, "", "")
+		}
+
+		if event != nil {
+			event.Threshold = queue.Threshold
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
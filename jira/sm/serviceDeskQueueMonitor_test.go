@@ -0,0 +1,158 @@
+package sm
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeQueue returns the snapshots in order, the snapshot "error" fails the request
+type fakeQueue struct {
+	mu        sync.Mutex
+	snapshots [][]string
+	polls     int
+}
+
+func (f *fakeQueue) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	index := f.polls
+	if index >= len(f.snapshots) {
+		index = len(f.snapshots) - 1
+	}
+
+	f.polls++
+	snapshot := f.snapshots[index]
+
+	if len(snapshot) == 1 && snapshot[0] == "error" {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var values []string
+	for _, key := range snapshot {
+		values = append(values, fmt.Sprintf(`{"id": "%v", "key": "%v"}`, strings.TrimPrefix(key, "SD-"), key))
+	}
+
+	_, _ = fmt.Fprintf(w, `{"isLastPage": true, "values": [%v]}`, strings.Join(values, ","))
+}
+
+func TestServiceDeskQueueService_Watch(t *testing.T) {
+
+	queue := &fakeQueue{snapshots: [][]string{
+		{"SD-1", "SD-2"},
+		{"SD-1", "SD-2", "SD-3"},
+		{"error"},
+		{"SD-3"},
+	}}
+
+	mux := http.NewServeMux()
+	mux.Handle("/rest/servicedeskapi/servicedesk/1/queue/2/issue", queue)
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &ServiceDeskQueueService{client: mockClient}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		got    []string
+		errors int
+	)
+
+	opts := &QueueMonitorOptionsScheme{
+		Queues:     []*QueueMonitorQueueScheme{{ServiceDeskID: 1, QueueID: 2, Threshold: 2}},
+		Interval:   time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+		OnError:    func(err error) { errors++ },
+	}
+
+	err = service.Watch(ctx, opts, func(event *QueueEventScheme) {
+
+		got = append(got, fmt.Sprintf("%v %v %v->%v", event.Type, event.IssueKey, event.PreviousCount, event.Count))
+
+		if event.Type == QueueThresholdRecoveredEvent {
+			cancel()
+		}
+	})
+
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, errors)
+	assert.Equal(t, []string{
+		"issue_entered SD-3 2->3",
+		"threshold_exceeded  2->3",
+		"issue_left SD-1 3->1",
+		"issue_left SD-2 3->1",
+		"threshold_recovered  3->1",
+	}, got)
+}
+
+func TestServiceDeskQueueService_Events(t *testing.T) {
+
+	queue := &fakeQueue{snapshots: [][]string{{"SD-1"}, {"SD-2"}}}
+
+	mux := http.NewServeMux()
+	mux.Handle("/rest/servicedeskapi/servicedesk/1/queue/2/issue", queue)
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	mockClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := &ServiceDeskQueueService{client: mockClient}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	opts := &QueueMonitorOptionsScheme{
+		Queues:      []*QueueMonitorQueueScheme{{ServiceDeskID: 1, QueueID: 2}},
+		Interval:    time.Millisecond,
+		EmitInitial: true,
+	}
+
+	events, err := service.Events(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for event := range events {
+
+		got = append(got, event.Type+" "+event.IssueKey)
+
+		if len(got) == 3 {
+			cancel()
+		}
+	}
+
+	assert.Equal(t, []string{"issue_entered SD-1", "issue_entered SD-2", "issue_left SD-1"}, got)
+
+	// The options are validated before the channel is returned
+	events, err = service.Events(context.Background(), &QueueMonitorOptionsScheme{})
+	assert.Error(t, err)
+	assert.Nil(t, events)
+
+	err = service.Watch(context.Background(), nil, func(*QueueEventScheme) {})
+	assert.Error(t, err)
+
+	err = service.Watch(context.Background(), opts, nil)
+	assert.Error(t, err)
+}
@@ -6,6 +6,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/cache"
+	"github.com/ctreminiom/go-atlassian/internal/httplog"
+	"github.com/ctreminiom/go-atlassian/internal/instrument"
+	"github.com/ctreminiom/go-atlassian/internal/route"
+	"github.com/ctreminiom/go-atlassian/ratelimit"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -17,6 +22,11 @@ type Client struct {
 	HTTP *http.Client
 	Site *url.URL
 
+	logger          *httplog.Hook
+	instrumentation *instrument.Hook
+	limiter         *ratelimit.Limiter
+	cache           *cache.Cache
+
 	Auth          *AuthenticationService
 	Customer      *CustomerService
 	Info          *InfoService
@@ -27,8 +37,11 @@ type Client struct {
 	ServiceDesk   *ServiceDeskService
 }
 
+// ClientOption configures the client created by New, e.g: WithInstrumentation
+type ClientOption func(*Client)
+
 //New
-func New(httpClient *http.Client, site string) (client *Client, err error) {
+func New(httpClient *http.Client, site string, options ...ClientOption) (client *Client, err error) {
 
 	if httpClient == nil {
 		httpClient = http.DefaultClient
@@ -71,10 +84,14 @@ func New(httpClient *http.Client, site string) (client *Client, err error) {
 		Queue:  &ServiceDeskQueueService{client: client},
 	}
 
+	for _, option := range options {
+		option(client)
+	}
+
 	return
 }
 
-func (c *Client) newRequest(ctx context.Context, method, urlAsString string, payload interface{}) (request *http.Request, err error) {
+func (c *Client) newRequest(ctx context.Context, method, template, urlAsString string, payload interface{}) (request *http.Request, err error) {
 
 	if ctx == nil {
 		return nil, errors.New("the context param is nil, please provide a valid one")
@@ -96,7 +113,7 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 		}
 	}
 
-	request, err = http.NewRequestWithContext(ctx, method, endpointPath.String(), payloadBuffer)
+	request, err = http.NewRequestWithContext(route.With(ctx, template), method, endpointPath.String(), payloadBuffer)
 	if err != nil {
 		return
 	}
@@ -105,6 +122,10 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 		request.SetBasicAuth(c.Auth.mail, c.Auth.token)
 	}
 
+	if c.Auth.bearerTokenProvided {
+		request.Header.Set("Authorization", "Bearer "+c.Auth.bearerToken)
+	}
+
 	if c.Auth.userAgentProvided {
 		request.Header.Set("User-Agent", c.Auth.agent)
 	}
@@ -114,7 +135,11 @@ func (c *Client) newRequest(ctx context.Context, method, urlAsString string, pay
 
 func (c *Client) Do(request *http.Request) (response *Response, err error) {
 
-	httpResponse, err := c.HTTP.Do(request)
+	template := route.Of(request.Context())
+
+	httpResponse, err := c.cache.Do(request, template, func(request *http.Request) (*http.Response, error) {
+		return c.send(request, template)
+	})
 	if err != nil {
 		return
 	}
@@ -132,6 +157,24 @@ func (c *Client) Do(request *http.Request) (response *Response, err error) {
 	return
 }
 
+// send sends the request with the rate limiter, the instrumentation and the logger of the client
+func (c *Client) send(request *http.Request, route string) (*http.Response, error) {
+
+	if err := c.limiter.Wait(request.Context(), request.Method, route); err != nil {
+		return nil, err
+	}
+
+	request, call := c.instrumentation.Start(request, route)
+	exchange := c.logger.Start(request)
+
+	httpResponse, err := c.HTTP.Do(request)
+	exchange.Finish(httpResponse, err)
+	call.End(httpResponse, err)
+	c.limiter.Update(httpResponse)
+
+	return httpResponse, err
+}
+
 type Response struct {
 	StatusCode  int
 	Endpoint    string
@@ -0,0 +1,74 @@
+package sm
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/telemetry"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type instrumentationContextKey struct{}
+
+type recordingInstrumentation struct {
+	requests []*telemetry.RequestScheme
+	results  []*telemetry.ResultScheme
+}
+
+func (r *recordingInstrumentation) Start(ctx context.Context, request *telemetry.RequestScheme) (context.Context, telemetry.Span) {
+	r.requests = append(r.requests, request)
+	return context.WithValue(ctx, instrumentationContextKey{}, len(r.requests)), r
+}
+
+func (r *recordingInstrumentation) End(result *telemetry.ResultScheme) {
+	r.results = append(r.results, result)
+}
+
+// contextTransport records the instrumentation values of the request contexts
+type contextTransport struct {
+	values []interface{}
+}
+
+func (c *contextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	c.values = append(c.values, request.Context().Value(instrumentationContextKey{}))
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func TestWithInstrumentation(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "10", "projectKey": "DESK"}`))
+	}))
+	defer mockServer.Close()
+
+	var (
+		instrumentation = &recordingInstrumentation{}
+		transport       = &contextTransport{}
+	)
+
+	mockClient, err := New(&http.Client{Transport: transport}, mockServer.URL, WithInstrumentation(instrumentation))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = mockClient.ServiceDesk.Queue.Get(context.Background(), 10, 20, true)
+	assert.NoError(t, err)
+
+	if assert.Len(t, instrumentation.requests, 1) && assert.Len(t, instrumentation.results, 1) {
+		assert.Equal(t, telemetry.ProductServiceManagement, instrumentation.requests[0].Product)
+		assert.Equal(t, "rest/servicedeskapi/servicedesk/{serviceDeskID}/queue/{queueID}", instrumentation.requests[0].Route)
+		assert.Equal(t, http.StatusOK, instrumentation.results[0].StatusCode)
+		assert.Equal(t, []interface{}{1}, transport.values)
+	}
+
+	// The client without the instrumentation sends the requests with the original context
+	mockClient, err = New(&http.Client{Transport: transport}, mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = mockClient.ServiceDesk.Queue.Get(context.Background(), 10, 20, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{1, nil}, transport.values)
+}
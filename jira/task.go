@@ -38,7 +38,7 @@ func (t *TaskService) Get(ctx context.Context, taskID string) (result *TaskSchem
 
 	var endpoint = fmt.Sprintf("rest/api/3/task/%v", taskID)
 
-	request, err := t.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := t.client.newRequest(ctx, http.MethodGet, "rest/api/3/task/{taskID}", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -68,7 +68,7 @@ func (t *TaskService) Cancel(ctx context.Context, taskID string) (response *Resp
 
 	var endpoint = fmt.Sprintf("rest/api/3/task/%v/cancel", taskID)
 
-	request, err := t.client.newRequest(ctx, http.MethodPost, endpoint, nil)
+	request, err := t.client.newRequest(ctx, http.MethodPost, "rest/api/3/task/{taskID}/cancel", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,19 @@
+package jira
+
+import (
+	"github.com/ctreminiom/go-atlassian/internal/instrument"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"github.com/ctreminiom/go-atlassian/telemetry"
+)
+
+// WithInstrumentation calls the instrumentation for every request of the client, e.g: to create the OpenTelemetry
+// spans and metrics. The requests are described with the endpoint templates of the service methods,
+// e.g: rest/api/3/issue/{issueKeyOrID}
+func WithInstrumentation(instrumentation telemetry.Instrumentation) ClientOption {
+	return func(c *Client) {
+		c.instrumentation = instrument.New(instrumentation, telemetry.ProductJira)
+
+		// The Service Management module shares the instrumentation
+		sm.WithInstrumentation(instrumentation)(c.ServiceManagement)
+	}
+}
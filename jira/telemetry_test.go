@@ -0,0 +1,89 @@
+package jira
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/telemetry"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type instrumentationContextKey struct{}
+
+type recordingInstrumentation struct {
+	requests []*telemetry.RequestScheme
+	results  []*telemetry.ResultScheme
+}
+
+func (r *recordingInstrumentation) Start(ctx context.Context, request *telemetry.RequestScheme) (context.Context, telemetry.Span) {
+	r.requests = append(r.requests, request)
+	return context.WithValue(ctx, instrumentationContextKey{}, len(r.requests)), r
+}
+
+func (r *recordingInstrumentation) End(result *telemetry.ResultScheme) {
+	r.results = append(r.results, result)
+}
+
+// contextTransport records the instrumentation values of the request contexts
+type contextTransport struct {
+	values []interface{}
+}
+
+func (c *contextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	c.values = append(c.values, request.Context().Value(instrumentationContextKey{}))
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func TestWithInstrumentation(t *testing.T) {
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/api/3/issue/KP-1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id": "10001", "key": "KP-1"}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/request/DESK-1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errorMessage": "The request doesn't exist"}`, http.StatusNotFound)
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	var (
+		instrumentation = &recordingInstrumentation{}
+		transport       = &contextTransport{}
+	)
+
+	mockClient, err := New(&http.Client{Transport: transport}, mockServer.URL, WithInstrumentation(instrumentation))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = mockClient.Issue.Get(context.Background(), "KP-1", nil, []string{"changelog"})
+	assert.NoError(t, err)
+
+	// The caller sends the request again
+	_, _, err = mockClient.ServiceManagement.Request.Get(telemetry.WithRetries(context.Background(), 1), "DESK-1", nil)
+	assert.Error(t, err)
+
+	if !assert.Len(t, instrumentation.requests, 2) || !assert.Len(t, instrumentation.results, 2) {
+		return
+	}
+
+	assert.Equal(t, telemetry.ProductJira, instrumentation.requests[0].Product)
+	assert.Equal(t, http.MethodGet, instrumentation.requests[0].Method)
+	assert.Equal(t, "rest/api/3/issue/{issueKeyOrID}", instrumentation.requests[0].Route)
+	assert.Equal(t, "/rest/api/3/issue/KP-1", instrumentation.requests[0].URL.Path)
+	assert.Equal(t, 0, instrumentation.requests[0].Retries)
+	assert.Equal(t, http.StatusOK, instrumentation.results[0].StatusCode)
+
+	// The Service Management module shares the instrumentation
+	assert.Equal(t, telemetry.ProductServiceManagement, instrumentation.requests[1].Product)
+	assert.Equal(t, "rest/servicedeskapi/request/{issueKeyOrID}", instrumentation.requests[1].Route)
+	assert.Equal(t, 1, instrumentation.requests[1].Retries)
+	assert.Equal(t, http.StatusNotFound, instrumentation.results[1].StatusCode)
+
+	// The requests are sent with the context returned by the instrumentation
+	assert.Equal(t, []interface{}{1, 2}, transport.values)
+}
@@ -88,7 +88,7 @@ func (u *UserService) Get(ctx context.Context, accountID string, expands []strin
 
 	var endpoint = fmt.Sprintf("rest/api/3/user?%v", params.Encode())
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "rest/api/3/user", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -137,7 +137,7 @@ func (u *UserService) Create(ctx context.Context, payload *UserPayloadScheme) (r
 
 	var endpoint = "rest/api/3/user"
 
-	request, err := u.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	request, err := u.client.newRequest(ctx, http.MethodPost, "rest/api/3/user", endpoint, &payload)
 	if err != nil {
 		return
 	}
@@ -170,7 +170,7 @@ func (u *UserService) Delete(ctx context.Context, accountID string) (response *R
 	params.Add("accountId", accountID)
 	var endpoint = fmt.Sprintf("rest/api/3/user?%v", params.Encode())
 
-	request, err := u.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodDelete, "rest/api/3/user", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -209,7 +209,7 @@ func (u *UserService) Find(ctx context.Context, accountIDs []string, startAt, ma
 
 	var endpoint = fmt.Sprintf("rest/api/3/user/bulk?%v", params.Encode())
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "rest/api/3/user/bulk", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -247,7 +247,7 @@ func (u *UserService) Groups(ctx context.Context, accountID string) (result *[]U
 
 	var endpoint = fmt.Sprintf("rest/api/3/user/groups?%v", params.Encode())
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "rest/api/3/user/groups", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -277,7 +277,7 @@ func (u *UserService) Gets(ctx context.Context, startAt, maxResults int) (result
 
 	var endpoint = fmt.Sprintf("rest/api/3/users/search?%v", params.Encode())
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "rest/api/3/users/search", endpoint, nil)
 	if err != nil {
 		return
 	}
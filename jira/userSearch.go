@@ -45,7 +45,7 @@ func (u *UserSearchService) Projects(ctx context.Context, accountID string, proj
 
 	var endpoint = fmt.Sprintf("rest/api/3/user/assignable/multiProjectSearch?%v", params.Encode())
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "rest/api/3/user/assignable/multiProjectSearch", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -86,7 +86,7 @@ func (u *UserSearchService) Do(ctx context.Context, accountID, query string, sta
 
 	var endpoint = fmt.Sprintf("rest/api/3/user/search?%v", params.Encode())
 
-	request, err := u.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	request, err := u.client.newRequest(ctx, http.MethodGet, "rest/api/3/user/search", endpoint, nil)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,241 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type WebhookService struct{ client *Client }
+
+type WebhookSubscriptionPayloadScheme struct {
+	Webhooks []*WebhookSubscriptionScheme `json:"webhooks,omitempty"`
+	URL      string                       `json:"url,omitempty"`
+}
+
+type WebhookSubscriptionScheme struct {
+	JqlFilter               string   `json:"jqlFilter,omitempty"`
+	FieldIdsFilter          []string `json:"fieldIdsFilter,omitempty"`
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"`
+	Events                  []string `json:"events,omitempty"`
+}
+
+type WebhookRegistrationResultScheme struct {
+	WebhookRegistrationResult []*WebhookRegistrationScheme `json:"webhookRegistrationResult,omitempty"`
+}
+
+type WebhookRegistrationScheme struct {
+	CreatedWebhookID int      `json:"createdWebhookId,omitempty"`
+	Errors           []string `json:"errors,omitempty"`
+}
+
+type WebhookPageScheme struct {
+	MaxResults int              `json:"maxResults,omitempty"`
+	StartAt    int              `json:"startAt,omitempty"`
+	Total      int              `json:"total,omitempty"`
+	IsLast     bool             `json:"isLast,omitempty"`
+	Values     []*WebhookScheme `json:"values,omitempty"`
+}
+
+type WebhookScheme struct {
+	ID                      int      `json:"id,omitempty"`
+	JqlFilter               string   `json:"jqlFilter,omitempty"`
+	FieldIdsFilter          []string `json:"fieldIdsFilter,omitempty"`
+	IssuePropertyKeysFilter []string `json:"issuePropertyKeysFilter,omitempty"`
+	Events                  []string `json:"events,omitempty"`
+	ExpirationDate          int64    `json:"expirationDate,omitempty"`
+}
+
+type WebhookExpirationScheme struct {
+	ExpirationDate int64 `json:"expirationDate,omitempty"`
+}
+
+type WebhookFailedPageScheme struct {
+	Values     []*WebhookFailedScheme `json:"values,omitempty"`
+	MaxResults int                    `json:"maxResults,omitempty"`
+	Next       string                 `json:"next,omitempty"`
+}
+
+type WebhookFailedScheme struct {
+	ID          string `json:"id,omitempty"`
+	Body        string `json:"body,omitempty"`
+	URL         string `json:"url,omitempty"`
+	FailureTime int64  `json:"failureTime,omitempty"`
+}
+
+// Registers webhooks, the webhooks are only available for Connect and OAuth 2.0 apps.
+// The webhooks expire after 30 days, use the Refresh method to extend their life.
+// Docs: N/A
+func (w *WebhookService) Register(ctx context.Context, payload *WebhookSubscriptionPayloadScheme) (result *WebhookRegistrationResultScheme, response *Response, err error) {
+
+	if payload == nil || len(payload.Webhooks) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid WebhookSubscriptionPayloadScheme pointer with the webhooks")
+	}
+
+	if len(payload.URL) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid URL value")
+	}
+
+	var endpoint = "rest/api/3/webhook"
+
+	request, err := w.client.newRequest(ctx, http.MethodPost, "rest/api/3/webhook", endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WebhookRegistrationResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns a paginated list of the webhooks registered by the calling app.
+// Docs: N/A
+func (w *WebhookService) Gets(ctx context.Context, startAt, maxResults int) (result *WebhookPageScheme, response *Response, err error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	var endpoint = fmt.Sprintf("rest/api/3/webhook?%v", params.Encode())
+
+	request, err := w.client.newRequest(ctx, http.MethodGet, "rest/api/3/webhook", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WebhookPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Removes webhooks by ID, only the webhooks registered by the calling app are removed.
+// Docs: N/A
+func (w *WebhookService) Delete(ctx context.Context, webhookIDs []int) (response *Response, err error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid webhookIDs value")
+	}
+
+	payload := struct {
+		WebhookIds []int `json:"webhookIds"`
+	}{
+		WebhookIds: webhookIDs,
+	}
+
+	var endpoint = "rest/api/3/webhook"
+
+	request, err := w.client.newRequest(ctx, http.MethodDelete, "rest/api/3/webhook", endpoint, &payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// Extends the life of webhooks, the webhooks expire 30 days after the call.
+// Docs: N/A
+func (w *WebhookService) Refresh(ctx context.Context, webhookIDs []int) (result *WebhookExpirationScheme, response *Response, err error) {
+
+	if len(webhookIDs) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid webhookIDs value")
+	}
+
+	payload := struct {
+		WebhookIds []int `json:"webhookIds"`
+	}{
+		WebhookIds: webhookIDs,
+	}
+
+	var endpoint = "rest/api/3/webhook/refresh"
+
+	request, err := w.client.newRequest(ctx, http.MethodPut, "rest/api/3/webhook/refresh", endpoint, &payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WebhookExpirationScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns webhooks that have failed to be delivered in the last 72 hours, sorted by the failure time.
+// The after value is the failure time, in milliseconds, of the last webhook returned by the previous call.
+// Docs: N/A
+func (w *WebhookService) Failed(ctx context.Context, maxResults int, after int64) (result *WebhookFailedPageScheme, response *Response, err error) {
+
+	params := url.Values{}
+
+	if maxResults > 0 {
+		params.Add("maxResults", strconv.Itoa(maxResults))
+	}
+
+	if after > 0 {
+		params.Add("after", strconv.FormatInt(after, 10))
+	}
+
+	var endpoint = "rest/api/3/webhook/failed"
+	if len(params) != 0 {
+		endpoint = fmt.Sprintf("%v?%v", endpoint, params.Encode())
+	}
+
+	request, err := w.client.newRequest(ctx, http.MethodGet, "rest/api/3/webhook/failed", endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = w.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(WebhookFailedPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,256 @@
+package jira
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	WebhookIssueCreatedEvent   = "jira:issue_created"
+	WebhookIssueUpdatedEvent   = "jira:issue_updated"
+	WebhookIssueDeletedEvent   = "jira:issue_deleted"
+	WebhookCommentCreatedEvent = "comment_created"
+	WebhookCommentUpdatedEvent = "comment_updated"
+	WebhookCommentDeletedEvent = "comment_deleted"
+	WebhookWorklogCreatedEvent = "worklog_created"
+	WebhookWorklogUpdatedEvent = "worklog_updated"
+	WebhookWorklogDeletedEvent = "worklog_deleted"
+	WebhookSprintCreatedEvent  = "sprint_created"
+	WebhookSprintUpdatedEvent  = "sprint_updated"
+	WebhookSprintDeletedEvent  = "sprint_deleted"
+	WebhookSprintStartedEvent  = "sprint_started"
+	WebhookSprintClosedEvent   = "sprint_closed"
+
+	// WebhookAnyEvent registers a callback for every event
+	WebhookAnyEvent = "*"
+)
+
+// WebhookEventScheme represents a webhook delivered by Jira, the fields are set depending on the event,
+// e.g: the issue events contain the issue and, when it's updated, the changelog.
+type WebhookEventScheme struct {
+	Timestamp          int64                   `json:"timestamp,omitempty"`
+	WebhookEvent       string                  `json:"webhookEvent,omitempty"`
+	IssueEventTypeName string                  `json:"issue_event_type_name,omitempty"`
+	MatchedWebhookIDs  []int                   `json:"matchedWebhookIds,omitempty"`
+	User               *UserScheme             `json:"user,omitempty"`
+	Issue              *IssueScheme            `json:"issue,omitempty"`
+	Changelog          *WebhookChangelogScheme `json:"changelog,omitempty"`
+	Comment            *IssueCommentScheme     `json:"comment,omitempty"`
+	Worklog            *WebhookWorklogScheme   `json:"worklog,omitempty"`
+	Sprint             *WebhookSprintScheme    `json:"sprint,omitempty"`
+	OldValue           *WebhookSprintScheme    `json:"oldValue,omitempty"`
+
+	CommentBody string `json:"-"` // The comment body when it's delivered as text, the webhooks don't use the ADF format
+	Identifier  string `json:"-"` // The X-Atlassian-Webhook-Identifier header, it's the same on the retries
+	Retry       int    `json:"-"` // The X-Atlassian-Webhook-Retry header
+}
+
+type WebhookChangelogScheme struct {
+	ID    string                             `json:"id,omitempty"`
+	Items []*IssueChangelogHistoryItemScheme `json:"items,omitempty"`
+}
+
+type WebhookWorklogScheme struct {
+	Self             string      `json:"self,omitempty"`
+	ID               string      `json:"id,omitempty"`
+	IssueID          string      `json:"issueId,omitempty"`
+	Author           *UserScheme `json:"author,omitempty"`
+	UpdateAuthor     *UserScheme `json:"updateAuthor,omitempty"`
+	Comment          interface{} `json:"comment,omitempty"`
+	Created          string      `json:"created,omitempty"`
+	Updated          string      `json:"updated,omitempty"`
+	Started          string      `json:"started,omitempty"`
+	TimeSpent        string      `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int         `json:"timeSpentSeconds,omitempty"`
+}
+
+type WebhookSprintScheme struct {
+	ID            int    `json:"id,omitempty"`
+	Self          string `json:"self,omitempty"`
+	State         string `json:"state,omitempty"`
+	Name          string `json:"name,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	CompleteDate  string `json:"completeDate,omitempty"`
+	CreatedDate   string `json:"createdDate,omitempty"`
+	OriginBoardID int    `json:"originBoardId,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+}
+
+// UnmarshalJSON decodes the webhook, the comment body delivered as text is stored on the CommentBody field
+func (w *WebhookEventScheme) UnmarshalJSON(data []byte) error {
+
+	type webhookEvent WebhookEventScheme
+
+	payload := struct {
+		*webhookEvent
+		Comment map[string]json.RawMessage `json:"comment,omitempty"`
+	}{webhookEvent: (*webhookEvent)(w)}
+
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	if payload.Comment == nil {
+		return nil
+	}
+
+	if body, ok := payload.Comment["body"]; ok {
+
+		var text string
+		if json.Unmarshal(body, &text) == nil {
+			w.CommentBody = text
+			delete(payload.Comment, "body")
+		}
+	}
+
+	comment, err := json.Marshal(payload.Comment)
+	if err != nil {
+		return err
+	}
+
+	w.Comment = new(IssueCommentScheme)
+	return json.Unmarshal(comment, w.Comment)
+}
+
+// ParseWebhookEvent decodes the body of a webhook
+func ParseWebhookEvent(body []byte) (event *WebhookEventScheme, err error) {
+
+	event = new(WebhookEventScheme)
+	if err = json.Unmarshal(body, event); err != nil {
+		return nil, err
+	}
+
+	if len(event.WebhookEvent) == 0 {
+		return nil, fmt.Errorf("error, the webhook doesn't contain the webhookEvent value")
+	}
+
+	return event, nil
+}
+
+// WebhookHandler is an http.Handler that receives the Jira webhooks and calls the callbacks registered for the event.
+// It replies 401 when the secret is not valid, 400 when the body can't be decoded and 500 when a callback fails,
+// so Jira retries the delivery.
+type WebhookHandler struct {
+	// Secret verifies the HMAC-SHA256 signature of the X-Hub-Signature header,
+	// the requests without the header are verified with the secret query parameter. Empty disables the verification.
+	Secret string
+
+	// MaxBodySize limits the size of the webhooks, the default value is 10MB
+	MaxBodySize int64
+
+	mu        sync.RWMutex
+	callbacks map[string][]func(ctx context.Context, event *WebhookEventScheme) error
+}
+
+// NewWebhookHandler returns a WebhookHandler that verifies the webhooks with the secret
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{Secret: secret}
+}
+
+// On registers a callback for the event, e.g: jira:issue_created, the WebhookAnyEvent value registers it for every event.
+// The callbacks are called in order, the first error stops the dispatch.
+func (h *WebhookHandler) On(event string, callback func(ctx context.Context, event *WebhookEventScheme) error) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.callbacks == nil {
+		h.callbacks = make(map[string][]func(ctx context.Context, event *WebhookEventScheme) error)
+	}
+
+	h.callbacks[event] = append(h.callbacks[event], callback)
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	maxBodySize := h.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = 10 << 20
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if !h.verify(r, body) {
+		http.Error(w, "the webhook signature is not valid", http.StatusUnauthorized)
+		return
+	}
+
+	event, err := ParseWebhookEvent(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event.Identifier = r.Header.Get("X-Atlassian-Webhook-Identifier")
+	event.Retry, _ = strconv.Atoi(r.Header.Get("X-Atlassian-Webhook-Retry"))
+
+	if err = h.dispatch(r.Context(), event); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *WebhookHandler) dispatch(ctx context.Context, event *WebhookEventScheme) error {
+
+	h.mu.RLock()
+	callbacks := append(append([]func(context.Context, *WebhookEventScheme) error{}, h.callbacks[event.WebhookEvent]...), h.callbacks[WebhookAnyEvent]...)
+	h.mu.RUnlock()
+
+	for _, callback := range callbacks {
+		if err := callback(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *WebhookHandler) verify(r *http.Request, body []byte) bool {
+
+	if len(h.Secret) == 0 {
+		return true
+	}
+
+	if signature := r.Header.Get("X-Hub-Signature"); len(signature) != 0 {
+
+		parts := strings.SplitN(signature, "=", 2)
+		if len(parts) != 2 || parts[0] != "sha256" {
+			return false
+		}
+
+		expected, err := hex.DecodeString(parts[1])
+		if err != nil {
+			return false
+		}
+
+		mac := hmac.New(sha256.New, []byte(h.Secret))
+		mac.Write(body)
+
+		return hmac.Equal(mac.Sum(nil), expected)
+	}
+
+	return subtle.ConstantTimeCompare([]byte(r.URL.Query().Get("secret")), []byte(h.Secret)) == 1
+}
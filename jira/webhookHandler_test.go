@@ -0,0 +1,183 @@
+package jira
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	webhookIssueUpdatedBody = `{
+		"timestamp": 1627492838000,
+		"webhookEvent": "jira:issue_updated",
+		"issue_event_type_name": "issue_generic",
+		"matchedWebhookIds": [1000],
+		"user": {"accountId": "account-id", "displayName": "Carlos"},
+		"issue": {"id": "10002", "key": "KP-2", "fields": {"summary": "Migrate the database"}},
+		"changelog": {"id": "10010", "items": [{"field": "status", "fieldId": "status", "fromString": "To Do", "toString": "In Progress"}]}
+	}`
+
+	webhookCommentCreatedBody = `{
+		"timestamp": 1627492838000,
+		"webhookEvent": "comment_created",
+		"comment": {"id": "10050", "author": {"accountId": "account-id"}, "body": "The migration is done", "created": "2021-07-28T17:20:38.000+0000"},
+		"issue": {"id": "10002", "key": "KP-2"}
+	}`
+
+	webhookSprintStartedBody = `{
+		"timestamp": 1627492838000,
+		"webhookEvent": "sprint_started",
+		"sprint": {"id": 4, "state": "active", "name": "Sprint 4", "originBoardId": 1}
+	}`
+)
+
+func signWebhook(secret, body string) string {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseWebhookEvent(t *testing.T) {
+
+	event, err := ParseWebhookEvent([]byte(webhookCommentCreatedBody))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, WebhookCommentCreatedEvent, event.WebhookEvent)
+	assert.Equal(t, "The migration is done", event.CommentBody)
+	assert.Equal(t, "10050", event.Comment.ID)
+	assert.Equal(t, "account-id", event.Comment.Author.AccountID)
+	assert.Equal(t, "KP-2", event.Issue.Key)
+
+	event, err = ParseWebhookEvent([]byte(`{"webhookEvent": "comment_updated", "comment": {"id": "1", "body": {"type": "doc", "version": 1}}}`))
+	if assert.NoError(t, err) {
+		assert.Empty(t, event.CommentBody)
+		assert.Equal(t, "doc", event.Comment.Body.Type)
+	}
+
+	_, err = ParseWebhookEvent([]byte(`{"timestamp": 1627492838000}`))
+	assert.Error(t, err)
+
+	_, err = ParseWebhookEvent([]byte(`{invalid`))
+	assert.Error(t, err)
+}
+
+func TestWebhookHandler_ServeHTTP(t *testing.T) {
+
+	var (
+		handler = NewWebhookHandler("webhook-secret")
+		events  []string
+	)
+
+	handler.On(WebhookIssueUpdatedEvent, func(ctx context.Context, event *WebhookEventScheme) error {
+
+		assert.Equal(t, "KP-2", event.Issue.Key)
+		assert.Equal(t, "Migrate the database", event.Issue.Fields.Summary)
+		assert.Equal(t, "In Progress", event.Changelog.Items[0].ToString)
+		assert.Equal(t, "webhook-identifier", event.Identifier)
+		assert.Equal(t, 1, event.Retry)
+
+		events = append(events, event.WebhookEvent)
+		return nil
+	})
+
+	handler.On(WebhookCommentCreatedEvent, func(ctx context.Context, event *WebhookEventScheme) error {
+		return errors.New("the comment can't be stored")
+	})
+
+	handler.On(WebhookAnyEvent, func(ctx context.Context, event *WebhookEventScheme) error {
+		events = append(events, "any:"+event.WebhookEvent)
+		return nil
+	})
+
+	testCases := []struct {
+		name      string
+		method    string
+		target    string
+		body      string
+		signature string
+		want      int
+	}{
+		{
+			name:      "ServeHTTPWhenTheSignatureIsValid",
+			method:    http.MethodPost,
+			target:    "/webhook",
+			body:      webhookIssueUpdatedBody,
+			signature: signWebhook("webhook-secret", webhookIssueUpdatedBody),
+			want:      http.StatusOK,
+		},
+		{
+			name:   "ServeHTTPWhenTheSecretIsOnTheQuery",
+			method: http.MethodPost,
+			target: "/webhook?secret=webhook-secret",
+			body:   webhookSprintStartedBody,
+			want:   http.StatusOK,
+		},
+		{
+			name:      "ServeHTTPWhenTheSignatureIsNotValid",
+			method:    http.MethodPost,
+			target:    "/webhook?secret=webhook-secret",
+			body:      webhookIssueUpdatedBody,
+			signature: signWebhook("another-secret", webhookIssueUpdatedBody),
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:   "ServeHTTPWhenTheSecretIsNotProvided",
+			method: http.MethodPost,
+			target: "/webhook",
+			body:   webhookIssueUpdatedBody,
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:      "ServeHTTPWhenTheCallbackFails",
+			method:    http.MethodPost,
+			target:    "/webhook",
+			body:      webhookCommentCreatedBody,
+			signature: signWebhook("webhook-secret", webhookCommentCreatedBody),
+			want:      http.StatusInternalServerError,
+		},
+		{
+			name:   "ServeHTTPWhenTheBodyIsNotValid",
+			method: http.MethodPost,
+			target: "/webhook?secret=webhook-secret",
+			body:   `{"timestamp": 1627492838000}`,
+			want:   http.StatusBadRequest,
+		},
+		{
+			name:   "ServeHTTPWhenTheMethodIsIncorrect",
+			method: http.MethodGet,
+			target: "/webhook?secret=webhook-secret",
+			want:   http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request := httptest.NewRequest(testCase.method, testCase.target, strings.NewReader(testCase.body))
+			request.Header.Set("X-Atlassian-Webhook-Identifier", "webhook-identifier")
+			request.Header.Set("X-Atlassian-Webhook-Retry", "1")
+
+			if len(testCase.signature) != 0 {
+				request.Header.Set("X-Hub-Signature", testCase.signature)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.want, recorder.Code)
+		})
+	}
+
+	assert.Equal(t, []string{WebhookIssueUpdatedEvent, "any:" + WebhookIssueUpdatedEvent, "any:" + WebhookSprintStartedEvent}, events)
+}
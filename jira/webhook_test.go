@@ -0,0 +1,759 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestWebhookService_Register(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		payload            *WebhookSubscriptionPayloadScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "RegisterWebhooksWhenTheParametersAreCorrect",
+			payload: &WebhookSubscriptionPayloadScheme{
+				URL: "https://your-app.example.com/webhook-received",
+				Webhooks: []*WebhookSubscriptionScheme{
+					{JqlFilter: "project = PROJ", Events: []string{"jira:issue_created", "jira:issue_updated"}},
+				},
+			},
+			mockFile:           "./mocks/register-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "RegisterWebhooksWhenThePayloadIsNil",
+			payload:            nil,
+			mockFile:           "./mocks/register-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RegisterWebhooksWhenTheURLIsNotSet",
+			payload:            &WebhookSubscriptionPayloadScheme{Webhooks: []*WebhookSubscriptionScheme{{JqlFilter: "project = PROJ"}}},
+			mockFile:           "./mocks/register-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "RegisterWebhooksWhenTheRequestMethodIsIncorrect",
+			payload: &WebhookSubscriptionPayloadScheme{
+				URL: "https://your-app.example.com/webhook-received",
+				Webhooks: []*WebhookSubscriptionScheme{
+					{JqlFilter: "project = PROJ", Events: []string{"jira:issue_created", "jira:issue_updated"}},
+				},
+			},
+			mockFile:           "./mocks/register-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "RegisterWebhooksWhenTheStatusCodeIsIncorrect",
+			payload: &WebhookSubscriptionPayloadScheme{
+				URL: "https://your-app.example.com/webhook-received",
+				Webhooks: []*WebhookSubscriptionScheme{
+					{JqlFilter: "project = PROJ", Events: []string{"jira:issue_created", "jira:issue_updated"}},
+				},
+			},
+			mockFile:           "./mocks/register-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "RegisterWebhooksWhenTheContextIsNil",
+			payload: &WebhookSubscriptionPayloadScheme{
+				URL: "https://your-app.example.com/webhook-received",
+				Webhooks: []*WebhookSubscriptionScheme{
+					{JqlFilter: "project = PROJ", Events: []string{"jira:issue_created", "jira:issue_updated"}},
+				},
+			},
+			mockFile:           "./mocks/register-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "RegisterWebhooksWhenTheResponseBodyHasADifferentFormat",
+			payload: &WebhookSubscriptionPayloadScheme{
+				URL: "https://your-app.example.com/webhook-received",
+				Webhooks: []*WebhookSubscriptionScheme{
+					{JqlFilter: "project = PROJ", Events: []string{"jira:issue_created", "jira:issue_updated"}},
+				},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			i := &WebhookService{client: mockClient}
+
+			gotResult, gotResponse, err := i.Register(testCase.context, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, registration := range gotResult.WebhookRegistrationResult {
+					t.Log(registration.CreatedWebhookID, registration.Errors)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWebhookService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name                string
+		startAt, maxResults int
+		mockFile            string
+		wantHTTPMethod      string
+		endpoint            string
+		context             context.Context
+		wantHTTPCodeReturn  int
+		wantErr             bool
+	}{
+		{
+			name:               "GetWebhooksWhenTheParametersAreCorrect",
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook?maxResults=50&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWebhooksWhenTheRequestMethodIsIncorrect",
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook?maxResults=50&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWebhooksWhenTheStatusCodeIsIncorrect",
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook?maxResults=50&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWebhooksWhenTheContextIsNil",
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/get-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook?maxResults=50&startAt=0",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWebhooksWhenTheResponseBodyHasADifferentFormat",
+			startAt:            0,
+			maxResults:         50,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook?maxResults=50&startAt=0",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			i := &WebhookService{client: mockClient}
+
+			gotResult, gotResponse, err := i.Gets(testCase.context, testCase.startAt, testCase.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, webhook := range gotResult.Values {
+					t.Log(webhook.ID, webhook.JqlFilter, webhook.ExpirationDate)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		webhookIDs         []int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "DeleteWebhooksWhenTheParametersAreCorrect",
+			webhookIDs:         []int{10000, 10001},
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteWebhooksWhenTheWebhookIDsAreNotSet",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWebhooksWhenTheRequestMethodIsIncorrect",
+			webhookIDs:         []int{10000, 10001},
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWebhooksWhenTheStatusCodeIsIncorrect",
+			webhookIDs:         []int{10000, 10001},
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/webhook",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWebhooksWhenTheContextIsNil",
+			webhookIDs:         []int{10000, 10001},
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/rest/api/3/webhook",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			i := &WebhookService{client: mockClient}
+
+			gotResponse, err := i.Delete(testCase.context, testCase.webhookIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWebhookService_Refresh(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		webhookIDs         []int
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "RefreshWebhooksWhenTheParametersAreCorrect",
+			webhookIDs:         []int{10000, 10001},
+			mockFile:           "./mocks/refresh-webhooks.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/webhook/refresh",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "RefreshWebhooksWhenTheWebhookIDsAreNotSet",
+			mockFile:           "./mocks/refresh-webhooks.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/webhook/refresh",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RefreshWebhooksWhenTheRequestMethodIsIncorrect",
+			webhookIDs:         []int{10000, 10001},
+			mockFile:           "./mocks/refresh-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook/refresh",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RefreshWebhooksWhenTheStatusCodeIsIncorrect",
+			webhookIDs:         []int{10000, 10001},
+			mockFile:           "./mocks/refresh-webhooks.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/webhook/refresh",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RefreshWebhooksWhenTheContextIsNil",
+			webhookIDs:         []int{10000, 10001},
+			mockFile:           "./mocks/refresh-webhooks.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/webhook/refresh",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RefreshWebhooksWhenTheResponseBodyHasADifferentFormat",
+			webhookIDs:         []int{10000, 10001},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPut,
+			endpoint:           "/rest/api/3/webhook/refresh",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			i := &WebhookService{client: mockClient}
+
+			gotResult, gotResponse, err := i.Refresh(testCase.context, testCase.webhookIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, int64(1589631032000), gotResult.ExpirationDate)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestWebhookService_Failed(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		maxResults         int
+		after              int64
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetFailedWebhooksWhenTheParametersAreCorrect",
+			maxResults:         100,
+			after:              1573118132000,
+			mockFile:           "./mocks/get-failed-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook/failed?after=1573118132000&maxResults=100",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetFailedWebhooksWhenTheParametersAreNotSet",
+			mockFile:           "./mocks/get-failed-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook/failed",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetFailedWebhooksWhenTheRequestMethodIsIncorrect",
+			maxResults:         100,
+			after:              1573118132000,
+			mockFile:           "./mocks/get-failed-webhooks.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/rest/api/3/webhook/failed?after=1573118132000&maxResults=100",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetFailedWebhooksWhenTheStatusCodeIsIncorrect",
+			maxResults:         100,
+			after:              1573118132000,
+			mockFile:           "./mocks/get-failed-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook/failed?after=1573118132000&maxResults=100",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetFailedWebhooksWhenTheContextIsNil",
+			maxResults:         100,
+			after:              1573118132000,
+			mockFile:           "./mocks/get-failed-webhooks.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook/failed?after=1573118132000&maxResults=100",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetFailedWebhooksWhenTheResponseBodyHasADifferentFormat",
+			maxResults:         100,
+			after:              1573118132000,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/rest/api/3/webhook/failed?after=1573118132000&maxResults=100",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			i := &WebhookService{client: mockClient}
+
+			gotResult, gotResponse, err := i.Failed(testCase.context, testCase.maxResults, testCase.after)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, failed := range gotResult.Values {
+					t.Log(failed.ID, failed.URL, failed.FailureTime)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
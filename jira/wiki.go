@@ -0,0 +1,567 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ADFToWiki converts an Atlassian Document Format document to the wiki markup used by Jira Server and Data Center,
+// the paragraphs, headings, lists, code blocks, quotes, panels, tables, mentions and the text marks are converted,
+// and the media nodes are omitted because they reference the attachments of the Cloud media service.
+func ADFToWiki(document *CommentNodeScheme) string {
+
+	if document == nil {
+		return ""
+	}
+
+	return wikiBlocks(document.Content)
+}
+
+// WikiToADF converts the wiki markup returned by Jira Server and Data Center to an Atlassian Document Format document,
+// the paragraphs, headings, lists, code blocks, quotes, rules, tables, mentions, links and the bold, italic and
+// monospaced texts are converted, the rest of the markup is kept as text.
+func WikiToADF(markup string) *CommentNodeScheme {
+
+	document := &CommentNodeScheme{Version: 1, Type: "doc"}
+	document.Content = wikiToNodes(strings.Split(strings.ReplaceAll(markup, "\r\n", "\n"), "\n"))
+
+	return document
+}
+
+func wikiBlocks(nodes []*CommentNodeScheme) string {
+
+	var blocks []string
+	for _, node := range nodes {
+		if block := wikiBlock(node); len(block) != 0 {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
+
+func wikiBlock(node *CommentNodeScheme) string {
+
+	switch node.Type {
+
+	case "paragraph":
+		return wikiInline(node.Content)
+
+	case "heading":
+		return fmt.Sprintf("h%d. %v", attributeInt(node.Attrs, "level", 1), wikiInline(node.Content))
+
+	case "bulletList", "orderedList":
+		return wikiList(node, "")
+
+	case "codeBlock":
+
+		macro := "{code}"
+		if language, ok := node.Attrs["language"].(string); ok && len(language) != 0 {
+			macro = "{code:" + language + "}"
+		}
+
+		return macro + "\n" + wikiText(node.Content) + "\n{code}"
+
+	case "blockquote":
+		return "{quote}\n" + wikiBlocks(node.Content) + "\n{quote}"
+
+	case "panel":
+		return "{panel}\n" + wikiBlocks(node.Content) + "\n{panel}"
+
+	case "rule":
+		return "----"
+
+	case "table":
+
+		var rows []string
+		for _, row := range node.Content {
+
+			var line strings.Builder
+			for _, cell := range row.Content {
+
+				separator := "|"
+				if cell.Type == "tableHeader" {
+					separator = "||"
+				}
+
+				line.WriteString(separator + strings.ReplaceAll(wikiBlocks(cell.Content), "\n\n", "\n"))
+
+				if cell == row.Content[len(row.Content)-1] {
+					line.WriteString(separator)
+				}
+			}
+
+			rows = append(rows, line.String())
+		}
+
+		return strings.Join(rows, "\n")
+
+	case "mediaSingle", "mediaGroup", "media":
+		return ""
+	}
+
+	if len(node.Text) != 0 {
+		return wikiInline([]*CommentNodeScheme{node})
+	}
+
+	return wikiBlocks(node.Content)
+}
+
+// wikiList converts the items of a list, the nested lists repeat the markers of their parents, e.g: "*#"
+func wikiList(list *CommentNodeScheme, markers string) string {
+
+	marker := "*"
+	if list.Type == "orderedList" {
+		marker = "#"
+	}
+
+	markers += marker
+
+	var lines []string
+	for _, item := range list.Content {
+
+		var text []string
+		var nested []string
+
+		for _, child := range item.Content {
+
+			if child.Type == "bulletList" || child.Type == "orderedList" {
+				nested = append(nested, wikiList(child, markers))
+				continue
+			}
+
+			text = append(text, wikiBlock(child))
+		}
+
+		lines = append(lines, markers+" "+strings.Join(text, "\n"))
+		lines = append(lines, nested...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func wikiInline(nodes []*CommentNodeScheme) string {
+
+	var builder strings.Builder
+
+	for _, node := range nodes {
+
+		switch node.Type {
+
+		case "text":
+			builder.WriteString(wikiMarks(node.Text, node.Marks))
+
+		case "hardBreak":
+			builder.WriteString("\n")
+
+		case "mention":
+			builder.WriteString("[~" + attributeString(node.Attrs, "id") + "]")
+
+		case "emoji":
+
+			if text := attributeString(node.Attrs, "text"); len(text) != 0 {
+				builder.WriteString(text)
+			} else {
+				builder.WriteString(attributeString(node.Attrs, "shortName"))
+			}
+
+		case "inlineCard":
+			builder.WriteString("[" + attributeString(node.Attrs, "url") + "]")
+
+		default:
+			builder.WriteString(wikiInline(node.Content))
+		}
+	}
+
+	return builder.String()
+}
+
+func wikiMarks(text string, marks []*MarkScheme) string {
+
+	var link string
+
+	for _, mark := range marks {
+
+		switch mark.Type {
+		case "strong":
+			text = "*" + text + "*"
+		case "em":
+			text = "_" + text + "_"
+		case "code":
+			text = "{{" + text + "}}"
+		case "strike":
+			text = "-" + text + "-"
+		case "underline":
+			text = "+" + text + "+"
+		case "subsup":
+
+			if attributeString(mark.Attrs, "type") == "sup" {
+				text = "^" + text + "^"
+			} else {
+				text = "~" + text + "~"
+			}
+
+		case "textColor":
+			text = "{color:" + attributeString(mark.Attrs, "color") + "}" + text + "{color}"
+		case "link":
+			link = attributeString(mark.Attrs, "href")
+		}
+	}
+
+	// The link is the outermost mark, e.g: [*bold*|https://example.com]
+	if len(link) != 0 {
+		return "[" + text + "|" + link + "]"
+	}
+
+	return text
+}
+
+// wikiText returns the text of the nodes without the marks, e.g: the content of the code blocks
+func wikiText(nodes []*CommentNodeScheme) string {
+
+	var builder strings.Builder
+	for _, node := range nodes {
+		builder.WriteString(node.Text)
+		builder.WriteString(wikiText(node.Content))
+	}
+
+	return builder.String()
+}
+
+var (
+	wikiHeading = regexp.MustCompile(`^h([1-6])\.\s+(.*)$`)
+	wikiListRow = regexp.MustCompile(`^([*#]+)\s+(.*)$`)
+	wikiCode    = regexp.MustCompile(`^\{(code|noformat)(?::([^}|]*))?[^}]*\}(.*)$`)
+	wikiToken   = regexp.MustCompile(`\{\{.+?\}\}|\[~[^\]]+\]|\[[^\]|]+\|[^\]]+\]|\[(?:https?|mailto):[^\]]+\]|\*[^*\s](?:[^*\n]*[^*\s])?\*|_[^_\s](?:[^_\n]*[^_\s])?_`)
+)
+
+func wikiToNodes(lines []string) (nodes []*CommentNodeScheme) {
+
+	var paragraph []string
+	var lists []*CommentNodeScheme
+
+	flush := func() {
+
+		if len(paragraph) != 0 {
+
+			node := &CommentNodeScheme{Type: "paragraph"}
+			for index, line := range paragraph {
+
+				if index != 0 {
+					node.Content = append(node.Content, &CommentNodeScheme{Type: "hardBreak"})
+				}
+
+				node.Content = append(node.Content, wikiToInline(line)...)
+			}
+
+			nodes = append(nodes, node)
+			paragraph = nil
+		}
+
+		lists = nil
+	}
+
+	for index := 0; index < len(lines); index++ {
+
+		line := lines[index]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+
+		case len(trimmed) == 0:
+			flush()
+
+		case wikiCode.MatchString(trimmed):
+
+			flush()
+
+			match := wikiCode.FindStringSubmatch(trimmed)
+
+			var content []string
+			if rest := strings.TrimSuffix(match[3], "{"+match[1]+"}"); len(rest) != 0 {
+				content = append(content, rest)
+			}
+
+			closed := strings.HasSuffix(match[3], "{"+match[1]+"}")
+			for ; !closed && index+1 < len(lines); index++ {
+
+				next := lines[index+1]
+				if position := strings.Index(next, "{"+match[1]+"}"); position != -1 {
+					if position != 0 {
+						content = append(content, next[:position])
+					}
+
+					index++
+					break
+				}
+
+				content = append(content, next)
+			}
+
+			node := &CommentNodeScheme{Type: "codeBlock"}
+			if len(match[2]) != 0 {
+				node.Attrs = map[string]interface{}{"language": match[2]}
+			}
+
+			if text := strings.Join(content, "\n"); len(text) != 0 {
+				node.Content = []*CommentNodeScheme{{Type: "text", Text: text}}
+			}
+
+			nodes = append(nodes, node)
+
+		case strings.HasPrefix(trimmed, "{quote}"):
+
+			flush()
+
+			var content []string
+			if rest := strings.TrimPrefix(trimmed, "{quote}"); len(rest) != 0 {
+				content = append(content, strings.TrimSuffix(rest, "{quote}"))
+			}
+
+			closed := len(trimmed) > len("{quote}") && strings.HasSuffix(trimmed, "{quote}")
+			for ; !closed && index+1 < len(lines); index++ {
+
+				next := lines[index+1]
+				if strings.Contains(next, "{quote}") {
+					content = append(content, strings.Replace(next, "{quote}", "", 1))
+					index++
+					break
+				}
+
+				content = append(content, next)
+			}
+
+			nodes = append(nodes, &CommentNodeScheme{Type: "blockquote", Content: wikiToNodes(content)})
+
+		case trimmed == "----":
+			flush()
+			nodes = append(nodes, &CommentNodeScheme{Type: "rule"})
+
+		case wikiHeading.MatchString(trimmed):
+
+			flush()
+
+			match := wikiHeading.FindStringSubmatch(trimmed)
+			level, _ := strconv.Atoi(match[1])
+
+			nodes = append(nodes, &CommentNodeScheme{
+				Type:    "heading",
+				Attrs:   map[string]interface{}{"level": level},
+				Content: wikiToInline(match[2]),
+			})
+
+		case wikiListRow.MatchString(trimmed):
+
+			if len(paragraph) != 0 {
+				flush()
+			}
+
+			match := wikiListRow.FindStringSubmatch(trimmed)
+			markers := match[1]
+
+			// The lists of the deeper levels, or of a different type, are closed
+			for len(lists) > len(markers) || (len(lists) != 0 && lists[len(lists)-1].Type != wikiListType(markers[len(lists)-1])) {
+				lists = lists[:len(lists)-1]
+			}
+
+			for len(lists) < len(markers) {
+
+				list := &CommentNodeScheme{Type: wikiListType(markers[len(lists)])}
+
+				if len(lists) == 0 {
+					nodes = append(nodes, list)
+				} else {
+
+					parent := lists[len(lists)-1]
+					if len(parent.Content) == 0 {
+						parent.Content = append(parent.Content, &CommentNodeScheme{Type: "listItem"})
+					}
+
+					item := parent.Content[len(parent.Content)-1]
+					item.Content = append(item.Content, list)
+				}
+
+				lists = append(lists, list)
+			}
+
+			list := lists[len(lists)-1]
+			list.Content = append(list.Content, &CommentNodeScheme{
+				Type:    "listItem",
+				Content: []*CommentNodeScheme{{Type: "paragraph", Content: wikiToInline(match[2])}},
+			})
+
+		case strings.HasPrefix(trimmed, "|"):
+
+			flush()
+
+			table := &CommentNodeScheme{Type: "table"}
+			for ; index < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[index]), "|"); index++ {
+				table.Content = append(table.Content, wikiToRow(strings.TrimSpace(lines[index])))
+			}
+
+			index--
+			nodes = append(nodes, table)
+
+		default:
+
+			if len(lists) != 0 {
+				flush()
+			}
+
+			paragraph = append(paragraph, line)
+		}
+	}
+
+	flush()
+
+	return nodes
+}
+
+func wikiListType(marker byte) string {
+
+	if marker == '#' {
+		return "orderedList"
+	}
+
+	return "bulletList"
+}
+
+func wikiToRow(line string) *CommentNodeScheme {
+
+	row := &CommentNodeScheme{Type: "tableRow"}
+
+	for len(line) != 0 {
+
+		cellType, separator := "tableCell", "|"
+		if strings.HasPrefix(line, "||") {
+			cellType, separator = "tableHeader", "||"
+		}
+
+		line = strings.TrimPrefix(line, separator)
+		if len(line) == 0 {
+			break
+		}
+
+		end := strings.Index(line, "|")
+		if end == -1 {
+			end = len(line)
+		}
+
+		text := strings.TrimSpace(line[:end])
+		line = line[end:]
+
+		cell := &CommentNodeScheme{Type: cellType, Content: []*CommentNodeScheme{{Type: "paragraph"}}}
+		cell.Content[0].Content = wikiToInline(text)
+
+		row.Content = append(row.Content, cell)
+	}
+
+	return row
+}
+
+// wikiToInline converts the inline markup of a line, the marks are only converted at the word boundaries,
+// e.g: the underscores of "snake_case_name" are kept as text.
+func wikiToInline(line string) (nodes []*CommentNodeScheme) {
+
+	var text strings.Builder
+
+	flush := func() {
+		if text.Len() != 0 {
+			nodes = append(nodes, &CommentNodeScheme{Type: "text", Text: text.String()})
+			text.Reset()
+		}
+	}
+
+	position := 0
+	for _, match := range wikiToken.FindAllStringIndex(line, -1) {
+
+		start, end := match[0], match[1]
+		token := line[start:end]
+
+		before, _ := utf8.DecodeLastRuneInString(line[:start])
+		after, _ := utf8.DecodeRuneInString(line[end:])
+
+		boundary := !isWordRune(before) && !isWordRune(after)
+		if !boundary && (token[0] == '*' || token[0] == '_') {
+			continue
+		}
+
+		text.WriteString(line[position:start])
+		position = end
+
+		node := wikiTokenNode(token)
+		if node == nil {
+			text.WriteString(token)
+			continue
+		}
+
+		flush()
+		nodes = append(nodes, node)
+	}
+
+	text.WriteString(line[position:])
+	flush()
+
+	return nodes
+}
+
+func wikiTokenNode(token string) *CommentNodeScheme {
+
+	switch {
+
+	case strings.HasPrefix(token, "{{"):
+		return wikiMarkedText(token[2:len(token)-2], &MarkScheme{Type: "code"})
+
+	case strings.HasPrefix(token, "[~"):
+		return &CommentNodeScheme{Type: "mention", Attrs: map[string]interface{}{"id": token[2 : len(token)-1]}}
+
+	case strings.HasPrefix(token, "["):
+
+		content := token[1 : len(token)-1]
+
+		text, href := content, content
+		if separator := strings.Index(content, "|"); separator != -1 {
+			text, href = content[:separator], content[separator+1:]
+		}
+
+		return wikiMarkedText(text, &MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": href}})
+
+	case strings.HasPrefix(token, "*"):
+		return wikiMarkedText(token[1:len(token)-1], &MarkScheme{Type: "strong"})
+
+	case strings.HasPrefix(token, "_"):
+		return wikiMarkedText(token[1:len(token)-1], &MarkScheme{Type: "em"})
+	}
+
+	return nil
+}
+
+func wikiMarkedText(text string, mark *MarkScheme) *CommentNodeScheme {
+	return &CommentNodeScheme{Type: "text", Text: text, Marks: []*MarkScheme{mark}}
+}
+
+func isWordRune(character rune) bool {
+	return unicode.IsLetter(character) || unicode.IsDigit(character)
+}
+
+func attributeString(attributes map[string]interface{}, key string) string {
+
+	if value, ok := attributes[key]; ok && value != nil {
+		return fmt.Sprint(value)
+	}
+
+	return ""
+}
+
+func attributeInt(attributes map[string]interface{}, key string, fallback int) int {
+
+	if level, err := strconv.Atoi(attributeString(attributes, key)); err == nil {
+		return level
+	}
+
+	return fallback
+}
@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestADFToWiki(t *testing.T) {
+
+	text := func(value string, marks ...*MarkScheme) *CommentNodeScheme {
+		return &CommentNodeScheme{Type: "text", Text: value, Marks: marks}
+	}
+
+	paragraph := func(content ...*CommentNodeScheme) *CommentNodeScheme {
+		return &CommentNodeScheme{Type: "paragraph", Content: content}
+	}
+
+	item := func(content ...*CommentNodeScheme) *CommentNodeScheme {
+		return &CommentNodeScheme{Type: "listItem", Content: content}
+	}
+
+	testCases := []struct {
+		name     string
+		document *CommentNodeScheme
+		want     string
+	}{
+		{
+			name: "ConvertTheDocumentWhenItContainsTextMarks",
+			document: &CommentNodeScheme{Type: "doc", Content: []*CommentNodeScheme{
+				paragraph(
+					text("bold", &MarkScheme{Type: "strong"}), text(" "),
+					text("italic", &MarkScheme{Type: "em"}), text(" "),
+					text("code", &MarkScheme{Type: "code"}), text(" "),
+					text("docs", &MarkScheme{Type: "link", Attrs: map[string]interface{}{"href": "https://example.com"}}),
+					&CommentNodeScheme{Type: "hardBreak"},
+					&CommentNodeScheme{Type: "mention", Attrs: map[string]interface{}{"id": "jdoe", "text": "@John"}},
+				),
+			}},
+			want: "*bold* _italic_ {{code}} [docs|https://example.com]\n[~jdoe]",
+		},
+		{
+			name: "ConvertTheDocumentWhenItContainsBlocks",
+			document: &CommentNodeScheme{Type: "doc", Content: []*CommentNodeScheme{
+				{Type: "heading", Attrs: map[string]interface{}{"level": 2}, Content: []*CommentNodeScheme{text("Release")}},
+				{Type: "bulletList", Content: []*CommentNodeScheme{
+					item(paragraph(text("first")), &CommentNodeScheme{Type: "orderedList", Content: []*CommentNodeScheme{
+						item(paragraph(text("nested"))),
+					}}),
+					item(paragraph(text("second"))),
+				}},
+				{Type: "codeBlock", Attrs: map[string]interface{}{"language": "go"}, Content: []*CommentNodeScheme{text("fmt.Println()")}},
+				{Type: "blockquote", Content: []*CommentNodeScheme{paragraph(text("quoted"))}},
+				{Type: "rule"},
+				{Type: "mediaSingle", Content: []*CommentNodeScheme{{Type: "media"}}},
+			}},
+			want: "h2. Release\n\n* first\n*# nested\n* second\n\n{code:go}\nfmt.Println()\n{code}\n\n{quote}\nquoted\n{quote}\n\n----",
+		},
+		{
+			name: "ConvertTheDocumentWhenItContainsATable",
+			document: &CommentNodeScheme{Type: "doc", Content: []*CommentNodeScheme{
+				{Type: "table", Content: []*CommentNodeScheme{
+					{Type: "tableRow", Content: []*CommentNodeScheme{
+						{Type: "tableHeader", Content: []*CommentNodeScheme{paragraph(text("Key"))}},
+						{Type: "tableHeader", Content: []*CommentNodeScheme{paragraph(text("Status"))}},
+					}},
+					{Type: "tableRow", Content: []*CommentNodeScheme{
+						{Type: "tableCell", Content: []*CommentNodeScheme{paragraph(text("KP-1"))}},
+						{Type: "tableCell", Content: []*CommentNodeScheme{paragraph(text("Done"))}},
+					}},
+				}},
+			}},
+			want: "||Key||Status||\n|KP-1|Done|",
+		},
+		{
+			name: "ConvertTheDocumentWhenItIsNil",
+			want: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, ADFToWiki(testCase.document))
+		})
+	}
+}
+
+func TestWikiToADF(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		markup string
+		want   string
+	}{
+		{
+			name:   "ConvertTheMarkupWhenItContainsTextMarks",
+			markup: "*bold* and _italic_ with {{code}}, [docs|https://example.com] for [~jdoe] in snake_case_name",
+			want: `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[` +
+				`{"type":"text","text":"bold","marks":[{"type":"strong"}]},{"type":"text","text":" and "},` +
+				`{"type":"text","text":"italic","marks":[{"type":"em"}]},{"type":"text","text":" with "},` +
+				`{"type":"text","text":"code","marks":[{"type":"code"}]},{"type":"text","text":", "},` +
+				`{"type":"text","text":"docs","marks":[{"type":"link","attrs":{"href":"https://example.com"}}]},{"type":"text","text":" for "},` +
+				`{"type":"mention","attrs":{"id":"jdoe"}},{"type":"text","text":" in snake_case_name"}]}]}`,
+		},
+		{
+			name:   "ConvertTheMarkupWhenItContainsBlocks",
+			markup: "h1. Title\nfirst line\nsecond line\n\n* one\n*# nested\n* two\n{code:java}\nint a;\n{code}\n{quote}quoted{quote}\n----",
+			want: `{"version":1,"type":"doc","content":[` +
+				`{"type":"heading","content":[{"type":"text","text":"Title"}],"attrs":{"level":1}},` +
+				`{"type":"paragraph","content":[{"type":"text","text":"first line"},{"type":"hardBreak"},{"type":"text","text":"second line"}]},` +
+				`{"type":"bulletList","content":[` +
+				`{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"one"}]},` +
+				`{"type":"orderedList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"nested"}]}]}]}]},` +
+				`{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"two"}]}]}]},` +
+				`{"type":"codeBlock","content":[{"type":"text","text":"int a;"}],"attrs":{"language":"java"}},` +
+				`{"type":"blockquote","content":[{"type":"paragraph","content":[{"type":"text","text":"quoted"}]}]},` +
+				`{"type":"rule"}]}`,
+		},
+		{
+			name:   "ConvertTheMarkupWhenItContainsATable",
+			markup: "||Key||Status||\n|KP-1|Done|",
+			want: `{"version":1,"type":"doc","content":[{"type":"table","content":[` +
+				`{"type":"tableRow","content":[{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Key"}]}]},` +
+				`{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Status"}]}]}]},` +
+				`{"type":"tableRow","content":[{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"KP-1"}]}]},` +
+				`{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"Done"}]}]}]}]}]}`,
+		},
+		{
+			name:   "ConvertTheMarkupWhenItIsEmpty",
+			markup: "",
+			want:   `{"version":1,"type":"doc"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			document, err := json.Marshal(WikiToADF(testCase.markup))
+			assert.NoError(t, err)
+			assert.JSONEq(t, testCase.want, string(document))
+		})
+	}
+}
+
+func TestWikiRoundTrip(t *testing.T) {
+
+	markup := "h3. Notes\n\n*Deployed* to _staging_ by [~jdoe]\n\n# build\n# deploy\n\n{code:bash}\nmake release\n{code}"
+	assert.Equal(t, markup, ADFToWiki(WikiToADF(markup)))
+}
@@ -0,0 +1,379 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type AlertService struct{ client *Client }
+
+const (
+	P1AlertPriority = "P1"
+	P2AlertPriority = "P2"
+	P3AlertPriority = "P3"
+	P4AlertPriority = "P4"
+	P5AlertPriority = "P5"
+)
+
+type AlertPayloadScheme struct {
+	Message     string             `json:"message,omitempty"`
+	Alias       string             `json:"alias,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Responders  []*ResponderScheme `json:"responders,omitempty"`
+	VisibleTo   []*ResponderScheme `json:"visibleTo,omitempty"`
+	Actions     []string           `json:"actions,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
+	Details     map[string]string  `json:"details,omitempty"`
+	Entity      string             `json:"entity,omitempty"`
+	Source      string             `json:"source,omitempty"`
+	Priority    string             `json:"priority,omitempty"`
+	User        string             `json:"user,omitempty"`
+	Note        string             `json:"note,omitempty"`
+}
+
+// AlertActionPayloadScheme is the optional payload of the alert actions, e.g: acknowledge, close or add note
+type AlertActionPayloadScheme struct {
+	User   string `json:"user,omitempty"`
+	Source string `json:"source,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+type AlertPageScheme struct {
+	Data      []*AlertScheme `json:"data,omitempty"`
+	Paging    *PagingScheme  `json:"paging,omitempty"`
+	Took      float64        `json:"took,omitempty"`
+	RequestID string         `json:"requestId,omitempty"`
+}
+
+type AlertScheme struct {
+	ID             string                  `json:"id,omitempty"`
+	TinyID         string                  `json:"tinyId,omitempty"`
+	Alias          string                  `json:"alias,omitempty"`
+	Message        string                  `json:"message,omitempty"`
+	Status         string                  `json:"status,omitempty"`
+	Acknowledged   bool                    `json:"acknowledged,omitempty"`
+	IsSeen         bool                    `json:"isSeen,omitempty"`
+	Tags           []string                `json:"tags,omitempty"`
+	Snoozed        bool                    `json:"snoozed,omitempty"`
+	Count          int                     `json:"count,omitempty"`
+	LastOccurredAt string                  `json:"lastOccurredAt,omitempty"`
+	CreatedAt      string                  `json:"createdAt,omitempty"`
+	UpdatedAt      string                  `json:"updatedAt,omitempty"`
+	Source         string                  `json:"source,omitempty"`
+	Owner          string                  `json:"owner,omitempty"`
+	Priority       string                  `json:"priority,omitempty"`
+	Responders     []*ResponderScheme      `json:"responders,omitempty"`
+	Integration    *AlertIntegrationScheme `json:"integration,omitempty"`
+	Report         *AlertReportScheme      `json:"report,omitempty"`
+	Actions        []string                `json:"actions,omitempty"`
+	Entity         string                  `json:"entity,omitempty"`
+	Description    string                  `json:"description,omitempty"`
+	Details        map[string]string       `json:"details,omitempty"`
+}
+
+type AlertIntegrationScheme struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+type AlertReportScheme struct {
+	AckTime        int    `json:"ackTime,omitempty"`
+	CloseTime      int    `json:"closeTime,omitempty"`
+	AcknowledgedBy string `json:"acknowledgedBy,omitempty"`
+	ClosedBy       string `json:"closedBy,omitempty"`
+}
+
+type AlertResultScheme struct {
+	Data      *AlertScheme `json:"data,omitempty"`
+	Took      float64      `json:"took,omitempty"`
+	RequestID string       `json:"requestId,omitempty"`
+}
+
+type AlertRequestStatusResultScheme struct {
+	Data      *AlertRequestStatusScheme `json:"data,omitempty"`
+	Took      float64                   `json:"took,omitempty"`
+	RequestID string                    `json:"requestId,omitempty"`
+}
+
+// AlertRequestStatusScheme is the status of an asynchronous alert operation, it contains the ID of the alert created
+type AlertRequestStatusScheme struct {
+	Success       bool   `json:"success,omitempty"`
+	Action        string `json:"action,omitempty"`
+	ProcessedAt   string `json:"processedAt,omitempty"`
+	IntegrationID string `json:"integrationId,omitempty"`
+	IsSuccess     bool   `json:"isSuccess,omitempty"`
+	Status        string `json:"status,omitempty"`
+	AlertID       string `json:"alertId,omitempty"`
+	Alias         string `json:"alias,omitempty"`
+}
+
+type AlertNotePageScheme struct {
+	Data      []*AlertNoteScheme `json:"data,omitempty"`
+	Paging    *PagingScheme      `json:"paging,omitempty"`
+	Took      float64            `json:"took,omitempty"`
+	RequestID string             `json:"requestId,omitempty"`
+}
+
+type AlertNoteScheme struct {
+	Note      string `json:"note,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	Offset    string `json:"offset,omitempty"`
+}
+
+type GetAlertsOptionsScheme struct {
+	Query  string // The alerts search query, e.g: status: open AND priority: P1
+	Sort   string // The field used to sort the alerts, e.g: createdAt
+	Order  string // The order of the alerts, asc or desc
+	Offset int
+	Limit  int
+}
+
+// Creates an alert, the alert is created asynchronously, use the RequestStatus method to get the ID of the alert.
+// Docs: N/A
+func (a *AlertService) Create(ctx context.Context, payload *AlertPayloadScheme) (result *RequestResultScheme, response *Response, err error) {
+
+	if payload == nil || len(payload.Message) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid AlertPayloadScheme pointer with the message")
+	}
+
+	return a.action(ctx, "v2/alerts", payload)
+}
+
+// Returns the alerts that match the query, the open and closed alerts are returned when the query is not provided.
+// Docs: N/A
+func (a *AlertService) Gets(ctx context.Context, options *GetAlertsOptionsScheme) (result *AlertPageScheme, response *Response, err error) {
+
+	params := url.Values{}
+
+	if options != nil {
+
+		if len(options.Query) != 0 {
+			params.Add("query", options.Query)
+		}
+
+		if len(options.Sort) != 0 {
+			params.Add("sort", options.Sort)
+		}
+
+		if len(options.Order) != 0 {
+			params.Add("order", options.Order)
+		}
+
+		if options.Offset != 0 {
+			params.Add("offset", strconv.Itoa(options.Offset))
+		}
+
+		if options.Limit != 0 {
+			params.Add("limit", strconv.Itoa(options.Limit))
+		}
+	}
+
+	request, err := a.client.newRequest(ctx, http.MethodGet, withQuery("v2/alerts", params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = a.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(AlertPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns an alert, the identifier type is id, alias or tiny, the empty value uses the id type.
+// Docs: N/A
+func (a *AlertService) Get(ctx context.Context, identifier, identifierType string) (result *AlertResultScheme, response *Response, err error) {
+
+	if len(identifier) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("v2/alerts/%v", url.PathEscape(identifier)), identifierParams("identifierType", identifierType))
+
+	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = a.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(AlertResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns the status of an asynchronous alert operation, e.g: the alert creation.
+// Docs: N/A
+func (a *AlertService) RequestStatus(ctx context.Context, requestID string) (result *AlertRequestStatusResultScheme, response *Response, err error) {
+
+	if len(requestID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid requestID value")
+	}
+
+	var endpoint = fmt.Sprintf("v2/alerts/requests/%v", url.PathEscape(requestID))
+
+	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = a.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(AlertRequestStatusResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Acknowledges an alert, the payload is optional.
+// Docs: N/A
+func (a *AlertService) Acknowledge(ctx context.Context, identifier, identifierType string, payload *AlertActionPayloadScheme) (result *RequestResultScheme, response *Response, err error) {
+	return a.identifiedAction(ctx, identifier, identifierType, "acknowledge", payload)
+}
+
+// Closes an alert, the payload is optional.
+// Docs: N/A
+func (a *AlertService) Close(ctx context.Context, identifier, identifierType string, payload *AlertActionPayloadScheme) (result *RequestResultScheme, response *Response, err error) {
+	return a.identifiedAction(ctx, identifier, identifierType, "close", payload)
+}
+
+// Adds a note to an alert.
+// Docs: N/A
+func (a *AlertService) AddNote(ctx context.Context, identifier, identifierType string, payload *AlertActionPayloadScheme) (result *RequestResultScheme, response *Response, err error) {
+
+	if payload == nil || len(payload.Note) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid AlertActionPayloadScheme pointer with the note")
+	}
+
+	return a.identifiedAction(ctx, identifier, identifierType, "notes", payload)
+}
+
+// Returns the notes of an alert, the newest notes first.
+// Docs: N/A
+func (a *AlertService) Notes(ctx context.Context, identifier, identifierType, offset string, limit int) (result *AlertNotePageScheme, response *Response, err error) {
+
+	if len(identifier) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	params := identifierParams("identifierType", identifierType)
+
+	if len(offset) != 0 {
+		params.Add("offset", offset)
+	}
+
+	if limit != 0 {
+		params.Add("limit", strconv.Itoa(limit))
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("v2/alerts/%v/notes", url.PathEscape(identifier)), params)
+
+	request, err := a.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = a.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(AlertNotePageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Adds custom properties to an alert, the existing properties with the same key are overwritten.
+// Docs: N/A
+func (a *AlertService) AddDetails(ctx context.Context, identifier, identifierType string, details map[string]string, payload *AlertActionPayloadScheme) (result *RequestResultScheme, response *Response, err error) {
+
+	if len(details) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid details value")
+	}
+
+	if payload == nil {
+		payload = &AlertActionPayloadScheme{}
+	}
+
+	detailsPayload := struct {
+		*AlertActionPayloadScheme
+		Details map[string]string `json:"details"`
+	}{
+		AlertActionPayloadScheme: payload,
+		Details:                  details,
+	}
+
+	return a.identifiedAction(ctx, identifier, identifierType, "details", &detailsPayload)
+}
+
+func (a *AlertService) identifiedAction(ctx context.Context, identifier, identifierType, action string, payload interface{}) (result *RequestResultScheme, response *Response, err error) {
+
+	if len(identifier) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	if payload == nil {
+		payload = &AlertActionPayloadScheme{}
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("v2/alerts/%v/%v", url.PathEscape(identifier), action), identifierParams("identifierType", identifierType))
+
+	return a.action(ctx, endpoint, payload)
+}
+
+func (a *AlertService) action(ctx context.Context, endpoint string, payload interface{}) (result *RequestResultScheme, response *Response, err error) {
+
+	request, err := a.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = a.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RequestResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
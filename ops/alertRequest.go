@@ -0,0 +1,95 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"net/url"
+)
+
+const (
+	// RequestKeyAlertDetail is the alert detail that stores the key of the linked customer request
+	RequestKeyAlertDetail = "jsm.requestKey"
+
+	// ServiceDeskIDAlertDetail is the alert detail that stores the service desk of the linked customer request
+	ServiceDeskIDAlertDetail = "jsm.serviceDeskId"
+
+	// RequestLinkAlertDetail is the alert detail that stores the browse link of the linked customer request
+	RequestLinkAlertDetail = "jsm.requestLink"
+)
+
+// AlertRequestLinkScheme contains the results of the steps used to link an alert with a customer request,
+// the steps executed before a failure are returned with the error.
+type AlertRequestLinkScheme struct {
+	Request *sm.CustomerRequestScheme
+	Details *RequestResultScheme
+	Note    *RequestResultScheme
+	Comment *sm.RequestCommentScheme
+}
+
+// RequestKey returns the key of the Jira Service Management customer request linked to the alert
+func (a *AlertScheme) RequestKey() string {
+
+	if a == nil {
+		return ""
+	}
+
+	return a.Details[RequestKeyAlertDetail]
+}
+
+// LinkRequest links an alert with a Jira Service Management customer request, the request key, service desk
+// and browse link are stored on the alert details, a note is added to the alert and an internal comment
+// is added to the customer request.
+// Docs: N/A
+func (a *AlertService) LinkRequest(ctx context.Context, identifier, identifierType string, smClient *sm.Client, requestKey string) (result *AlertRequestLinkScheme, err error) {
+
+	if len(identifier) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	if smClient == nil {
+		return nil, fmt.Errorf("error, please provide a valid sm.Client pointer")
+	}
+
+	if len(requestKey) == 0 {
+		return nil, fmt.Errorf("error, please provide a valid requestKey value")
+	}
+
+	result = new(AlertRequestLinkScheme)
+
+	result.Request, _, err = smClient.Request.Get(ctx, requestKey, nil)
+	if err != nil {
+		return
+	}
+
+	requestLink := smClient.Site.ResolveReference(&url.URL{Path: "browse/" + result.Request.IssueKey}).String()
+
+	details := map[string]string{
+		RequestKeyAlertDetail:    result.Request.IssueKey,
+		ServiceDeskIDAlertDetail: result.Request.ServiceDeskID,
+		RequestLinkAlertDetail:   requestLink,
+	}
+
+	result.Details, _, err = a.AddDetails(ctx, identifier, identifierType, details, nil)
+	if err != nil {
+		return
+	}
+
+	note := &AlertActionPayloadScheme{
+		Note: fmt.Sprintf("Linked to the customer request %v: %v", result.Request.IssueKey, requestLink),
+	}
+
+	result.Note, _, err = a.AddNote(ctx, identifier, identifierType, note)
+	if err != nil {
+		return
+	}
+
+	comment := fmt.Sprintf("Linked to the Opsgenie alert %v", identifier)
+
+	result.Comment, _, err = smClient.Request.Comment.Create(ctx, result.Request.IssueKey, comment, false)
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,121 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAlertService_LinkRequest(t *testing.T) {
+
+	var (
+		alertID = "70413a06-38d6-4c85-92b8-5ebc900d42e2"
+		details map[string]string
+		note    string
+		comment struct {
+			Public bool   `json:"public"`
+			Body   string `json:"body"`
+		}
+	)
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/rest/servicedeskapi/request/DESK-12", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"issueId": "10010", "issueKey": "DESK-12", "serviceDeskId": "1"}`))
+	})
+
+	mux.HandleFunc("/rest/servicedeskapi/request/DESK-12/comment", func(w http.ResponseWriter, r *http.Request) {
+
+		if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "10020", "body": "Linked to the Opsgenie alert", "public": false}`))
+	})
+
+	mux.HandleFunc("/v2/alerts/"+alertID+"/details", func(w http.ResponseWriter, r *http.Request) {
+
+		var payload struct {
+			Details map[string]string `json:"details"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		details = payload.Details
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"result": "Request will be processed", "requestId": "43a29c5c-3dbf-4fa4-9c26-f4f71023e120"}`))
+	})
+
+	mux.HandleFunc("/v2/alerts/"+alertID+"/notes", func(w http.ResponseWriter, r *http.Request) {
+
+		var payload AlertActionPayloadScheme
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		note = payload.Note
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"result": "Request will be processed", "requestId": "7e6d5c4b-3a2f-4e1d-9c8b-7a6f5e4d3c2b"}`))
+	})
+
+	mockServer := httptest.NewServer(mux)
+	defer mockServer.Close()
+
+	opsClient, err := startMockClient(mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	smClient, err := sm.New(nil, mockServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	link, err := opsClient.Alert.LinkRequest(context.Background(), alertID, IDIdentifierType, smClient, "DESK-12")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "DESK-12", link.Request.IssueKey)
+	assert.Equal(t, "43a29c5c-3dbf-4fa4-9c26-f4f71023e120", link.Details.RequestID)
+	assert.Equal(t, "7e6d5c4b-3a2f-4e1d-9c8b-7a6f5e4d3c2b", link.Note.RequestID)
+	assert.Equal(t, "10020", link.Comment.ID)
+
+	assert.Equal(t, map[string]string{
+		RequestKeyAlertDetail:    "DESK-12",
+		ServiceDeskIDAlertDetail: "1",
+		RequestLinkAlertDetail:   mockServer.URL + "/browse/DESK-12",
+	}, details)
+
+	assert.Equal(t, "Linked to the customer request DESK-12: "+mockServer.URL+"/browse/DESK-12", note)
+	assert.False(t, comment.Public)
+	assert.Equal(t, "Linked to the Opsgenie alert "+alertID, comment.Body)
+	assert.Equal(t, "DESK-12", (&AlertScheme{Details: details}).RequestKey())
+
+	// The steps executed before the failure are returned with the error
+	link, err = opsClient.Alert.LinkRequest(context.Background(), "ba9f0a81-0b27-4d05-9b36-9ee4fa2da5e6", IDIdentifierType, smClient, "DESK-12")
+	assert.Error(t, err)
+	assert.Equal(t, "DESK-12", link.Request.IssueKey)
+	assert.Nil(t, link.Details)
+
+	_, err = opsClient.Alert.LinkRequest(context.Background(), alertID, IDIdentifierType, nil, "DESK-12")
+	assert.Error(t, err)
+
+	_, err = opsClient.Alert.LinkRequest(context.Background(), alertID, IDIdentifierType, smClient, "")
+	assert.Error(t, err)
+
+	_, err = opsClient.Alert.LinkRequest(context.Background(), "", IDIdentifierType, smClient, "DESK-12")
+	assert.Error(t, err)
+}
@@ -0,0 +1,1611 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestAlertService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		payload            *AlertPayloadScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "CreateWhenTheParametersAreCorrect",
+			payload: &AlertPayloadScheme{
+				Message:  "Our servers are in danger",
+				Alias:    "event_573",
+				Priority: P1AlertPriority,
+				Tags:     []string{"OverwriteQuietHours", "Critical"},
+				Details:  map[string]string{"serverName": "Zion"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateWhenThePayloadIsNil",
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheMessageIsNotProvided",
+			payload:            &AlertPayloadScheme{Alias: "event_573"},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheRequestMethodIsIncorrect",
+			payload: &AlertPayloadScheme{
+				Message:  "Our servers are in danger",
+				Alias:    "event_573",
+				Priority: P1AlertPriority,
+				Tags:     []string{"OverwriteQuietHours", "Critical"},
+				Details:  map[string]string{"serverName": "Zion"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheStatusCodeIsIncorrect",
+			payload: &AlertPayloadScheme{
+				Message:  "Our servers are in danger",
+				Alias:    "event_573",
+				Priority: P1AlertPriority,
+				Tags:     []string{"OverwriteQuietHours", "Critical"},
+				Details:  map[string]string{"serverName": "Zion"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheContextIsNil",
+			payload: &AlertPayloadScheme{
+				Message:  "Our servers are in danger",
+				Alias:    "event_573",
+				Priority: P1AlertPriority,
+				Tags:     []string{"OverwriteQuietHours", "Critical"},
+				Details:  map[string]string{"serverName": "Zion"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheResponseBodyHasADifferentFormat",
+			payload: &AlertPayloadScheme{
+				Message:  "Our servers are in danger",
+				Alias:    "event_573",
+				Priority: P1AlertPriority,
+				Tags:     []string{"OverwriteQuietHours", "Critical"},
+				Details:  map[string]string{"serverName": "Zion"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "43a29c5c-3dbf-4fa4-9c26-f4f71023e120", gotResult.RequestID)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestAlertService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		options            *GetAlertsOptionsScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "GetsWhenTheParametersAreCorrect",
+			options: &GetAlertsOptionsScheme{
+				Query:  "status: open",
+				Sort:   "createdAt",
+				Order:  "desc",
+				Offset: 10,
+				Limit:  10,
+			},
+			mockFile:           "./mocks/get-alerts.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts?limit=10&offset=10&order=desc&query=status%3A+open&sort=createdAt",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name: "GetsWhenTheRequestMethodIsIncorrect",
+			options: &GetAlertsOptionsScheme{
+				Query:  "status: open",
+				Sort:   "createdAt",
+				Order:  "desc",
+				Offset: 10,
+				Limit:  10,
+			},
+			mockFile:           "./mocks/get-alerts.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts?limit=10&offset=10&order=desc&query=status%3A+open&sort=createdAt",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheStatusCodeIsIncorrect",
+			options: &GetAlertsOptionsScheme{
+				Query:  "status: open",
+				Sort:   "createdAt",
+				Order:  "desc",
+				Offset: 10,
+				Limit:  10,
+			},
+			mockFile:           "./mocks/get-alerts.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts?limit=10&offset=10&order=desc&query=status%3A+open&sort=createdAt",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheContextIsNil",
+			options: &GetAlertsOptionsScheme{
+				Query:  "status: open",
+				Sort:   "createdAt",
+				Order:  "desc",
+				Offset: 10,
+				Limit:  10,
+			},
+			mockFile:           "./mocks/get-alerts.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts?limit=10&offset=10&order=desc&query=status%3A+open&sort=createdAt",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name: "GetsWhenTheResponseBodyHasADifferentFormat",
+			options: &GetAlertsOptionsScheme{
+				Query:  "status: open",
+				Sort:   "createdAt",
+				Order:  "desc",
+				Offset: 10,
+				Limit:  10,
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts?limit=10&offset=10&order=desc&query=status%3A+open&sort=createdAt",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheOptionsAreNil",
+			options:            nil,
+			mockFile:           "./mocks/get-alerts.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, alert := range gotResult.Data {
+					t.Log(alert.TinyID, alert.Message, alert.Status)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestAlertService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-alert.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheIdentifierIsNotProvided",
+			identifier:         "",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-alert.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-alert.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-alert.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-alert.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2?identifierType=id",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheIdentifierTypeIsNotProvided",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     "",
+			mockFile:           "./mocks/get-alert.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.identifier, testCase.identifierType)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "DESK-12", gotResult.Data.RequestKey())
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestAlertService_RequestStatus(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		requestID          string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "RequestStatusWhenTheParametersAreCorrect",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/get-alert-request-status.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "RequestStatusWhenTheRequestIDIsNotProvided",
+			requestID:          "",
+			mockFile:           "./mocks/get-alert-request-status.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RequestStatusWhenTheRequestMethodIsIncorrect",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/get-alert-request-status.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RequestStatusWhenTheStatusCodeIsIncorrect",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/get-alert-request-status.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RequestStatusWhenTheContextIsNil",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/get-alert-request-status.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RequestStatusWhenTheResponseBodyHasADifferentFormat",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.RequestStatus(testCase.context, testCase.requestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "70413a06-38d6-4c85-92b8-5ebc900d42e2", gotResult.Data.AlertID)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestAlertService_Acknowledge(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		payload                    *AlertActionPayloadScheme
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:           "AcknowledgeWhenTheParametersAreCorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Acknowledged by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/acknowledge?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:           "AcknowledgeWhenTheIdentifierIsNotProvided",
+			identifier:     "",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Acknowledged by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/acknowledge?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "AcknowledgeWhenTheRequestMethodIsIncorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Acknowledged by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/acknowledge?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "AcknowledgeWhenTheStatusCodeIsIncorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Acknowledged by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/acknowledge?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:           "AcknowledgeWhenTheContextIsNil",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Acknowledged by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/acknowledge?identifierType=id",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "AcknowledgeWhenTheResponseBodyHasADifferentFormat",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Acknowledged by the monitoring script",
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/acknowledge?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Acknowledge(testCase.context, testCase.identifier, testCase.identifierType, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestAlertService_Close(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		payload                    *AlertActionPayloadScheme
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:           "CloseWhenTheParametersAreCorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Closed by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/close?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:           "CloseWhenTheIdentifierIsNotProvided",
+			identifier:     "",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Closed by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/close?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "CloseWhenTheRequestMethodIsIncorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Closed by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/close?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "CloseWhenTheStatusCodeIsIncorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Closed by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/close?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:           "CloseWhenTheContextIsNil",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Closed by the monitoring script",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/close?identifierType=id",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "CloseWhenTheResponseBodyHasADifferentFormat",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "Closed by the monitoring script",
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/close?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Close(testCase.context, testCase.identifier, testCase.identifierType, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestAlertService_AddNote(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		payload                    *AlertActionPayloadScheme
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:           "AddNoteWhenTheParametersAreCorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "The application servers were restarted",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:           "AddNoteWhenTheIdentifierIsNotProvided",
+			identifier:     "",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "The application servers were restarted",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddNoteWhenTheNoteIsNotProvided",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			payload:            &AlertActionPayloadScheme{User: "Monitoring Script"},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddNoteWhenThePayloadIsNil",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "AddNoteWhenTheRequestMethodIsIncorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "The application servers were restarted",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "AddNoteWhenTheStatusCodeIsIncorrect",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "The application servers were restarted",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:           "AddNoteWhenTheContextIsNil",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "The application servers were restarted",
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:           "AddNoteWhenTheResponseBodyHasADifferentFormat",
+			identifier:     "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType: IDIdentifierType,
+			payload: &AlertActionPayloadScheme{
+				User:   "Monitoring Script",
+				Source: "AWS Lambda",
+				Note:   "The application servers were restarted",
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.AddNote(testCase.context, testCase.identifier, testCase.identifierType, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestAlertService_Notes(t *testing.T) {
+
+	testCases := []struct {
+		name                               string
+		identifier, identifierType, offset string
+		limit                              int
+		mockFile                           string
+		wantHTTPMethod                     string
+		endpoint                           string
+		context                            context.Context
+		wantHTTPCodeReturn                 int
+		wantErr                            bool
+	}{
+		{
+			name:               "NotesWhenTheParametersAreCorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			offset:             "1622538730024_1622538730024234",
+			limit:              20,
+			mockFile:           "./mocks/get-alert-notes.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id&limit=20&offset=1622538730024_1622538730024234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "NotesWhenTheIdentifierIsNotProvided",
+			identifier:         "",
+			identifierType:     IDIdentifierType,
+			offset:             "1622538730024_1622538730024234",
+			limit:              20,
+			mockFile:           "./mocks/get-alert-notes.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id&limit=20&offset=1622538730024_1622538730024234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "NotesWhenTheRequestMethodIsIncorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			offset:             "1622538730024_1622538730024234",
+			limit:              20,
+			mockFile:           "./mocks/get-alert-notes.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id&limit=20&offset=1622538730024_1622538730024234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "NotesWhenTheStatusCodeIsIncorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			offset:             "1622538730024_1622538730024234",
+			limit:              20,
+			mockFile:           "./mocks/get-alert-notes.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id&limit=20&offset=1622538730024_1622538730024234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "NotesWhenTheContextIsNil",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			offset:             "1622538730024_1622538730024234",
+			limit:              20,
+			mockFile:           "./mocks/get-alert-notes.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id&limit=20&offset=1622538730024_1622538730024234",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "NotesWhenTheResponseBodyHasADifferentFormat",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			offset:             "1622538730024_1622538730024234",
+			limit:              20,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/notes?identifierType=id&limit=20&offset=1622538730024_1622538730024234",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Notes(testCase.context, testCase.identifier, testCase.identifierType, testCase.offset, testCase.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, note := range gotResult.Data {
+					t.Log(note.Owner, note.Note)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestAlertService_AddDetails(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		details                    map[string]string
+		payload                    *AlertActionPayloadScheme
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:               "AddDetailsWhenTheParametersAreCorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			details:            map[string]string{"region": "Oregon"},
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/details?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:               "AddDetailsWhenTheIdentifierIsNotProvided",
+			identifier:         "",
+			identifierType:     IDIdentifierType,
+			details:            map[string]string{"region": "Oregon"},
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/details?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddDetailsWhenTheDetailsAreNotProvided",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			details:            nil,
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/details?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddDetailsWhenTheRequestMethodIsIncorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			details:            map[string]string{"region": "Oregon"},
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/details?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddDetailsWhenTheStatusCodeIsIncorrect",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			details:            map[string]string{"region": "Oregon"},
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/details?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddDetailsWhenTheContextIsNil",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			details:            map[string]string{"region": "Oregon"},
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/details?identifierType=id",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "AddDetailsWhenTheResponseBodyHasADifferentFormat",
+			identifier:         "70413a06-38d6-4c85-92b8-5ebc900d42e2",
+			identifierType:     IDIdentifierType,
+			details:            map[string]string{"region": "Oregon"},
+			payload:            nil,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/alerts/70413a06-38d6-4c85-92b8-5ebc900d42e2/details?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &AlertService{client: mockClient}
+
+			gotResult, gotResponse, err := service.AddDetails(testCase.context, testCase.identifier, testCase.identifierType, testCase.details, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
@@ -0,0 +1,26 @@
+package ops
+
+type AuthenticationService struct {
+	client *Client
+
+	genieKeyProvided bool
+	genieKey         string
+
+	userAgentProvided bool
+	agent             string
+}
+
+// SetGenieKey sets the API key of an Opsgenie API integration, the requests are authenticated with the GenieKey scheme
+func (a *AuthenticationService) SetGenieKey(key string) {
+
+	a.genieKey = key
+
+	a.genieKeyProvided = true
+}
+
+func (a *AuthenticationService) SetUserAgent(agent string) {
+
+	a.agent = agent
+
+	a.userAgentProvided = true
+}
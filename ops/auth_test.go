@@ -0,0 +1,63 @@
+package ops
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestAuthenticationService(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		site     string
+		genieKey string
+		wantURL  string
+		wantAuth string
+	}{
+		{
+			name:     "AuthenticateWhenTheGenieKeyIsProvided",
+			genieKey: "eb243592-faa2-4ba2-a551-1afdf565c889",
+			wantURL:  "https://api.opsgenie.com/v2/alerts",
+			wantAuth: "GenieKey eb243592-faa2-4ba2-a551-1afdf565c889",
+		},
+		{
+			name:     "AuthenticateWhenTheSiteIsTheEuropeSite",
+			site:     EuropeSite,
+			genieKey: "eb243592-faa2-4ba2-a551-1afdf565c889",
+			wantURL:  "https://api.eu.opsgenie.com/v2/alerts",
+			wantAuth: "GenieKey eb243592-faa2-4ba2-a551-1afdf565c889",
+		},
+		{
+			name:     "AuthenticateWhenTheGenieKeyIsNotProvided",
+			wantURL:  "https://api.opsgenie.com/v2/alerts",
+			wantAuth: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			mockClient, err := New(nil, testCase.site)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(testCase.genieKey) != 0 {
+				mockClient.Auth.SetGenieKey(testCase.genieKey)
+			}
+
+			mockClient.Auth.SetUserAgent("curl/7.54.0")
+
+			request, err := mockClient.newRequest(context.Background(), http.MethodGet, "v2/alerts", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, testCase.wantURL, request.URL.String())
+			assert.Equal(t, testCase.wantAuth, request.Header.Get("Authorization"))
+			assert.Equal(t, "curl/7.54.0", request.Header.Get("User-Agent"))
+		})
+	}
+}
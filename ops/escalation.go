@@ -0,0 +1,184 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type EscalationService struct{ client *Client }
+
+type EscalationPayloadScheme struct {
+	Name        string                  `json:"name,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Rules       []*EscalationRuleScheme `json:"rules,omitempty"`
+	OwnerTeam   *ResponderScheme        `json:"ownerTeam,omitempty"`
+	Repeat      *EscalationRepeatScheme `json:"repeat,omitempty"`
+}
+
+type EscalationPageScheme struct {
+	Data      []*EscalationScheme `json:"data,omitempty"`
+	Took      float64             `json:"took,omitempty"`
+	RequestID string              `json:"requestId,omitempty"`
+}
+
+type EscalationResultScheme struct {
+	Data      *EscalationScheme `json:"data,omitempty"`
+	Took      float64           `json:"took,omitempty"`
+	RequestID string            `json:"requestId,omitempty"`
+}
+
+type EscalationCreatedResultScheme struct {
+	Data      *EscalationScheme `json:"data,omitempty"`
+	Result    string            `json:"result,omitempty"`
+	Took      float64           `json:"took,omitempty"`
+	RequestID string            `json:"requestId,omitempty"`
+}
+
+type EscalationScheme struct {
+	ID          string                  `json:"id,omitempty"`
+	Name        string                  `json:"name,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	OwnerTeam   *ResponderScheme        `json:"ownerTeam,omitempty"`
+	Rules       []*EscalationRuleScheme `json:"rules,omitempty"`
+	Repeat      *EscalationRepeatScheme `json:"repeat,omitempty"`
+}
+
+// EscalationRuleScheme notifies the recipient when the condition, e:g if-not-acked, is true after the delay
+type EscalationRuleScheme struct {
+	Condition  string                 `json:"condition,omitempty"`
+	NotifyType string                 `json:"notifyType,omitempty"`
+	Delay      *EscalationDelayScheme `json:"delay,omitempty"`
+	Recipient  *ResponderScheme       `json:"recipient,omitempty"`
+}
+
+type EscalationDelayScheme struct {
+	TimeAmount int    `json:"timeAmount"`
+	TimeUnit   string `json:"timeUnit,omitempty"`
+}
+
+type EscalationRepeatScheme struct {
+	WaitInterval         int  `json:"waitInterval,omitempty"`
+	Count                int  `json:"count,omitempty"`
+	ResetRecipientStates bool `json:"resetRecipientStates,omitempty"`
+	CloseAlertAfterAll   bool `json:"closeAlertAfterAll,omitempty"`
+}
+
+// Returns the escalations.
+// Docs: N/A
+func (e *EscalationService) Gets(ctx context.Context) (result *EscalationPageScheme, response *Response, err error) {
+
+	var endpoint = "v2/escalations"
+
+	request, err := e.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = e.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(EscalationPageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Creates an escalation, the name and at least one rule are required.
+// Docs: N/A
+func (e *EscalationService) Create(ctx context.Context, payload *EscalationPayloadScheme) (result *EscalationCreatedResultScheme, response *Response, err error) {
+
+	if payload == nil || len(payload.Name) == 0 || len(payload.Rules) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid EscalationPayloadScheme pointer with the name and the rules")
+	}
+
+	var endpoint = "v2/escalations"
+
+	request, err := e.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = e.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(EscalationCreatedResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns an escalation, the identifier type is id or name.
+// Docs: N/A
+func (e *EscalationService) Get(ctx context.Context, identifier, identifierType string) (result *EscalationResultScheme, response *Response, err error) {
+
+	if len(identifier) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("v2/escalations/%v", url.PathEscape(identifier)), identifierParams("identifierType", identifierType))
+
+	request, err := e.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = e.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(EscalationResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Deletes an escalation, the identifier type is id or name.
+// Docs: N/A
+func (e *EscalationService) Delete(ctx context.Context, identifier, identifierType string) (result *RequestResultScheme, response *Response, err error) {
+
+	if len(identifier) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("v2/escalations/%v", url.PathEscape(identifier)), identifierParams("identifierType", identifierType))
+
+	request, err := e.client.newRequest(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = e.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RequestResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
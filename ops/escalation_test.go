@@ -0,0 +1,646 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestEscalationService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			mockFile:           "./mocks/get-escalations.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			mockFile:           "./mocks/get-escalations.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			mockFile:           "./mocks/get-escalations.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			mockFile:           "./mocks/get-escalations.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &EscalationService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, escalation := range gotResult.Data {
+					t.Log(escalation.Name, len(escalation.Rules))
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestEscalationService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		payload            *EscalationPayloadScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "CreateWhenTheParametersAreCorrect",
+			payload: &EscalationPayloadScheme{
+				Name: "Platform Escalation",
+				Rules: []*EscalationRuleScheme{
+					{
+						Condition:  "if-not-acked",
+						NotifyType: "default",
+						Delay:      &EscalationDelayScheme{TimeAmount: 5, TimeUnit: "minutes"},
+						Recipient:  &ResponderScheme{Type: "team", Name: "Platform"},
+					},
+				},
+			},
+			mockFile:           "./mocks/create-escalation.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateWhenThePayloadIsNil",
+			payload:            nil,
+			mockFile:           "./mocks/create-escalation.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheRulesAreNotProvided",
+			payload:            &EscalationPayloadScheme{Name: "Platform Escalation"},
+			mockFile:           "./mocks/create-escalation.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheRequestMethodIsIncorrect",
+			payload: &EscalationPayloadScheme{
+				Name: "Platform Escalation",
+				Rules: []*EscalationRuleScheme{
+					{
+						Condition:  "if-not-acked",
+						NotifyType: "default",
+						Delay:      &EscalationDelayScheme{TimeAmount: 5, TimeUnit: "minutes"},
+						Recipient:  &ResponderScheme{Type: "team", Name: "Platform"},
+					},
+				},
+			},
+			mockFile:           "./mocks/create-escalation.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheStatusCodeIsIncorrect",
+			payload: &EscalationPayloadScheme{
+				Name: "Platform Escalation",
+				Rules: []*EscalationRuleScheme{
+					{
+						Condition:  "if-not-acked",
+						NotifyType: "default",
+						Delay:      &EscalationDelayScheme{TimeAmount: 5, TimeUnit: "minutes"},
+						Recipient:  &ResponderScheme{Type: "team", Name: "Platform"},
+					},
+				},
+			},
+			mockFile:           "./mocks/create-escalation.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheContextIsNil",
+			payload: &EscalationPayloadScheme{
+				Name: "Platform Escalation",
+				Rules: []*EscalationRuleScheme{
+					{
+						Condition:  "if-not-acked",
+						NotifyType: "default",
+						Delay:      &EscalationDelayScheme{TimeAmount: 5, TimeUnit: "minutes"},
+						Recipient:  &ResponderScheme{Type: "team", Name: "Platform"},
+					},
+				},
+			},
+			mockFile:           "./mocks/create-escalation.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/escalations",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheResponseBodyHasADifferentFormat",
+			payload: &EscalationPayloadScheme{
+				Name: "Platform Escalation",
+				Rules: []*EscalationRuleScheme{
+					{
+						Condition:  "if-not-acked",
+						NotifyType: "default",
+						Delay:      &EscalationDelayScheme{TimeAmount: 5, TimeUnit: "minutes"},
+						Recipient:  &ResponderScheme{Type: "team", Name: "Platform"},
+					},
+				},
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/escalations",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusCreated,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &EscalationService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestEscalationService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-escalation.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheIdentifierIsNotProvided",
+			identifier:         "",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-escalation.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-escalation.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-escalation.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/get-escalation.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &EscalationService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.identifier, testCase.identifierType)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestEscalationService_Delete(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:               "DeleteWhenTheParametersAreCorrect",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "DeleteWhenTheIdentifierIsNotProvided",
+			identifier:         "",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheRequestMethodIsIncorrect",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheStatusCodeIsIncorrect",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheContextIsNil",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "DeleteWhenTheResponseBodyHasADifferentFormat",
+			identifier:         "c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f",
+			identifierType:     IDIdentifierType,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodDelete,
+			endpoint:           "/v2/escalations/c7a8e3f0-2d1b-4c6a-9e8f-3b2a1d0c9e8f?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &EscalationService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Delete(testCase.context, testCase.identifier, testCase.identifierType)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/ops"
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+
+	instance, err := ops.New(nil, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetGenieKey(os.Getenv("GENIE_KEY"))
+
+	payload := &ops.AlertPayloadScheme{
+		Message:  "The checkout service is down",
+		Alias:    "checkout-down",
+		Priority: ops.P1AlertPriority,
+		Tags:     []string{"checkout", "payments"},
+		Responders: []*ops.ResponderScheme{
+			{Type: "team", Name: "Platform"},
+		},
+	}
+
+	request, response, err := instance.Alert.Create(context.Background(), payload)
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	// The alerts are created asynchronously
+	time.Sleep(2 * time.Second)
+
+	status, _, err := instance.Alert.RequestStatus(context.Background(), request.RequestID)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println(status.Data.Status, status.Data.AlertID)
+}
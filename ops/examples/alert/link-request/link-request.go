@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/jira/sm"
+	"github.com/ctreminiom/go-atlassian/ops"
+	"log"
+	"os"
+)
+
+func main() {
+
+	var (
+		host  = os.Getenv("HOST")
+		mail  = os.Getenv("MAIL")
+		token = os.Getenv("TOKEN")
+	)
+
+	atlassian, err := sm.New(nil, host)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	atlassian.Auth.SetBasicAuth(mail, token)
+
+	instance, err := ops.New(nil, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetGenieKey(os.Getenv("GENIE_KEY"))
+
+	link, err := instance.Alert.LinkRequest(context.Background(), "checkout-down", ops.AliasIdentifierType, atlassian, "DESK-12")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("The alert was linked with the customer request", link.Request.IssueKey)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/ops"
+	"log"
+	"os"
+)
+
+func main() {
+
+	instance, err := ops.New(nil, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	instance.Auth.SetGenieKey(os.Getenv("GENIE_KEY"))
+
+	onCalls, response, err := instance.Schedule.OnCalls(context.Background(), "Platform On-Call", ops.NameIdentifierType, true, "")
+	if err != nil {
+		if response != nil {
+			log.Println("Response HTTP Response", string(response.BodyAsBytes))
+			log.Println("HTTP Endpoint Used", response.Endpoint)
+		}
+		log.Fatal(err)
+	}
+
+	for _, recipient := range onCalls.Data.OnCallRecipients {
+		log.Println(recipient, "is on call")
+	}
+}
@@ -0,0 +1,187 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type IncidentService struct{ client *Client }
+
+type IncidentPayloadScheme struct {
+	Message            string             `json:"message,omitempty"`
+	Description        string             `json:"description,omitempty"`
+	Responders         []*ResponderScheme `json:"responders,omitempty"`
+	Tags               []string           `json:"tags,omitempty"`
+	Details            map[string]string  `json:"details,omitempty"`
+	Priority           string             `json:"priority,omitempty"`
+	Note               string             `json:"note,omitempty"`
+	ServiceID          string             `json:"serviceId,omitempty"`
+	NotifyStakeholders bool               `json:"notifyStakeholders,omitempty"`
+}
+
+type IncidentResultScheme struct {
+	Data      *IncidentScheme `json:"data,omitempty"`
+	Took      float64         `json:"took,omitempty"`
+	RequestID string          `json:"requestId,omitempty"`
+}
+
+type IncidentScheme struct {
+	ID              string             `json:"id,omitempty"`
+	TinyID          string             `json:"tinyId,omitempty"`
+	Message         string             `json:"message,omitempty"`
+	Status          string             `json:"status,omitempty"`
+	Tags            []string           `json:"tags,omitempty"`
+	CreatedAt       string             `json:"createdAt,omitempty"`
+	UpdatedAt       string             `json:"updatedAt,omitempty"`
+	Priority        string             `json:"priority,omitempty"`
+	OwnerTeam       string             `json:"ownerTeam,omitempty"`
+	Responders      []*ResponderScheme `json:"responders,omitempty"`
+	ExtraProperties map[string]string  `json:"extraProperties,omitempty"`
+}
+
+type IncidentRequestStatusResultScheme struct {
+	Data      *IncidentRequestStatusScheme `json:"data,omitempty"`
+	Took      float64                      `json:"took,omitempty"`
+	RequestID string                       `json:"requestId,omitempty"`
+}
+
+// IncidentRequestStatusScheme is the status of an asynchronous incident operation, it contains the ID of the incident created
+type IncidentRequestStatusScheme struct {
+	Success     bool   `json:"success,omitempty"`
+	Action      string `json:"action,omitempty"`
+	ProcessedAt string `json:"processedAt,omitempty"`
+	IsSuccess   bool   `json:"isSuccess,omitempty"`
+	Status      string `json:"status,omitempty"`
+	IncidentID  string `json:"incidentId,omitempty"`
+}
+
+// Creates an incident, the incident is created asynchronously, use the RequestStatus method to get the ID of the incident.
+// Docs: N/A
+func (i *IncidentService) Create(ctx context.Context, payload *IncidentPayloadScheme) (result *RequestResultScheme, response *Response, err error) {
+
+	if payload == nil || len(payload.Message) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid IncidentPayloadScheme pointer with the message")
+	}
+
+	var endpoint = "v1/incidents/create"
+
+	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = i.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RequestResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns an incident, the identifier type is id or tiny, the empty value uses the id type.
+// Docs: N/A
+func (i *IncidentService) Get(ctx context.Context, identifier, identifierType string) (result *IncidentResultScheme, response *Response, err error) {
+
+	if len(identifier) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("v1/incidents/%v", url.PathEscape(identifier)), identifierParams("identifierType", identifierType))
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(IncidentResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Resolves an open incident, the note is optional.
+// Docs: N/A
+func (i *IncidentService) Resolve(ctx context.Context, identifier, identifierType, note string) (result *RequestResultScheme, response *Response, err error) {
+
+	if len(identifier) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	payload := struct {
+		Note string `json:"note,omitempty"`
+	}{
+		Note: note,
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("v1/incidents/%v/resolve", url.PathEscape(identifier)), identifierParams("identifierType", identifierType))
+
+	request, err := i.client.newRequest(ctx, http.MethodPost, endpoint, &payload)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err = i.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(RequestResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns the status of an asynchronous incident operation, e.g: the incident creation.
+// Docs: N/A
+func (i *IncidentService) RequestStatus(ctx context.Context, requestID string) (result *IncidentRequestStatusResultScheme, response *Response, err error) {
+
+	if len(requestID) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid requestID value")
+	}
+
+	var endpoint = fmt.Sprintf("v1/incidents/requests/%v", url.PathEscape(requestID))
+
+	request, err := i.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = i.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(IncidentRequestStatusResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
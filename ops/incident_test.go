@@ -0,0 +1,656 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestIncidentService_Create(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		payload            *IncidentPayloadScheme
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name: "CreateWhenTheParametersAreCorrect",
+			payload: &IncidentPayloadScheme{
+				Message:  "The checkout service is down",
+				Priority: P1AlertPriority,
+				Tags:     []string{"checkout", "payments"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+				NotifyStakeholders: true,
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/create",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:               "CreateWhenThePayloadIsNil",
+			payload:            nil,
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/create",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "CreateWhenTheMessageIsNotProvided",
+			payload:            &IncidentPayloadScheme{Priority: P1AlertPriority},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/create",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheRequestMethodIsIncorrect",
+			payload: &IncidentPayloadScheme{
+				Message:  "The checkout service is down",
+				Priority: P1AlertPriority,
+				Tags:     []string{"checkout", "payments"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+				NotifyStakeholders: true,
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/create",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheStatusCodeIsIncorrect",
+			payload: &IncidentPayloadScheme{
+				Message:  "The checkout service is down",
+				Priority: P1AlertPriority,
+				Tags:     []string{"checkout", "payments"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+				NotifyStakeholders: true,
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/create",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheContextIsNil",
+			payload: &IncidentPayloadScheme{
+				Message:  "The checkout service is down",
+				Priority: P1AlertPriority,
+				Tags:     []string{"checkout", "payments"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+				NotifyStakeholders: true,
+			},
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/create",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name: "CreateWhenTheResponseBodyHasADifferentFormat",
+			payload: &IncidentPayloadScheme{
+				Message:  "The checkout service is down",
+				Priority: P1AlertPriority,
+				Tags:     []string{"checkout", "payments"},
+				Responders: []*ResponderScheme{
+					{Type: "team", Name: "Platform"},
+				},
+				NotifyStakeholders: true,
+			},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/create",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IncidentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Create(testCase.context, testCase.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestIncidentService_Get(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:               "GetWhenTheParametersAreCorrect",
+			identifier:         "42",
+			identifierType:     TinyIdentifierType,
+			mockFile:           "./mocks/get-incident.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/42?identifierType=tiny",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetWhenTheIdentifierIsNotProvided",
+			identifier:         "",
+			identifierType:     TinyIdentifierType,
+			mockFile:           "./mocks/get-incident.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/42?identifierType=tiny",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheRequestMethodIsIncorrect",
+			identifier:         "42",
+			identifierType:     TinyIdentifierType,
+			mockFile:           "./mocks/get-incident.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/42?identifierType=tiny",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheStatusCodeIsIncorrect",
+			identifier:         "42",
+			identifierType:     TinyIdentifierType,
+			mockFile:           "./mocks/get-incident.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/42?identifierType=tiny",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheContextIsNil",
+			identifier:         "42",
+			identifierType:     TinyIdentifierType,
+			mockFile:           "./mocks/get-incident.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/42?identifierType=tiny",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetWhenTheResponseBodyHasADifferentFormat",
+			identifier:         "42",
+			identifierType:     TinyIdentifierType,
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/42?identifierType=tiny",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IncidentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Get(testCase.context, testCase.identifier, testCase.identifierType)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestIncidentService_Resolve(t *testing.T) {
+
+	testCases := []struct {
+		name                             string
+		identifier, identifierType, note string
+		mockFile                         string
+		wantHTTPMethod                   string
+		endpoint                         string
+		context                          context.Context
+		wantHTTPCodeReturn               int
+		wantErr                          bool
+	}{
+		{
+			name:               "ResolveWhenTheParametersAreCorrect",
+			identifier:         "696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a",
+			identifierType:     IDIdentifierType,
+			note:               "The checkout service was rolled back",
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a/resolve?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            false,
+		},
+
+		{
+			name:               "ResolveWhenTheIdentifierIsNotProvided",
+			identifier:         "",
+			identifierType:     IDIdentifierType,
+			note:               "The checkout service was rolled back",
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a/resolve?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ResolveWhenTheRequestMethodIsIncorrect",
+			identifier:         "696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a",
+			identifierType:     IDIdentifierType,
+			note:               "The checkout service was rolled back",
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a/resolve?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ResolveWhenTheStatusCodeIsIncorrect",
+			identifier:         "696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a",
+			identifierType:     IDIdentifierType,
+			note:               "The checkout service was rolled back",
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a/resolve?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ResolveWhenTheContextIsNil",
+			identifier:         "696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a",
+			identifierType:     IDIdentifierType,
+			note:               "The checkout service was rolled back",
+			mockFile:           "./mocks/request-result.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a/resolve?identifierType=id",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+
+		{
+			name:               "ResolveWhenTheResponseBodyHasADifferentFormat",
+			identifier:         "696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a",
+			identifierType:     IDIdentifierType,
+			note:               "The checkout service was rolled back",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a/resolve?identifierType=id",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusAccepted,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IncidentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Resolve(testCase.context, testCase.identifier, testCase.identifierType, testCase.note)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestIncidentService_RequestStatus(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		requestID          string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "RequestStatusWhenTheParametersAreCorrect",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/get-incident-request-status.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "RequestStatusWhenTheRequestIDIsNotProvided",
+			requestID:          "",
+			mockFile:           "./mocks/get-incident-request-status.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RequestStatusWhenTheRequestMethodIsIncorrect",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/get-incident-request-status.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v1/incidents/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RequestStatusWhenTheStatusCodeIsIncorrect",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/get-incident-request-status.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RequestStatusWhenTheContextIsNil",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/get-incident-request-status.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "RequestStatusWhenTheResponseBodyHasADifferentFormat",
+			requestID:          "43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v1/incidents/requests/43a29c5c-3dbf-4fa4-9c26-f4f71023e120",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &IncidentService{client: mockClient}
+
+			gotResult, gotResponse, err := service.RequestStatus(testCase.context, testCase.requestID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "696f2bb7-9a28-4e0d-8c4a-5b0c9bfb8f7a", gotResult.Data.IncidentID)
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
@@ -0,0 +1,275 @@
+package ops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/internal/retry"
+	"github.com/ctreminiom/go-atlassian/ratelimit"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// DefaultSite is the Opsgenie REST API, it's used when the site is not provided
+	DefaultSite = "https://api.opsgenie.com/"
+
+	// EuropeSite is the Opsgenie REST API of the accounts hosted in the EU region
+	EuropeSite = "https://api.eu.opsgenie.com/"
+
+	// DefaultMaxRetries is the number of times the requests rejected with the 429 status code are sent again
+	DefaultMaxRetries = 3
+
+	IDIdentifierType    = "id"
+	AliasIdentifierType = "alias"
+	TinyIdentifierType  = "tiny"
+	NameIdentifierType  = "name"
+)
+
+type Client struct {
+	HTTP *http.Client
+	Site *url.URL
+
+	maxRetries int
+	limiter    *ratelimit.Limiter
+
+	Auth       *AuthenticationService
+	Alert      *AlertService
+	Incident   *IncidentService
+	Schedule   *ScheduleService
+	Escalation *EscalationService
+}
+
+// ClientOption configures the client created by New, e.g: WithRetries
+type ClientOption func(*Client)
+
+// WithRetries sets the number of times the requests rejected with the 429 status code are sent again, the requests
+// wait the seconds of the Retry-After header or an exponential backoff. The 0 value disables the retries.
+func WithRetries(maxRetries int) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// New returns an Opsgenie client, the empty site uses the DefaultSite value
+func New(httpClient *http.Client, site string, options ...ClientOption) (client *Client, err error) {
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	if len(site) == 0 {
+		site = DefaultSite
+	}
+
+	if !strings.HasSuffix(site, "/") {
+		site += "/"
+	}
+
+	siteAsURL, err := url.Parse(site)
+	if err != nil {
+		return
+	}
+
+	client = &Client{}
+	client.HTTP = httpClient
+	client.Site = siteAsURL
+	client.maxRetries = DefaultMaxRetries
+
+	client.Auth = &AuthenticationService{client: client}
+	client.Alert = &AlertService{client: client}
+	client.Incident = &IncidentService{client: client}
+	client.Schedule = &ScheduleService{client: client}
+	client.Escalation = &EscalationService{client: client}
+
+	for _, option := range options {
+		option(client)
+	}
+
+	return
+}
+
+func (c *Client) newRequest(ctx context.Context, method, urlAsString string, payload interface{}) (request *http.Request, err error) {
+
+	if ctx == nil {
+		return nil, errors.New("the context param is nil, please provide a valid one")
+	}
+
+	relativePath, err := url.Parse(urlAsString)
+	if err != nil {
+		return
+	}
+
+	relativePath.Path = strings.TrimLeft(relativePath.Path, "/")
+	relativePath.RawPath = strings.TrimLeft(relativePath.RawPath, "/")
+
+	endpointPath := c.Site.ResolveReference(relativePath)
+	var payloadBuffer io.ReadWriter
+	if payload != nil {
+		payloadBuffer = new(bytes.Buffer)
+		if err = json.NewEncoder(payloadBuffer).Encode(payload); err != nil {
+			return
+		}
+	}
+
+	request, err = http.NewRequestWithContext(ctx, method, endpointPath.String(), payloadBuffer)
+	if err != nil {
+		return
+	}
+
+	if c.Auth.genieKeyProvided {
+		request.Header.Set("Authorization", fmt.Sprintf("GenieKey %v", c.Auth.genieKey))
+	}
+
+	if c.Auth.userAgentProvided {
+		request.Header.Set("User-Agent", c.Auth.agent)
+	}
+
+	return
+}
+
+func (c *Client) Do(request *http.Request) (response *Response, err error) {
+
+	for attempt := 0; ; attempt++ {
+
+		if err = c.limiter.Wait(request.Context(), request.Method, ""); err != nil {
+			return
+		}
+
+		var httpResponse *http.Response
+		httpResponse, err = c.HTTP.Do(request)
+		c.limiter.Update(httpResponse)
+		if err != nil {
+			return
+		}
+
+		if c.retryable(request, httpResponse, attempt) {
+
+			wait := retry.After(httpResponse.Header, attempt)
+			_ = httpResponse.Body.Close()
+
+			if err = retry.Sleep(request.Context(), wait); err != nil {
+				return
+			}
+
+			if request.GetBody != nil {
+				if request.Body, err = request.GetBody(); err != nil {
+					return
+				}
+			}
+
+			continue
+		}
+
+		response, err = checkResponse(httpResponse, request.URL.String())
+		if err != nil {
+			return
+		}
+
+		return newResponse(httpResponse, request.URL.String())
+	}
+}
+
+type Response struct {
+	StatusCode  int
+	Endpoint    string
+	Headers     map[string][]string
+	BodyAsBytes []byte
+	Method      string
+}
+
+func newResponse(http *http.Response, endpoint string) (response *Response, err error) {
+
+	var statusCode = http.StatusCode
+
+	var httpResponseAsBytes []byte
+	if http.ContentLength != 0 {
+		httpResponseAsBytes, err = ioutil.ReadAll(http.Body)
+		if err != nil {
+			return
+		}
+	}
+
+	newResponse := Response{
+		StatusCode:  statusCode,
+		Headers:     http.Header,
+		BodyAsBytes: httpResponseAsBytes,
+		Endpoint:    endpoint,
+		Method:      http.Request.Method,
+	}
+
+	return &newResponse, nil
+}
+
+func checkResponse(http *http.Response, endpoint string) (response *Response, err error) {
+
+	var statusCode = http.StatusCode
+	if 200 <= statusCode && statusCode <= 299 {
+		return
+	}
+
+	var httpResponseAsBytes []byte
+	if http.ContentLength != 0 {
+		httpResponseAsBytes, err = ioutil.ReadAll(http.Body)
+		if err != nil {
+			return
+		}
+	}
+
+	newErrorResponse := Response{
+		StatusCode:  statusCode,
+		Headers:     http.Header,
+		BodyAsBytes: httpResponseAsBytes,
+		Endpoint:    endpoint,
+		Method:      http.Request.Method,
+	}
+
+	return &newErrorResponse, fmt.Errorf("request failed. Please analyze the request body for more details. Status Code: %d", statusCode)
+}
+
+// RequestResultScheme is returned by the asynchronous operations, the request ID returns the status of the operation
+type RequestResultScheme struct {
+	Result    string  `json:"result,omitempty"`
+	Took      float64 `json:"took,omitempty"`
+	RequestID string  `json:"requestId,omitempty"`
+}
+
+type PagingScheme struct {
+	Next  string `json:"next,omitempty"`
+	First string `json:"first,omitempty"`
+	Last  string `json:"last,omitempty"`
+}
+
+// ResponderScheme is a team, user, escalation or schedule, it's identified by the ID, the name or the username
+type ResponderScheme struct {
+	Type     string `json:"type,omitempty"`
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// identifierParams adds the identifierType query parameter, the id type is the default value of the API
+func identifierParams(name, identifierType string) url.Values {
+
+	params := url.Values{}
+	if len(identifierType) != 0 {
+		params.Add(name, identifierType)
+	}
+
+	return params
+}
+
+// withQuery appends the encoded query parameters to the endpoint
+func withQuery(endpoint string, params url.Values) string {
+
+	if len(params) == 0 {
+		return endpoint
+	}
+
+	return fmt.Sprintf("%v?%v", endpoint, params.Encode())
+}
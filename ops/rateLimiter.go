@@ -0,0 +1,11 @@
+package ops
+
+import "github.com/ctreminiom/go-atlassian/ratelimit"
+
+// WithRateLimiter waits for the tokens of the limiter before sending the requests of the client, the weights
+// of the limiter are matched with the methods of the requests, e.g: POST
+func WithRateLimiter(limiter *ratelimit.Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
@@ -0,0 +1,38 @@
+package ops
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithRateLimiter(t *testing.T) {
+
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer mockServer.Close()
+
+	limiter, err := ratelimit.New(&ratelimit.OptionsScheme{Rate: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockClient, err := New(nil, mockServer.URL, WithRateLimiter(limiter), WithRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = mockClient.Alert.Get(context.Background(), "8418d193-2dab-4490-b331-8c02cdd196b7", "")
+	assert.Error(t, err)
+
+	// The 429 status code pauses the requests of the clients sharing the limiter
+	stats := limiter.Stats()
+	assert.Equal(t, int64(1), stats.Requests)
+	assert.Equal(t, int64(1), stats.Throttled)
+	assert.False(t, stats.PausedUntil.IsZero())
+}
@@ -0,0 +1,13 @@
+package ops
+
+import "net/http"
+
+// retryable returns true when the request was rejected by the rate limit and its body can be sent again
+func (c *Client) retryable(request *http.Request, response *http.Response, attempt int) bool {
+
+	if response.StatusCode != http.StatusTooManyRequests || attempt >= c.maxRetries {
+		return false
+	}
+
+	return request.Body == nil || request.Body == http.NoBody || request.GetBody != nil
+}
@@ -0,0 +1,98 @@
+package ops
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestWithRetries(t *testing.T) {
+
+	testCases := []struct {
+		name         string
+		options      []ClientOption
+		wantRequests int
+		wantErr      bool
+	}{
+		{
+			name:         "RetryWhenTheRequestsAreRateLimited",
+			wantRequests: 3,
+			wantErr:      false,
+		},
+		{
+			name:         "RetryWhenTheRetriesAreExhausted",
+			options:      []ClientOption{WithRetries(1)},
+			wantRequests: 2,
+			wantErr:      true,
+		},
+		{
+			name:         "RetryWhenTheRetriesAreDisabled",
+			options:      []ClientOption{WithRetries(0)},
+			wantRequests: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu     sync.Mutex
+				bodies []string
+			)
+
+			// The first two requests are rejected by the rate limit
+			mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+				body, _ := ioutil.ReadAll(r.Body)
+
+				mu.Lock()
+				bodies = append(bodies, string(body))
+				requests := len(bodies)
+				mu.Unlock()
+
+				if requests <= 2 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"result": "Request will be processed", "took": 0.302, "requestId": "43a29c5c-3dbf-4fa4-9c26-f4f71023e120"}`))
+			}))
+			defer mockServer.Close()
+
+			mockClient, err := New(nil, mockServer.URL, testCase.options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotResult, gotResponse, err := mockClient.Alert.Create(context.Background(), &AlertPayloadScheme{Message: "Our servers are in danger"})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			assert.Len(t, bodies, testCase.wantRequests)
+
+			// The body of the request is sent again on every attempt
+			for _, body := range bodies {
+				assert.JSONEq(t, `{"message": "Our servers are in danger"}`, body)
+			}
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, http.StatusTooManyRequests, gotResponse.StatusCode)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "43a29c5c-3dbf-4fa4-9c26-f4f71023e120", gotResult.RequestID)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package ops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ScheduleService struct{ client *Client }
+
+type SchedulePageScheme struct {
+	Data       []*ScheduleScheme `json:"data,omitempty"`
+	Expandable []string          `json:"expandable,omitempty"`
+	Took       float64           `json:"took,omitempty"`
+	RequestID  string            `json:"requestId,omitempty"`
+}
+
+type ScheduleScheme struct {
+	ID          string           `json:"id,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Timezone    string           `json:"timezone,omitempty"`
+	Enabled     bool             `json:"enabled,omitempty"`
+	OwnerTeam   *ResponderScheme `json:"ownerTeam,omitempty"`
+}
+
+type OnCallResultScheme struct {
+	Data      *OnCallScheme `json:"data,omitempty"`
+	Took      float64       `json:"took,omitempty"`
+	RequestID string        `json:"requestId,omitempty"`
+}
+
+// OnCallScheme contains the participants of the schedule, the flat mode returns only the recipients.
+type OnCallScheme struct {
+	Parent             *ScheduleScheme            `json:"_parent,omitempty"`
+	OnCallParticipants []*OnCallParticipantScheme `json:"onCallParticipants,omitempty"`
+	OnCallRecipients   []string                   `json:"onCallRecipients,omitempty"`
+}
+
+type OnCallParticipantScheme struct {
+	ID                 string                     `json:"id,omitempty"`
+	Name               string                     `json:"name,omitempty"`
+	Type               string                     `json:"type,omitempty"`
+	EscalationTime     int                        `json:"escalationTime,omitempty"`
+	NotifyType         string                     `json:"notifyType,omitempty"`
+	OnCallParticipants []*OnCallParticipantScheme `json:"onCallParticipants,omitempty"`
+}
+
+// Returns the schedules, the expand values, e.g: rotation, adds the schedule details.
+// Docs: N/A
+func (s *ScheduleService) Gets(ctx context.Context, expand []string) (result *SchedulePageScheme, response *Response, err error) {
+
+	params := url.Values{}
+
+	if len(expand) != 0 {
+		params.Add("expand", strings.Join(expand, ","))
+	}
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, withQuery("v2/schedules", params), nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(SchedulePageScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
+
+// Returns who is on call for a schedule, the identifier type is id or name.
+// The date, e.g: 2021-06-01T09:00:00Z, is optional and the current time is used when it's not provided.
+// Docs: N/A
+func (s *ScheduleService) OnCalls(ctx context.Context, identifier, identifierType string, flat bool, date string) (result *OnCallResultScheme, response *Response, err error) {
+
+	if len(identifier) == 0 {
+		return nil, nil, fmt.Errorf("error, please provide a valid identifier value")
+	}
+
+	params := identifierParams("scheduleIdentifierType", identifierType)
+
+	if flat {
+		params.Add("flat", strconv.FormatBool(flat))
+	}
+
+	if len(date) != 0 {
+		params.Add("date", date)
+	}
+
+	var endpoint = withQuery(fmt.Sprintf("v2/schedules/%v/on-calls", url.PathEscape(identifier)), params)
+
+	request, err := s.client.newRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	response, err = s.client.Do(request)
+	if err != nil {
+		return
+	}
+
+	result = new(OnCallResultScheme)
+	if err = json.Unmarshal(response.BodyAsBytes, &result); err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,339 @@
+package ops
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestScheduleService_Gets(t *testing.T) {
+
+	testCases := []struct {
+		name               string
+		expand             []string
+		mockFile           string
+		wantHTTPMethod     string
+		endpoint           string
+		context            context.Context
+		wantHTTPCodeReturn int
+		wantErr            bool
+	}{
+		{
+			name:               "GetsWhenTheParametersAreCorrect",
+			expand:             []string{"rotation"},
+			mockFile:           "./mocks/get-schedules.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules?expand=rotation",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "GetsWhenTheRequestMethodIsIncorrect",
+			expand:             []string{"rotation"},
+			mockFile:           "./mocks/get-schedules.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/schedules?expand=rotation",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheStatusCodeIsIncorrect",
+			expand:             []string{"rotation"},
+			mockFile:           "./mocks/get-schedules.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules?expand=rotation",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheContextIsNil",
+			expand:             []string{"rotation"},
+			mockFile:           "./mocks/get-schedules.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules?expand=rotation",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheResponseBodyHasADifferentFormat",
+			expand:             []string{"rotation"},
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules?expand=rotation",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "GetsWhenTheExpandIsNotProvided",
+			expand:             nil,
+			mockFile:           "./mocks/get-schedules.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ScheduleService{client: mockClient}
+
+			gotResult, gotResponse, err := service.Gets(testCase.context, testCase.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				for _, schedule := range gotResult.Data {
+					t.Log(schedule.ID, schedule.Name, schedule.Timezone)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
+
+func TestScheduleService_OnCalls(t *testing.T) {
+
+	testCases := []struct {
+		name                       string
+		identifier, identifierType string
+		flat                       bool
+		date                       string
+		mockFile                   string
+		wantHTTPMethod             string
+		endpoint                   string
+		context                    context.Context
+		wantHTTPCodeReturn         int
+		wantErr                    bool
+	}{
+		{
+			name:               "OnCallsWhenTheParametersAreCorrect",
+			identifier:         "Platform On-Call",
+			identifierType:     NameIdentifierType,
+			flat:               false,
+			date:               "2021-06-01T09:00:00Z",
+			mockFile:           "./mocks/get-schedule-on-calls.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules/Platform On-Call/on-calls?date=2021-06-01T09%3A00%3A00Z&scheduleIdentifierType=name",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+
+		{
+			name:               "OnCallsWhenTheIdentifierIsNotProvided",
+			identifier:         "",
+			identifierType:     NameIdentifierType,
+			flat:               false,
+			date:               "2021-06-01T09:00:00Z",
+			mockFile:           "./mocks/get-schedule-on-calls.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules/Platform On-Call/on-calls?date=2021-06-01T09%3A00%3A00Z&scheduleIdentifierType=name",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OnCallsWhenTheRequestMethodIsIncorrect",
+			identifier:         "Platform On-Call",
+			identifierType:     NameIdentifierType,
+			flat:               false,
+			date:               "2021-06-01T09:00:00Z",
+			mockFile:           "./mocks/get-schedule-on-calls.json",
+			wantHTTPMethod:     http.MethodPost,
+			endpoint:           "/v2/schedules/Platform On-Call/on-calls?date=2021-06-01T09%3A00%3A00Z&scheduleIdentifierType=name",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OnCallsWhenTheStatusCodeIsIncorrect",
+			identifier:         "Platform On-Call",
+			identifierType:     NameIdentifierType,
+			flat:               false,
+			date:               "2021-06-01T09:00:00Z",
+			mockFile:           "./mocks/get-schedule-on-calls.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules/Platform On-Call/on-calls?date=2021-06-01T09%3A00%3A00Z&scheduleIdentifierType=name",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusBadRequest,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OnCallsWhenTheContextIsNil",
+			identifier:         "Platform On-Call",
+			identifierType:     NameIdentifierType,
+			flat:               false,
+			date:               "2021-06-01T09:00:00Z",
+			mockFile:           "./mocks/get-schedule-on-calls.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules/Platform On-Call/on-calls?date=2021-06-01T09%3A00%3A00Z&scheduleIdentifierType=name",
+			context:            nil,
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OnCallsWhenTheResponseBodyHasADifferentFormat",
+			identifier:         "Platform On-Call",
+			identifierType:     NameIdentifierType,
+			flat:               false,
+			date:               "2021-06-01T09:00:00Z",
+			mockFile:           "./mocks/empty_json.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules/Platform On-Call/on-calls?date=2021-06-01T09%3A00%3A00Z&scheduleIdentifierType=name",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            true,
+		},
+
+		{
+			name:               "OnCallsWhenTheFlatModeIsEnabled",
+			identifier:         "d875a1f4-9b4e-4219-a1f3-0c26936d18de",
+			identifierType:     "",
+			flat:               true,
+			date:               "",
+			mockFile:           "./mocks/get-schedule-on-calls.json",
+			wantHTTPMethod:     http.MethodGet,
+			endpoint:           "/v2/schedules/d875a1f4-9b4e-4219-a1f3-0c26936d18de/on-calls?flat=true",
+			context:            context.Background(),
+			wantHTTPCodeReturn: http.StatusOK,
+			wantErr:            false,
+		},
+	}
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			//Init a new HTTP mock server
+			mockOptions := mockServerOptions{
+				Endpoint:           testCase.endpoint,
+				MockFilePath:       testCase.mockFile,
+				MethodAccepted:     testCase.wantHTTPMethod,
+				ResponseCodeWanted: testCase.wantHTTPCodeReturn,
+			}
+
+			mockServer, err := startMockServer(&mockOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defer mockServer.Close()
+
+			//Init the library instance
+			mockClient, err := startMockClient(mockServer.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := &ScheduleService{client: mockClient}
+
+			gotResult, gotResponse, err := service.OnCalls(testCase.context, testCase.identifier, testCase.identifierType, testCase.flat, testCase.date)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.Error(t, err)
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				for _, participant := range gotResult.Data.OnCallParticipants {
+					t.Log(participant.Type, participant.Name)
+				}
+
+				apiEndpoint, err := url.Parse(gotResponse.Endpoint)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var endpointToAssert string
+
+				if apiEndpoint.Query().Encode() != "" {
+					endpointToAssert = fmt.Sprintf("%v?%v", apiEndpoint.Path, apiEndpoint.Query().Encode())
+				} else {
+					endpointToAssert = apiEndpoint.Path
+				}
+
+				t.Logf("HTTP Endpoint Wanted: %v, HTTP Endpoint Returned: %v", testCase.endpoint, endpointToAssert)
+				assert.Equal(t, testCase.endpoint, endpointToAssert)
+
+				t.Logf("HTTP Code Wanted: %v, HTTP Code Returned: %v", testCase.wantHTTPCodeReturn, gotResponse.StatusCode)
+				assert.Equal(t, gotResponse.StatusCode, testCase.wantHTTPCodeReturn)
+			}
+		})
+
+	}
+}
@@ -0,0 +1,408 @@
+// Package ratelimit limits the requests of the go-atlassian clients with a token bucket, the limiter can be
+// shared by the clients of the same site, e.g: jira.Client, its ServiceManagement client and admin.Client.
+//
+// The requests consume the tokens of their weights, the weights are configured with the endpoint templates
+// of the service methods, e.g: "POST rest/api/3/search", so the expensive requests consume more of the budget.
+// The rate is adapted to the rate limit headers of the responses: it's decreased when the API reports
+// that the budget is near the limit or returns the 429 status code, and it's recovered with the successful responses.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultPause is the time the requests are paused after a 429 status code without the Retry-After header
+	DefaultPause = time.Second
+
+	throttledFactor = 0.5  // The rate multiplier after a 429 status code
+	nearLimitFactor = 0.8  // The rate multiplier when the budget is near the limit
+	recoveryStep    = 0.05 // The fraction of the configured rate recovered after a successful response
+)
+
+type OptionsScheme struct {
+	Rate    float64            // The tokens added to the bucket per second, e.g: 10 for 10 requests per second with the default weight
+	Burst   float64            // The capacity of the bucket, defaults to Rate
+	MinRate float64            // The lowest rate the adaptation can reach, defaults to a tenth of Rate
+	Weights map[string]float64 // The tokens of the requests keyed by "METHOD route" or "METHOD", the default weight is 1
+}
+
+// Limiter is a token bucket shared by the clients, the nil limiter doesn't limit anything
+type Limiter struct {
+	mu sync.Mutex
+
+	rate, configuredRate, minRate float64
+	burst, tokens                 float64
+	weights                       map[string]float64
+
+	// last is the time the tokens were refilled, it's moved to the future to pause the requests
+	last time.Time
+	now  func() time.Time
+
+	waiting              int
+	requests, throttled  int64
+	consumed             float64
+	waited               time.Duration
+	limit, remaining     int
+	nearLimit            bool
+	resetAt, lastUpdated time.Time
+}
+
+type StatsScheme struct {
+	Rate        float64       // The current rate, it's lower than the configured rate after the rate limit responses
+	Burst       float64       // The capacity of the bucket
+	Tokens      float64       // The available tokens, it's negative when the requests are waiting for the tokens
+	Waiting     int           // The requests waiting for the tokens
+	Requests    int64         // The requests allowed by the limiter
+	Throttled   int64         // The responses with the 429 status code
+	Consumed    float64       // The tokens consumed by the allowed requests
+	Waited      time.Duration // The sum of the time the requests waited
+	PausedUntil time.Time     // The end of the pause requested by the API, it's zero when the requests aren't paused
+	Limit       int           // The X-RateLimit-Limit header of the last response, it's 0 when the header wasn't received
+	Remaining   int           // The X-RateLimit-Remaining header of the last response, it's -1 when the header wasn't received
+	NearLimit   bool          // The X-RateLimit-NearLimit header of the last response
+	Reset       time.Time     // The X-RateLimit-Reset header of the last response, it's zero when the header wasn't received
+	Updated     time.Time     // The time the last response was received
+}
+
+// New returns the limiter with a full bucket
+func New(opts *OptionsScheme) (*Limiter, error) {
+
+	if opts == nil {
+		return nil, fmt.Errorf("error, please provide a valid OptionsScheme pointer")
+	}
+
+	if opts.Rate <= 0 || math.IsInf(opts.Rate, 0) || math.IsNaN(opts.Rate) {
+		return nil, fmt.Errorf("error, please provide a valid Rate value")
+	}
+
+	if opts.Burst < 0 || opts.MinRate < 0 || opts.MinRate > opts.Rate {
+		return nil, fmt.Errorf("error, please provide a valid Burst and MinRate values")
+	}
+
+	limiter := &Limiter{
+		rate:           opts.Rate,
+		configuredRate: opts.Rate,
+		minRate:        opts.MinRate,
+		burst:          opts.Burst,
+		weights:        make(map[string]float64),
+		now:            time.Now,
+		remaining:      -1,
+	}
+
+	if limiter.burst == 0 {
+		limiter.burst = opts.Rate
+	}
+
+	if limiter.minRate == 0 {
+		limiter.minRate = opts.Rate / 10
+	}
+
+	for key, weight := range opts.Weights {
+
+		if weight < 0 {
+			return nil, fmt.Errorf("error, please provide a valid weight for %v", key)
+		}
+
+		limiter.weights[weightKey(key)] = weight
+	}
+
+	limiter.tokens = limiter.burst
+	limiter.last = limiter.now()
+
+	return limiter, nil
+}
+
+// Wait blocks until the bucket contains the tokens of the request or the context is done, the route is the
+// endpoint template of the request, e.g: "rest/api/3/search", it's empty when the request isn't a service method.
+func (l *Limiter) Wait(ctx context.Context, method, route string) error {
+
+	if l == nil {
+		return nil
+	}
+
+	weight := l.weight(method, route)
+
+	l.mu.Lock()
+	wait := l.reserve(weight)
+
+	if wait <= 0 {
+		l.requests++
+		l.consumed += weight
+		l.mu.Unlock()
+		return nil
+	}
+
+	l.waiting++
+	l.mu.Unlock()
+
+	var (
+		timer  = time.NewTimer(wait)
+		waited = wait
+	)
+
+	defer timer.Stop()
+
+	for {
+
+		select {
+		case <-timer.C:
+
+			l.mu.Lock()
+
+			// The requests are paused again when the API rejects a request while they're waiting
+			if now := l.now(); l.last.After(now) {
+
+				wait = l.last.Sub(now)
+				waited += wait
+				l.mu.Unlock()
+
+				timer.Reset(wait)
+				continue
+			}
+
+			l.waiting--
+			l.requests++
+			l.consumed += weight
+			l.waited += waited
+			l.mu.Unlock()
+
+			return nil
+
+		case <-ctx.Done():
+
+			// The tokens of the cancelled request are returned to the bucket
+			l.mu.Lock()
+			l.waiting--
+			l.tokens = math.Min(l.burst, l.tokens+weight)
+			l.mu.Unlock()
+
+			return ctx.Err()
+		}
+	}
+}
+
+// Update adapts the rate to the rate limit headers of the response, the nil responses are ignored
+func (l *Limiter) Update(response *http.Response) {
+
+	if l == nil || response == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+	l.lastUpdated = now
+
+	headers := response.Header
+
+	// The budget is the budget of the last response, the headers missing on the response aren't carried over
+	l.limit, l.remaining, l.resetAt = 0, -1, time.Time{}
+
+	if limit, err := strconv.Atoi(headers.Get("X-RateLimit-Limit")); err == nil {
+		l.limit = limit
+	}
+
+	if remaining, err := strconv.Atoi(headers.Get("X-RateLimit-Remaining")); err == nil {
+		l.remaining = remaining
+	}
+
+	if reset, ok := parseReset(headers.Get("X-RateLimit-Reset")); ok {
+		l.resetAt = reset
+	}
+
+	l.nearLimit = strings.EqualFold(headers.Get("X-RateLimit-NearLimit"), "true")
+
+	retryAfter, hasRetryAfter := parseRetryAfter(headers.Get("Retry-After"), now)
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests:
+
+		l.throttled++
+		l.rate = math.Max(l.minRate, l.rate*throttledFactor)
+
+		if !hasRetryAfter {
+			retryAfter = DefaultPause
+		}
+
+		l.pause(now.Add(retryAfter))
+
+	case response.StatusCode == http.StatusServiceUnavailable && hasRetryAfter:
+		l.pause(now.Add(retryAfter))
+
+	case l.remaining == 0:
+
+		// The budget is exhausted, the requests are paused until it's reset
+		l.rate = math.Max(l.minRate, l.rate*nearLimitFactor)
+
+		if l.resetAt.After(now) {
+			l.pause(l.resetAt)
+		}
+
+	case l.nearLimit:
+		l.rate = math.Max(l.minRate, l.rate*nearLimitFactor)
+
+	case response.StatusCode < http.StatusBadRequest:
+		l.rate = math.Min(l.configuredRate, l.rate+l.configuredRate*recoveryStep)
+	}
+}
+
+// Stats returns the current budget of the limiter, e.g: to export it to a dashboard
+func (l *Limiter) Stats() *StatsScheme {
+
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	stats := &StatsScheme{
+		Rate:      l.rate,
+		Burst:     l.burst,
+		Tokens:    l.tokens,
+		Waiting:   l.waiting,
+		Requests:  l.requests,
+		Throttled: l.throttled,
+		Consumed:  l.consumed,
+		Waited:    l.waited,
+		Limit:     l.limit,
+		Remaining: l.remaining,
+		NearLimit: l.nearLimit,
+		Reset:     l.resetAt,
+		Updated:   l.lastUpdated,
+	}
+
+	if l.last.After(now) {
+		stats.PausedUntil = l.last
+	}
+
+	return stats
+}
+
+// weight returns the tokens of the request, the weights larger than the bucket are reduced to its capacity
+func (l *Limiter) weight(method, route string) float64 {
+
+	weight, ok := l.weights[weightKey(method+" "+route)]
+	if !ok {
+		weight, ok = l.weights[weightKey(method)]
+	}
+
+	if !ok {
+		weight = 1
+	}
+
+	return math.Min(weight, l.burst)
+}
+
+// reserve takes the tokens from the bucket and returns the time until they're available, the tokens
+// can be negative so the waiting requests are allowed in order.
+func (l *Limiter) reserve(weight float64) time.Duration {
+
+	now := l.now()
+	l.refill(now)
+
+	l.tokens -= weight
+
+	var wait time.Duration
+	if l.last.After(now) {
+		wait = l.last.Sub(now)
+	}
+
+	if l.tokens < 0 {
+		wait += time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	return wait
+}
+
+func (l *Limiter) refill(now time.Time) {
+
+	if !now.After(l.last) {
+		return
+	}
+
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+}
+
+// pause stops the refill of the tokens until the time, the available tokens are discarded
+func (l *Limiter) pause(until time.Time) {
+
+	if l.tokens > 0 {
+		l.tokens = 0
+	}
+
+	if until.After(l.last) {
+		l.last = until
+	}
+}
+
+// weightKey normalizes the keys of the weights, e.g: "post /rest/api/3/search" is "POST rest/api/3/search"
+func weightKey(key string) string {
+
+	fields := strings.Fields(key)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	fields[0] = strings.ToUpper(fields[0])
+	if len(fields) > 1 {
+		fields[1] = strings.Trim(fields[1], "/")
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// parseRetryAfter parses the seconds or the HTTP date of the Retry-After header
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+
+		if date.Before(now) {
+			return 0, true
+		}
+
+		return date.Sub(now), true
+	}
+
+	return 0, false
+}
+
+// parseReset parses the ISO 8601 timestamp or the Unix seconds of the X-RateLimit-Reset header
+func parseReset(value string) (time.Time, bool) {
+
+	if len(value) == 0 {
+		return time.Time{}, false
+	}
+
+	if reset, err := time.Parse(time.RFC3339, value); err == nil {
+		return reset, true
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), true
+	}
+
+	return time.Time{}, false
+}
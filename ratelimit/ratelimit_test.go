@@ -0,0 +1,328 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	current time.Time
+}
+
+func (f *fakeClock) now() time.Time                 { return f.current }
+func (f *fakeClock) advance(duration time.Duration) { f.current = f.current.Add(duration) }
+
+func newFakeLimiter(t *testing.T, opts *OptionsScheme) (*Limiter, *fakeClock) {
+
+	limiter, err := New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clock := &fakeClock{current: time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)}
+	limiter.now = clock.now
+	limiter.last = clock.current
+
+	return limiter, clock
+}
+
+func newResponse(status int, headers map[string]string) *http.Response {
+
+	response := &http.Response{StatusCode: status, Header: http.Header{}}
+	for key, value := range headers {
+		response.Header.Set(key, value)
+	}
+
+	return response
+}
+
+func TestNew(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		opts    *OptionsScheme
+		wantErr bool
+	}{
+		{
+			name: "CreateTheLimiterWhenTheOptionsAreCorrect",
+			opts: &OptionsScheme{Rate: 10, Weights: map[string]float64{"POST rest/api/3/search": 5}},
+		},
+		{
+			name:    "CreateTheLimiterWhenTheOptionsAreNotProvided",
+			wantErr: true,
+		},
+		{
+			name:    "CreateTheLimiterWhenTheRateIsNotProvided",
+			opts:    &OptionsScheme{Burst: 10},
+			wantErr: true,
+		},
+		{
+			name:    "CreateTheLimiterWhenTheMinRateIsHigherThanTheRate",
+			opts:    &OptionsScheme{Rate: 10, MinRate: 20},
+			wantErr: true,
+		},
+		{
+			name:    "CreateTheLimiterWhenAWeightIsNegative",
+			opts:    &OptionsScheme{Rate: 10, Weights: map[string]float64{"GET": -1}},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			limiter, err := New(testCase.opts)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, limiter)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, limiter)
+		})
+	}
+}
+
+func TestLimiter_Weight(t *testing.T) {
+
+	limiter, _ := newFakeLimiter(t, &OptionsScheme{
+		Rate:  10,
+		Burst: 20,
+		Weights: map[string]float64{
+			"post /rest/api/3/search": 5,
+			"DELETE":                  2,
+			"GET rest/api/3/search":   50,
+		},
+	})
+
+	assert.Equal(t, 5.0, limiter.weight(http.MethodPost, "rest/api/3/search"))
+	assert.Equal(t, 2.0, limiter.weight(http.MethodDelete, "rest/api/3/issue/{issueKeyOrID}"))
+	assert.Equal(t, 1.0, limiter.weight(http.MethodGet, "rest/api/3/issue/{issueKeyOrID}"))
+
+	// The weights larger than the bucket are reduced to its capacity
+	assert.Equal(t, 20.0, limiter.weight(http.MethodGet, "rest/api/3/search"))
+}
+
+func TestLimiter_Reserve(t *testing.T) {
+
+	limiter, clock := newFakeLimiter(t, &OptionsScheme{Rate: 10, Burst: 5})
+
+	// The burst is allowed without waiting
+	for index := 0; index < 5; index++ {
+		assert.Equal(t, time.Duration(0), limiter.reserve(1))
+	}
+
+	// The next requests wait in order
+	assert.Equal(t, 100*time.Millisecond, limiter.reserve(1))
+	assert.Equal(t, 300*time.Millisecond, limiter.reserve(2))
+
+	clock.advance(time.Second)
+
+	// The bucket is refilled up to its capacity
+	assert.Equal(t, time.Duration(0), limiter.reserve(5))
+	assert.Equal(t, 100*time.Millisecond, limiter.reserve(1))
+}
+
+func TestLimiter_Update(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		response *http.Response
+		want     func(t *testing.T, limiter *Limiter, clock *fakeClock)
+	}{
+		{
+			name:     "UpdateTheLimiterWhenTheResponseIsThrottled",
+			response: newResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "3", "X-RateLimit-Remaining": "0"}),
+			want: func(t *testing.T, limiter *Limiter, clock *fakeClock) {
+
+				stats := limiter.Stats()
+				assert.Equal(t, 5.0, stats.Rate)
+				assert.Equal(t, int64(1), stats.Throttled)
+				assert.Equal(t, 0, stats.Remaining)
+				assert.Equal(t, clock.current.Add(3*time.Second), stats.PausedUntil)
+
+				// The requests wait the pause and the refill of the tokens at the decreased rate
+				assert.Equal(t, 3*time.Second+200*time.Millisecond, limiter.reserve(1))
+			},
+		},
+		{
+			name:     "UpdateTheLimiterWhenTheThrottledResponseHasNotRetryAfter",
+			response: newResponse(http.StatusTooManyRequests, nil),
+			want: func(t *testing.T, limiter *Limiter, clock *fakeClock) {
+				assert.Equal(t, clock.current.Add(DefaultPause), limiter.Stats().PausedUntil)
+			},
+		},
+		{
+			name:     "UpdateTheLimiterWhenTheRetryAfterIsADate",
+			response: newResponse(http.StatusServiceUnavailable, map[string]string{"Retry-After": "Sat, 01 May 2021 12:00:10 GMT"}),
+			want: func(t *testing.T, limiter *Limiter, clock *fakeClock) {
+
+				stats := limiter.Stats()
+				assert.Equal(t, 10.0, stats.Rate)
+				assert.Equal(t, clock.current.Add(10*time.Second), stats.PausedUntil)
+			},
+		},
+		{
+			name:     "UpdateTheLimiterWhenTheBudgetIsNearTheLimit",
+			response: newResponse(http.StatusOK, map[string]string{"X-RateLimit-NearLimit": "true", "X-RateLimit-Limit": "100", "X-RateLimit-Remaining": "10"}),
+			want: func(t *testing.T, limiter *Limiter, clock *fakeClock) {
+
+				stats := limiter.Stats()
+				assert.Equal(t, 8.0, stats.Rate)
+				assert.True(t, stats.NearLimit)
+				assert.Equal(t, 100, stats.Limit)
+				assert.Equal(t, 10, stats.Remaining)
+				assert.True(t, stats.PausedUntil.IsZero())
+			},
+		},
+		{
+			name:     "UpdateTheLimiterWhenTheBudgetIsExhausted",
+			response: newResponse(http.StatusOK, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "2021-05-01T12:00:30Z"}),
+			want: func(t *testing.T, limiter *Limiter, clock *fakeClock) {
+
+				stats := limiter.Stats()
+				assert.Equal(t, 8.0, stats.Rate)
+				assert.Equal(t, clock.current.Add(30*time.Second), stats.Reset)
+				assert.Equal(t, clock.current.Add(30*time.Second), stats.PausedUntil)
+			},
+		},
+		{
+			name:     "UpdateTheLimiterWhenTheNextResponseHasNotTheHeaders",
+			response: newResponse(http.StatusOK, map[string]string{"X-RateLimit-NearLimit": "true", "X-RateLimit-Limit": "100", "X-RateLimit-Remaining": "0"}),
+			want: func(t *testing.T, limiter *Limiter, clock *fakeClock) {
+
+				limiter.Update(newResponse(http.StatusOK, nil))
+
+				// The budget of the previous response isn't carried over, the rate is recovered
+				stats := limiter.Stats()
+				assert.Equal(t, 8.5, stats.Rate)
+				assert.False(t, stats.NearLimit)
+				assert.Equal(t, 0, stats.Limit)
+				assert.Equal(t, -1, stats.Remaining)
+				assert.True(t, stats.Reset.IsZero())
+			},
+		},
+		{
+			name:     "UpdateTheLimiterWhenTheResponseIsSuccessful",
+			response: newResponse(http.StatusOK, nil),
+			want: func(t *testing.T, limiter *Limiter, clock *fakeClock) {
+
+				stats := limiter.Stats()
+				assert.Equal(t, 10.0, stats.Rate)
+				assert.Equal(t, -1, stats.Remaining)
+				assert.Equal(t, clock.current, stats.Updated)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			limiter, clock := newFakeLimiter(t, &OptionsScheme{Rate: 10, Burst: 1})
+			limiter.Update(testCase.response)
+
+			testCase.want(t, limiter, clock)
+		})
+	}
+}
+
+func TestLimiter_Recovery(t *testing.T) {
+
+	limiter, _ := newFakeLimiter(t, &OptionsScheme{Rate: 10})
+
+	for index := 0; index < 5; index++ {
+		limiter.Update(newResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "0"}))
+	}
+
+	// The rate isn't decreased below the minimum rate
+	assert.Equal(t, 1.0, limiter.Stats().Rate)
+
+	for index := 0; index < 10; index++ {
+		limiter.Update(newResponse(http.StatusOK, nil))
+	}
+
+	assert.InDelta(t, 6.0, limiter.Stats().Rate, 0.0001)
+
+	for index := 0; index < 10; index++ {
+		limiter.Update(newResponse(http.StatusOK, nil))
+	}
+
+	// The rate isn't increased above the configured rate
+	assert.Equal(t, 10.0, limiter.Stats().Rate)
+}
+
+func TestLimiter_Wait(t *testing.T) {
+
+	limiter, err := New(&OptionsScheme{Rate: 100, Burst: 1, Weights: map[string]float64{"POST rest/api/3/search": 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, limiter.Wait(context.Background(), http.MethodPost, "rest/api/3/search"))
+
+	// The second request waits for the refill of the token
+	started := time.Now()
+	assert.NoError(t, limiter.Wait(context.Background(), http.MethodPost, "rest/api/3/search"))
+	assert.True(t, time.Since(started) >= 5*time.Millisecond)
+
+	// The cancelled request returns its token to the bucket
+	limiter.Update(newResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "60"}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx, http.MethodGet, "rest/api/3/myself"))
+
+	stats := limiter.Stats()
+	assert.Equal(t, int64(2), stats.Requests)
+	assert.Equal(t, 2.0, stats.Consumed)
+	assert.Equal(t, 0, stats.Waiting)
+	assert.Equal(t, 0.0, stats.Tokens)
+	assert.True(t, stats.Waited > 0)
+}
+
+func TestLimiter_WaitWhenTheRequestsArePausedWhileWaiting(t *testing.T) {
+
+	limiter, err := New(&OptionsScheme{Rate: 10, Burst: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, limiter.Wait(context.Background(), http.MethodGet, "rest/api/3/myself"))
+
+	var (
+		started = time.Now()
+		done    = make(chan time.Duration)
+	)
+
+	// The second request waits 100 milliseconds for the refill of the token
+	go func() {
+		_ = limiter.Wait(context.Background(), http.MethodGet, "rest/api/3/myself")
+		done <- time.Since(started)
+	}()
+
+	for limiter.Stats().Waiting == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	limiter.Update(newResponse(http.StatusTooManyRequests, map[string]string{"Retry-After": "0.3"}))
+
+	// The waiting request waits the pause requested after it was reserved
+	assert.True(t, <-done >= 300*time.Millisecond)
+	assert.True(t, limiter.Stats().Waited >= 250*time.Millisecond)
+}
+
+func TestLimiter_Nil(t *testing.T) {
+
+	var limiter *Limiter
+
+	// The nil limiter doesn't limit anything
+	assert.NoError(t, limiter.Wait(context.Background(), http.MethodGet, "rest/api/3/myself"))
+	limiter.Update(newResponse(http.StatusTooManyRequests, nil))
+	assert.Nil(t, limiter.Stats())
+}
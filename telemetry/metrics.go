@@ -0,0 +1,153 @@
+package telemetry
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultBuckets are the upper bounds of the latency histograms used when NewMetrics doesn't receive them
+var DefaultBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Metrics counts the requests and records the latency histograms per product, method, route and
+// status code. It implements Instrumentation, so it can be combined with a tracer using Combine.
+type Metrics struct {
+	mu      sync.Mutex
+	buckets []time.Duration
+	series  map[metricKey]*RouteMetricScheme
+}
+
+type metricKey struct {
+	product, method, route string
+	statusCode             int
+}
+
+type RouteMetricScheme struct {
+	Product    string
+	Method     string
+	Route      string
+	StatusCode int             // The status code of the responses, it's 0 for the failed requests
+	Count      int64           // The number of requests
+	Retries    int64           // The number of requests that were retries of previous requests
+	Duration   time.Duration   // The sum of the latencies
+	Buckets    []*BucketScheme // The cumulative latency histogram, Count contains the requests above the last bucket
+}
+
+type BucketScheme struct {
+	UpperBound time.Duration
+	Count      int64 // The number of requests with a latency lower or equal than the upper bound
+}
+
+// NewMetrics returns the metrics with the bucket upper bounds of the latency histograms
+func NewMetrics(buckets ...time.Duration) *Metrics {
+
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	sorted := append([]time.Duration(nil), buckets...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return &Metrics{buckets: sorted, series: make(map[metricKey]*RouteMetricScheme)}
+}
+
+func (m *Metrics) Start(ctx context.Context, request *RequestScheme) (context.Context, Span) {
+	return ctx, &metricSpan{metrics: m, request: request}
+}
+
+// Snapshot returns a copy of the metrics sorted by the product, the route, the method and the status code
+func (m *Metrics) Snapshot() []*RouteMetricScheme {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	snapshot := make([]*RouteMetricScheme, 0, len(m.series))
+	for _, metric := range m.series {
+
+		copied := *metric
+		copied.Buckets = make([]*BucketScheme, len(metric.Buckets))
+
+		for index, bucket := range metric.Buckets {
+			copiedBucket := *bucket
+			copied.Buckets[index] = &copiedBucket
+		}
+
+		snapshot = append(snapshot, &copied)
+	}
+
+	sort.Slice(snapshot, func(i, j int) bool {
+
+		a, b := snapshot[i], snapshot[j]
+
+		switch {
+		case a.Product != b.Product:
+			return a.Product < b.Product
+		case a.Route != b.Route:
+			return a.Route < b.Route
+		case a.Method != b.Method:
+			return a.Method < b.Method
+		}
+
+		return a.StatusCode < b.StatusCode
+	})
+
+	return snapshot
+}
+
+func (m *Metrics) record(request *RequestScheme, result *ResultScheme) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := metricKey{product: request.Product, method: request.Method, route: request.Route, statusCode: result.StatusCode}
+
+	metric, ok := m.series[key]
+	if !ok {
+
+		metric = &RouteMetricScheme{
+			Product:    request.Product,
+			Method:     request.Method,
+			Route:      request.Route,
+			StatusCode: result.StatusCode,
+			Buckets:    make([]*BucketScheme, len(m.buckets)),
+		}
+
+		for index, upperBound := range m.buckets {
+			metric.Buckets[index] = &BucketScheme{UpperBound: upperBound}
+		}
+
+		m.series[key] = metric
+	}
+
+	metric.Count++
+	metric.Duration += result.Duration
+
+	if request.Retries != 0 {
+		metric.Retries++
+	}
+
+	for _, bucket := range metric.Buckets {
+		if result.Duration <= bucket.UpperBound {
+			bucket.Count++
+		}
+	}
+}
+
+type metricSpan struct {
+	metrics *Metrics
+	request *RequestScheme
+}
+
+func (s *metricSpan) End(result *ResultScheme) {
+	s.metrics.record(s.request, result)
+}
@@ -0,0 +1,61 @@
+package telemetry
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+
+	metrics := NewMetrics(time.Second, 100*time.Millisecond)
+
+	record := func(request *RequestScheme, result *ResultScheme) {
+		_, span := metrics.Start(context.Background(), request)
+		span.End(result)
+	}
+
+	issue := &RequestScheme{Product: ProductJira, Method: http.MethodGet, Route: "rest/api/3/issue/{issueKeyOrID}"}
+	retried := &RequestScheme{Product: ProductJira, Method: http.MethodGet, Route: "rest/api/3/issue/{issueKeyOrID}", Retries: 1}
+	search := &RequestScheme{Product: ProductJira, Method: http.MethodPost, Route: "rest/api/3/search"}
+
+	record(issue, &ResultScheme{StatusCode: http.StatusOK, Duration: 50 * time.Millisecond})
+	record(retried, &ResultScheme{StatusCode: http.StatusOK, Duration: 500 * time.Millisecond})
+	record(issue, &ResultScheme{StatusCode: http.StatusNotFound, Duration: 20 * time.Millisecond})
+	record(search, &ResultScheme{Duration: 2 * time.Second, Err: context.DeadlineExceeded})
+
+	snapshot := metrics.Snapshot()
+	if !assert.Len(t, snapshot, 3) {
+		return
+	}
+
+	assert.Equal(t, &RouteMetricScheme{
+		Product:    ProductJira,
+		Method:     http.MethodGet,
+		Route:      "rest/api/3/issue/{issueKeyOrID}",
+		StatusCode: http.StatusOK,
+		Count:      2,
+		Retries:    1,
+		Duration:   550 * time.Millisecond,
+		Buckets: []*BucketScheme{
+			{UpperBound: 100 * time.Millisecond, Count: 1},
+			{UpperBound: time.Second, Count: 2},
+		},
+	}, snapshot[0])
+
+	assert.Equal(t, http.StatusNotFound, snapshot[1].StatusCode)
+	assert.Equal(t, int64(1), snapshot[1].Count)
+
+	// The failed requests are recorded with the 0 status code, their latency is above the last bucket
+	assert.Equal(t, "rest/api/3/search", snapshot[2].Route)
+	assert.Equal(t, 0, snapshot[2].StatusCode)
+	assert.Equal(t, int64(0), snapshot[2].Buckets[1].Count)
+
+	// The snapshot is a copy of the metrics
+	snapshot[0].Buckets[0].Count = 100
+	assert.Equal(t, int64(1), metrics.Snapshot()[0].Buckets[0].Count)
+
+	assert.Equal(t, DefaultBuckets, NewMetrics().buckets)
+}
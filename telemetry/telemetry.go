@@ -0,0 +1,182 @@
+// Package telemetry instruments the requests of the go-atlassian clients, the Instrumentation interface
+// receives a call per request to create the spans and to record the metrics, e.g: with OpenTelemetry.
+//
+// The requests are described with the endpoint templates of the service methods, e.g:
+// "rest/api/3/issue/{issueKeyOrID}", so the routes can be used as span names and metric labels.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// The products of the requests, they're used as the atlassian.product attribute
+const (
+	ProductJira              = "jira"
+	ProductServiceManagement = "jira-service-management"
+	ProductAdmin             = "admin"
+)
+
+// The attribute keys of the requests, they follow the OpenTelemetry semantic conventions of the HTTP clients
+const (
+	ProductAttribute       = "atlassian.product"
+	RouteAttribute         = "http.route"
+	MethodAttribute        = "http.request.method"
+	RetryCountAttribute    = "http.request.resend_count"
+	ServerAddressAttribute = "server.address"
+	StatusCodeAttribute    = "http.response.status_code"
+	ErrorTypeAttribute     = "error.type"
+)
+
+// Instrumentation is called before a request is sent, the returned context is used to send the request
+// so the span of the context can be propagated by the transport of the HTTP client.
+type Instrumentation interface {
+	Start(ctx context.Context, request *RequestScheme) (context.Context, Span)
+}
+
+// Span is ended once the response or the error of the request is received
+type Span interface {
+	End(result *ResultScheme)
+}
+
+type RequestScheme struct {
+	Product string   // The product of the client, e.g: ProductJira
+	Method  string   // The HTTP method
+	Route   string   // The endpoint template, it's empty when the request doesn't belong to a service method
+	URL     *url.URL // The URL of the request, it contains the identifiers and the query parameters
+	Retries int      // The number of times the request was sent before, e.g: after a 429 status code
+}
+
+type ResultScheme struct {
+	StatusCode int           // The status code of the response, it's 0 when the request failed
+	Duration   time.Duration // The time until the response headers were received
+	Err        error         // The transport error, e.g: a timeout or a cancelled context
+}
+
+type retriesContextKey struct{}
+
+// WithRetries returns the context of a request sent again, the retries are the number of times the request was sent
+// before, e.g: when the caller sends again a request rejected with the 429 status code. The clients report them as
+// the Retries of the RequestScheme, the Admin bulk operations set them on their retries.
+func WithRetries(ctx context.Context, retries int) context.Context {
+
+	if retries <= 0 {
+		return ctx
+	}
+
+	return context.WithValue(ctx, retriesContextKey{}, retries)
+}
+
+// RetriesOf returns the retries of the context, it's 0 when the request wasn't sent before
+func RetriesOf(ctx context.Context) int {
+	retries, _ := ctx.Value(retriesContextKey{}).(int)
+	return retries
+}
+
+// Name returns the span name of the request, e.g: "GET rest/api/3/issue/{issueKeyOrID}"
+func (r *RequestScheme) Name() string {
+
+	if len(r.Route) == 0 {
+		return r.Method
+	}
+
+	return r.Method + " " + r.Route
+}
+
+// Attributes returns the attributes of the request keyed by the attribute constants, the URL
+// isn't included because its identifiers would increase the cardinality of the metrics.
+func (r *RequestScheme) Attributes() map[string]interface{} {
+
+	attributes := map[string]interface{}{
+		ProductAttribute: r.Product,
+		MethodAttribute:  r.Method,
+	}
+
+	if len(r.Route) != 0 {
+		attributes[RouteAttribute] = r.Route
+	}
+
+	if r.URL != nil {
+		attributes[ServerAddressAttribute] = r.URL.Hostname()
+	}
+
+	if r.Retries != 0 {
+		attributes[RetryCountAttribute] = r.Retries
+	}
+
+	return attributes
+}
+
+// Attributes returns the status code of the response, or the error type when the request failed
+// or the API returned an error status code.
+func (r *ResultScheme) Attributes() map[string]interface{} {
+
+	attributes := make(map[string]interface{})
+
+	if r.StatusCode != 0 {
+		attributes[StatusCodeAttribute] = r.StatusCode
+	}
+
+	switch {
+	case r.Err != nil:
+		attributes[ErrorTypeAttribute] = errorType(r.Err)
+	case r.StatusCode >= 400:
+		attributes[ErrorTypeAttribute] = strconv.Itoa(r.StatusCode)
+	}
+
+	return attributes
+}
+
+func errorType(err error) string {
+
+	var urlErr *url.Error
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &urlErr) && urlErr.Timeout():
+		return "timeout"
+	}
+
+	return "_OTHER"
+}
+
+// Combine returns an instrumentation that calls the instrumentations in order, e.g: to create the
+// spans with a tracer and to record the metrics with NewMetrics.
+func Combine(instrumentations ...Instrumentation) Instrumentation {
+	return combined(instrumentations)
+}
+
+type combined []Instrumentation
+
+func (c combined) Start(ctx context.Context, request *RequestScheme) (context.Context, Span) {
+
+	spans := make(combinedSpan, 0, len(c))
+	for _, instrumentation := range c {
+
+		if instrumentation == nil {
+			continue
+		}
+
+		var span Span
+		ctx, span = instrumentation.Start(ctx, request)
+
+		if span != nil {
+			spans = append(spans, span)
+		}
+	}
+
+	return ctx, spans
+}
+
+type combinedSpan []Span
+
+// End ends the spans in the reverse order of the instrumentations
+func (c combinedSpan) End(result *ResultScheme) {
+	for index := len(c) - 1; index >= 0; index-- {
+		c[index].End(result)
+	}
+}
@@ -0,0 +1,118 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestRequestScheme(t *testing.T) {
+
+	endpoint, err := url.Parse("https://ctreminiom.atlassian.net/rest/api/3/issue/KP-1?expand=names")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := &RequestScheme{Product: ProductJira, Method: http.MethodGet, Route: "rest/api/3/issue/{issueKeyOrID}", URL: endpoint, Retries: 2}
+
+	assert.Equal(t, "GET rest/api/3/issue/{issueKeyOrID}", request.Name())
+	assert.Equal(t, map[string]interface{}{
+		ProductAttribute:       "jira",
+		MethodAttribute:        "GET",
+		RouteAttribute:         "rest/api/3/issue/{issueKeyOrID}",
+		ServerAddressAttribute: "ctreminiom.atlassian.net",
+		RetryCountAttribute:    2,
+	}, request.Attributes())
+
+	// The unknown routes aren't reported
+	request = &RequestScheme{Product: ProductAdmin, Method: http.MethodPost}
+
+	assert.Equal(t, "POST", request.Name())
+	assert.Equal(t, map[string]interface{}{ProductAttribute: "admin", MethodAttribute: "POST"}, request.Attributes())
+}
+
+func TestWithRetries(t *testing.T) {
+
+	ctx := WithRetries(context.Background(), 2)
+	assert.Equal(t, 2, RetriesOf(ctx))
+
+	// The first attempt doesn't change the context
+	assert.Equal(t, ctx, WithRetries(ctx, 0))
+	assert.Equal(t, 0, RetriesOf(context.Background()))
+}
+
+func TestResultScheme(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		result *ResultScheme
+		want   map[string]interface{}
+	}{
+		{
+			name:   "AttributesWhenTheResponseIsSuccessful",
+			result: &ResultScheme{StatusCode: http.StatusOK},
+			want:   map[string]interface{}{StatusCodeAttribute: 200},
+		},
+		{
+			name:   "AttributesWhenTheStatusCodeIsAnError",
+			result: &ResultScheme{StatusCode: http.StatusTooManyRequests},
+			want:   map[string]interface{}{StatusCodeAttribute: 429, ErrorTypeAttribute: "429"},
+		},
+		{
+			name:   "AttributesWhenTheRequestTimedOut",
+			result: &ResultScheme{Err: &url.Error{Op: "Get", URL: "https://ctreminiom.atlassian.net", Err: context.DeadlineExceeded}},
+			want:   map[string]interface{}{ErrorTypeAttribute: "timeout"},
+		},
+		{
+			name:   "AttributesWhenTheContextWasCanceled",
+			result: &ResultScheme{Err: &url.Error{Op: "Get", URL: "https://ctreminiom.atlassian.net", Err: context.Canceled}},
+			want:   map[string]interface{}{ErrorTypeAttribute: "canceled"},
+		},
+		{
+			name:   "AttributesWhenTheRequestFailed",
+			result: &ResultScheme{Err: errors.New("dial tcp: connection refused")},
+			want:   map[string]interface{}{ErrorTypeAttribute: "_OTHER"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.result.Attributes())
+		})
+	}
+}
+
+type contextKey string
+
+type recordingInstrumentation struct {
+	name  string
+	calls *[]string
+}
+
+func (r *recordingInstrumentation) Start(ctx context.Context, request *RequestScheme) (context.Context, Span) {
+	*r.calls = append(*r.calls, "start "+r.name)
+	return context.WithValue(ctx, contextKey(r.name), true), r
+}
+
+func (r *recordingInstrumentation) End(result *ResultScheme) {
+	*r.calls = append(*r.calls, "end "+r.name)
+}
+
+func TestCombine(t *testing.T) {
+
+	var calls []string
+
+	instrumentation := Combine(&recordingInstrumentation{name: "tracer", calls: &calls}, nil, &recordingInstrumentation{name: "metrics", calls: &calls})
+
+	ctx, span := instrumentation.Start(context.Background(), &RequestScheme{Method: http.MethodGet})
+	span.End(&ResultScheme{StatusCode: http.StatusOK})
+
+	assert.Equal(t, []string{"start tracer", "start metrics", "end metrics", "end tracer"}, calls)
+
+	// The context contains the values of both instrumentations
+	assert.Equal(t, true, ctx.Value(contextKey("tracer")))
+	assert.Equal(t, true, ctx.Value(contextKey("metrics")))
+}